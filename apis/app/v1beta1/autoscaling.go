@@ -0,0 +1,135 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1beta1
+
+import (
+	api "github.com/kiegroup/kogito-operator/apis"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Autoscaling defines the HorizontalPodAutoscaler managed by the operator for the service.
+// While it's enabled, the replicas of the service are handled by the HorizontalPodAutoscaler instead of the spec.
+// +k8s:openapi-gen=true
+// +operator-sdk:csv:customresourcedefinitions:displayName="Autoscaling"
+type Autoscaling struct {
+	// Lower limit for the number of replicas the service can be scaled down to.
+	//
+	// Default value: 1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Min Replicas"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:podCount"
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// Upper limit for the number of replicas the service can be scaled up to. Autoscaling is enabled when it's set.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Max Replicas"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:podCount"
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxReplicas int32 `json:"maxReplicas,omitempty"`
+	// Target average CPU utilization of the pods, as a percentage of the requested CPU.
+	// Defaults to 80 when no other target is given.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Target CPU Utilization Percentage"
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+	// Target average memory utilization of the pods, as a percentage of the requested memory.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Target Memory Utilization Percentage"
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TargetMemoryUtilizationPercentage *int32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+	// Custom pod metrics, e.g. exposed by the Kogito Prometheus add-on through a metrics adapter.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Metrics"
+	// +listType=atomic
+	// +optional
+	Metrics []AutoscalingMetric `json:"metrics,omitempty"`
+}
+
+// AutoscalingMetric is a custom pod metric used to scale the service.
+// +k8s:openapi-gen=true
+type AutoscalingMetric struct {
+	// Name of the metric, e.g. "kogito_process_instance_running_total".
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Target value of the metric averaged across the pods.
+	// +kubebuilder:validation:Required
+	TargetAverageValue resource.Quantity `json:"targetAverageValue"`
+}
+
+// GetName ...
+func (a AutoscalingMetric) GetName() string {
+	return a.Name
+}
+
+// GetTargetAverageValue ...
+func (a AutoscalingMetric) GetTargetAverageValue() resource.Quantity {
+	return a.TargetAverageValue
+}
+
+// IsEnabled ...
+func (a *Autoscaling) IsEnabled() bool {
+	return a.MaxReplicas > 0
+}
+
+// GetMinReplicas ...
+func (a *Autoscaling) GetMinReplicas() *int32 {
+	return a.MinReplicas
+}
+
+// SetMinReplicas ...
+func (a *Autoscaling) SetMinReplicas(minReplicas *int32) {
+	a.MinReplicas = minReplicas
+}
+
+// GetMaxReplicas ...
+func (a *Autoscaling) GetMaxReplicas() int32 {
+	return a.MaxReplicas
+}
+
+// SetMaxReplicas ...
+func (a *Autoscaling) SetMaxReplicas(maxReplicas int32) {
+	a.MaxReplicas = maxReplicas
+}
+
+// GetTargetCPUUtilizationPercentage ...
+func (a *Autoscaling) GetTargetCPUUtilizationPercentage() *int32 {
+	return a.TargetCPUUtilizationPercentage
+}
+
+// SetTargetCPUUtilizationPercentage ...
+func (a *Autoscaling) SetTargetCPUUtilizationPercentage(target *int32) {
+	a.TargetCPUUtilizationPercentage = target
+}
+
+// GetTargetMemoryUtilizationPercentage ...
+func (a *Autoscaling) GetTargetMemoryUtilizationPercentage() *int32 {
+	return a.TargetMemoryUtilizationPercentage
+}
+
+// SetTargetMemoryUtilizationPercentage ...
+func (a *Autoscaling) SetTargetMemoryUtilizationPercentage(target *int32) {
+	a.TargetMemoryUtilizationPercentage = target
+}
+
+// GetMetrics ...
+func (a *Autoscaling) GetMetrics() []api.AutoscalingMetricInterface {
+	metrics := make([]api.AutoscalingMetricInterface, len(a.Metrics))
+	for i, metric := range a.Metrics {
+		metrics[i] = metric
+	}
+	return metrics
+}
@@ -0,0 +1,68 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1beta1
+
+// ImageRegistry describes the container registry where the images built by the operator are pushed to.
+// +k8s:openapi-gen=true
+// +operator-sdk:csv:customresourcedefinitions:displayName="Image Registry"
+type ImageRegistry struct {
+	// Registry and namespace where the final image will be pushed to. Example: "quay.io/mynamespace".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Registry URL"
+	// +optional
+	URL string `json:"url,omitempty"`
+	// Name of the secret of type "kubernetes.io/dockerconfigjson" holding the credentials to push images to the registry.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Push Secret"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:io.kubernetes:Secret"
+	// +optional
+	Secret string `json:"secret,omitempty"`
+	// A flag indicating that the registry is insecure (plain HTTP or self signed certificates). Defaults to 'false'.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Insecure Registry"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch"
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+}
+
+// GetURL ...
+func (r *ImageRegistry) GetURL() string {
+	return r.URL
+}
+
+// SetURL ...
+func (r *ImageRegistry) SetURL(url string) {
+	r.URL = url
+}
+
+// GetSecret ...
+func (r *ImageRegistry) GetSecret() string {
+	return r.Secret
+}
+
+// SetSecret ...
+func (r *ImageRegistry) SetSecret(secret string) {
+	r.Secret = secret
+}
+
+// IsInsecure ...
+func (r *ImageRegistry) IsInsecure() bool {
+	return r.Insecure
+}
+
+// SetInsecure ...
+func (r *ImageRegistry) SetInsecure(insecure bool) {
+	r.Insecure = insecure
+}
@@ -0,0 +1,82 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1beta1
+
+// Ingress describes how the service is exposed outside the cluster by a networking.k8s.io/v1 Ingress on Kubernetes.
+// +k8s:openapi-gen=true
+// +operator-sdk:csv:customresourcedefinitions:displayName="Ingress"
+type Ingress struct {
+	// Host name the service is exposed on, e.g. "my-service.example.com". The Ingress is only created on Kubernetes when it's set.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Host"
+	// +optional
+	Host string `json:"host,omitempty"`
+	// Name of the IngressClass handling the Ingress. If not provided, the default IngressClass of the cluster is used.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Ingress Class Name"
+	// +optional
+	IngressClassName string `json:"ingressClassName,omitempty"`
+	// Name of the secret of type "kubernetes.io/tls" holding the certificate for the host. If set, the service is exposed with HTTPS.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="TLS Secret"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:io.kubernetes:Secret"
+	// +optional
+	TLSSecret string `json:"tlsSecret,omitempty"`
+	// Path prefix the service is exposed on. Defaults to "/".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Path"
+	// +kubebuilder:validation:Pattern=`^/.*`
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+// GetHost ...
+func (i *Ingress) GetHost() string {
+	return i.Host
+}
+
+// SetHost ...
+func (i *Ingress) SetHost(host string) {
+	i.Host = host
+}
+
+// GetIngressClassName ...
+func (i *Ingress) GetIngressClassName() string {
+	return i.IngressClassName
+}
+
+// SetIngressClassName ...
+func (i *Ingress) SetIngressClassName(ingressClassName string) {
+	i.IngressClassName = ingressClassName
+}
+
+// GetTLSSecret ...
+func (i *Ingress) GetTLSSecret() string {
+	return i.TLSSecret
+}
+
+// SetTLSSecret ...
+func (i *Ingress) SetTLSSecret(tlsSecret string) {
+	i.TLSSecret = tlsSecret
+}
+
+// GetPath ...
+func (i *Ingress) GetPath() string {
+	return i.Path
+}
+
+// SetPath ...
+func (i *Ingress) SetPath(path string) {
+	i.Path = path
+}
@@ -0,0 +1,135 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1beta1
+
+import (
+	api "github.com/kiegroup/kogito-operator/apis"
+)
+
+// KafkaTopics configures the Kafka topics provisioned by the operator for the service.
+// +k8s:openapi-gen=true
+// +operator-sdk:csv:customresourcedefinitions:displayName="Kafka Topics"
+type KafkaTopics struct {
+	// Defines what happens to the provisioned topics when the service is deleted.
+	// Retain keeps them in the cluster, Delete removes the ones no longer used by other services.
+	// Adopted topics, and topics of Kafka clusters not managed by Strimzi, are never deleted.
+	//
+	// Default value: Retain
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Deletion Policy"
+	// +kubebuilder:validation:Enum=Retain;Delete
+	// +optional
+	DeletionPolicy api.KafkaTopicDeletionPolicy `json:"deletionPolicy,omitempty"`
+	// Configuration of the topics required by the service. Topics not listed here are created with one partition and one replica.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Topics"
+	// +listType=atomic
+	// +optional
+	Topics []KafkaTopic `json:"topics,omitempty"`
+}
+
+// GetDeletionPolicy ...
+func (k *KafkaTopics) GetDeletionPolicy() api.KafkaTopicDeletionPolicy {
+	if len(k.DeletionPolicy) == 0 {
+		return api.RetainKafkaTopicDeletionPolicy
+	}
+	return k.DeletionPolicy
+}
+
+// SetDeletionPolicy ...
+func (k *KafkaTopics) SetDeletionPolicy(deletionPolicy api.KafkaTopicDeletionPolicy) {
+	k.DeletionPolicy = deletionPolicy
+}
+
+// GetTopics ...
+func (k *KafkaTopics) GetTopics() []api.KafkaTopicInterface {
+	topics := make([]api.KafkaTopicInterface, len(k.Topics))
+	for i, v := range k.Topics {
+		topics[i] = api.KafkaTopicInterface(v)
+	}
+	return topics
+}
+
+// KafkaTopic configures one or more Kafka topics required by the service.
+// +k8s:openapi-gen=true
+type KafkaTopic struct {
+	// Name of the topic, or a shell pattern matching many topic names, e.g. "orders-*".
+	// When many entries match a topic, the exact name wins over the first matching pattern.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Number of partitions of the topic.
+	//
+	// Default value: 1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Partitions *int32 `json:"partitions,omitempty"`
+	// Number of replicas of each partition.
+	//
+	// Default value: 1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ReplicationFactor *int32 `json:"replicationFactor,omitempty"`
+	// Topic configuration overrides, e.g. "retention.ms: 604800000".
+	// +optional
+	Config map[string]string `json:"config,omitempty"`
+}
+
+// GetName ...
+func (k KafkaTopic) GetName() string {
+	return k.Name
+}
+
+// GetPartitions ...
+func (k KafkaTopic) GetPartitions() *int32 {
+	return k.Partitions
+}
+
+// GetReplicationFactor ...
+func (k KafkaTopic) GetReplicationFactor() *int32 {
+	return k.ReplicationFactor
+}
+
+// GetConfig ...
+func (k KafkaTopic) GetConfig() map[string]string {
+	return k.Config
+}
+
+// KafkaTopicStatus describes a Kafka topic bound to the service.
+type KafkaTopicStatus struct {
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Name string `json:"name"`
+	// Namespace of the KafkaTopic resource
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Namespace string `json:"namespace"`
+	// Provisioned when the topic has been created by the operator, Adopted when it already existed
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	State api.KafkaTopicState `json:"state"`
+}
+
+// GetName ...
+func (k KafkaTopicStatus) GetName() string {
+	return k.Name
+}
+
+// GetNamespace ...
+func (k KafkaTopicStatus) GetNamespace() string {
+	return k.Namespace
+}
+
+// GetState ...
+func (k KafkaTopicStatus) GetState() api.KafkaTopicState {
+	return k.State
+}
@@ -0,0 +1,73 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1beta1
+
+// KnativeServing configures the Knative Serving Service of a KogitoRuntime deployed with the KnativeServing mode.
+// +k8s:openapi-gen=true
+// +operator-sdk:csv:customresourcedefinitions:displayName="Knative Serving"
+type KnativeServing struct {
+	// Lower limit for the number of pods of the service. Zero lets the service scale to zero when it receives no requests.
+	//
+	// Default value: 0
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Min Scale"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:podCount"
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinScale *int32 `json:"minScale,omitempty"`
+	// Upper limit for the number of pods of the service. Unlimited when not given.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Max Scale"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:podCount"
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxScale *int32 `json:"maxScale,omitempty"`
+	// Maximum number of requests handled at the same time by each pod. Zero, the default, doesn't limit them.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Container Concurrency"
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ContainerConcurrency *int64 `json:"containerConcurrency,omitempty"`
+}
+
+// GetMinScale ...
+func (k *KnativeServing) GetMinScale() *int32 {
+	return k.MinScale
+}
+
+// SetMinScale ...
+func (k *KnativeServing) SetMinScale(minScale *int32) {
+	k.MinScale = minScale
+}
+
+// GetMaxScale ...
+func (k *KnativeServing) GetMaxScale() *int32 {
+	return k.MaxScale
+}
+
+// SetMaxScale ...
+func (k *KnativeServing) SetMaxScale(maxScale *int32) {
+	k.MaxScale = maxScale
+}
+
+// GetContainerConcurrency ...
+func (k *KnativeServing) GetContainerConcurrency() *int64 {
+	return k.ContainerConcurrency
+}
+
+// SetContainerConcurrency ...
+func (k *KnativeServing) SetContainerConcurrency(containerConcurrency *int64) {
+	k.ContainerConcurrency = containerConcurrency
+}
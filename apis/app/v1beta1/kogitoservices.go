@@ -118,6 +118,11 @@ type KogitoCloudEventsStatus struct {
 	// +listType=atomic
 	// +operator-sdk:csv:customresourcedefinitions:type=status
 	Produces []KogitoCloudEventInfo `json:"produces,omitempty"`
+	// Kafka topics provisioned or adopted for the service
+	// +optional
+	// +listType=atomic
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Topics []KafkaTopicStatus `json:"topics,omitempty"`
 }
 
 // GetConsumes ...
@@ -160,6 +165,37 @@ func (k *KogitoCloudEventsStatus) SetProduces(produces []api.KogitoCloudEventInf
 	k.Produces = newProduces
 }
 
+// GetTopics ...
+func (k *KogitoCloudEventsStatus) GetTopics() []api.KafkaTopicStatusInterface {
+	topics := make([]api.KafkaTopicStatusInterface, len(k.Topics))
+	for i, v := range k.Topics {
+		topics[i] = api.KafkaTopicStatusInterface(v)
+	}
+	return topics
+}
+
+// SetTopic adds the given topic to the status or updates its state if it's already there.
+func (k *KogitoCloudEventsStatus) SetTopic(name, namespace string, state api.KafkaTopicState) {
+	for i, topic := range k.Topics {
+		if topic.Name == name && topic.Namespace == namespace {
+			k.Topics[i].State = state
+			return
+		}
+	}
+	k.Topics = append(k.Topics, KafkaTopicStatus{Name: name, Namespace: namespace, State: state})
+}
+
+// RemoveTopic removes the given topic from the status.
+func (k *KogitoCloudEventsStatus) RemoveTopic(name, namespace string) {
+	var topics []KafkaTopicStatus
+	for _, topic := range k.Topics {
+		if topic.Name != name || topic.Namespace != namespace {
+			topics = append(topics, topic)
+		}
+	}
+	k.Topics = topics
+}
+
 // KogitoCloudEventInfo describes the CloudEvent information based on the specification
 type KogitoCloudEventInfo struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=status
@@ -272,7 +308,7 @@ type KogitoServiceSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:io.kubernetes:Secret"
 	TrustStoreSecret string `json:"trustStoreSecret,omitempty"`
 
-	// A flag indicating that routes are disabled. Usable just on OpenShift.
+	// A flag indicating that routes are disabled. On Kubernetes, it disables the Ingress as well.
 	//
 	// If not provided, defaults to 'false'.
 	// +optional
@@ -280,6 +316,107 @@ type KogitoServiceSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="DisableRoute"
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch"
 	DisableRoute bool `json:"disableRoute,omitempty"`
+
+	// Ingress exposing the service outside the cluster. Usable just on Kubernetes, on OpenShift a Route is created instead.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Ingress Ingress `json:"ingress,omitempty"`
+
+	// Autoscaling of the service with a HorizontalPodAutoscaler. When enabled, the replicas field is ignored.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Autoscaling Autoscaling `json:"autoscaling,omitempty"`
+
+	// A flag indicating that the pods are not restarted when the ConfigMaps or Secrets consumed by the service change.
+	//
+	// If not provided, defaults to 'false'.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="DisableConfigRollout"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch"
+	DisableConfigRollout bool `json:"disableConfigRollout,omitempty"`
+
+	// Configuration and lifecycle of the Kafka topics provisioned for the service through a Kafka KogitoInfra.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	KafkaTopics KafkaTopics `json:"kafkaTopics,omitempty"`
+
+	// Delivery options of the Knative Triggers created for the events consumed by the service through a Knative Eventing KogitoInfra.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	KnativeTriggerDelivery KnativeTriggerDelivery `json:"knativeTriggerDelivery,omitempty"`
+
+	// Node labels the pods of the service must match to be scheduled on a node.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Node Selector"
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations of the pods of the service, allowing them to be scheduled on tainted nodes.
+	// +optional
+	// +listType=atomic
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// Node affinity and pod (anti-)affinity rules of the pods of the service.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:nodeAffinity"
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+
+	// Spreading of the pods of the service across topology domains, such as zones or nodes.
+	// The label selector of a constraint defaults to the pods of the service.
+	// +optional
+	// +listType=atomic
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// Name of the PriorityClass of the pods of the service.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Priority Class Name"
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// PodDisruptionBudget limiting the voluntary disruptions of the pods of the service, e.g. during node drains.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	PodDisruptionBudget PodDisruptionBudget `json:"podDisruptionBudget,omitempty"`
+
+	// Overlay merged into the pod template generated by the operator, e.g. to add init containers, sidecars or volumes.
+	//
+	// It's applied as a strategic merge patch: containers and volumes are merged by name.
+	// The main container, named after the service, only accepts additional env, envFrom, volumeMounts, securityContext and lifecycle.
+	// +optional
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	PodTemplate *corev1.PodTemplateSpec `json:"podTemplate,omitempty"`
+
+	// Name of the ServiceAccount the pods of the service run with.
+	//
+	// If not provided, KogitoRuntimes run with the "kogito-service-viewer" ServiceAccount and the other services with the namespace default one.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Service Account Name"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:io.kubernetes:ServiceAccount"
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// A flag indicating that the operator creates the ServiceAccount of the service, named after serviceAccountName or the service itself.
+	// For KogitoRuntimes, the ServiceAccount is granted the "kogito-service-viewer" Role used by the service discovery.
+	//
+	// If not provided, defaults to 'false'.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Create Service Account"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch"
+	CreateServiceAccount bool `json:"createServiceAccount,omitempty"`
+
+	// Secrets of type "kubernetes.io/dockerconfigjson" holding the credentials to pull the images of the service.
+	// +optional
+	// +listType=atomic
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
 }
 
 // GetReplicas ...
@@ -447,3 +584,163 @@ func (k *KogitoServiceSpec) IsRouteDisabled() bool {
 func (k *KogitoServiceSpec) SetDisableRoute(disableRoute bool) {
 	k.DisableRoute = disableRoute
 }
+
+// IsConfigRolloutDisabled ...
+func (k *KogitoServiceSpec) IsConfigRolloutDisabled() bool {
+	return k.DisableConfigRollout
+}
+
+// SetDisableConfigRollout ...
+func (k *KogitoServiceSpec) SetDisableConfigRollout(disableConfigRollout bool) {
+	k.DisableConfigRollout = disableConfigRollout
+}
+
+// GetKafkaTopics ...
+func (k *KogitoServiceSpec) GetKafkaTopics() api.KafkaTopicsInterface {
+	return &k.KafkaTopics
+}
+
+// SetKafkaTopics ...
+func (k *KogitoServiceSpec) SetKafkaTopics(kafkaTopics api.KafkaTopicsInterface) {
+	if newKafkaTopics, ok := kafkaTopics.(*KafkaTopics); ok {
+		k.KafkaTopics = *newKafkaTopics
+	}
+}
+
+// GetIngress ...
+func (k *KogitoServiceSpec) GetIngress() api.IngressInterface {
+	return &k.Ingress
+}
+
+// SetIngress ...
+func (k *KogitoServiceSpec) SetIngress(ingress api.IngressInterface) {
+	if newIngress, ok := ingress.(*Ingress); ok {
+		k.Ingress = *newIngress
+	}
+}
+
+// GetKnativeTriggerDelivery ...
+func (k *KogitoServiceSpec) GetKnativeTriggerDelivery() api.KnativeTriggerDeliveryInterface {
+	return &k.KnativeTriggerDelivery
+}
+
+// SetKnativeTriggerDelivery ...
+func (k *KogitoServiceSpec) SetKnativeTriggerDelivery(delivery api.KnativeTriggerDeliveryInterface) {
+	if newDelivery, ok := delivery.(*KnativeTriggerDelivery); ok {
+		k.KnativeTriggerDelivery = *newDelivery
+	}
+}
+
+// GetAutoscaling ...
+func (k *KogitoServiceSpec) GetAutoscaling() api.AutoscalingInterface {
+	return &k.Autoscaling
+}
+
+// SetAutoscaling ...
+func (k *KogitoServiceSpec) SetAutoscaling(autoscaling api.AutoscalingInterface) {
+	if newAutoscaling, ok := autoscaling.(*Autoscaling); ok {
+		k.Autoscaling = *newAutoscaling
+	}
+}
+
+// GetNodeSelector ...
+func (k *KogitoServiceSpec) GetNodeSelector() map[string]string {
+	return k.NodeSelector
+}
+
+// SetNodeSelector ...
+func (k *KogitoServiceSpec) SetNodeSelector(nodeSelector map[string]string) {
+	k.NodeSelector = nodeSelector
+}
+
+// GetTolerations ...
+func (k *KogitoServiceSpec) GetTolerations() []corev1.Toleration {
+	return k.Tolerations
+}
+
+// SetTolerations ...
+func (k *KogitoServiceSpec) SetTolerations(tolerations []corev1.Toleration) {
+	k.Tolerations = tolerations
+}
+
+// GetAffinity ...
+func (k *KogitoServiceSpec) GetAffinity() *corev1.Affinity {
+	return k.Affinity
+}
+
+// SetAffinity ...
+func (k *KogitoServiceSpec) SetAffinity(affinity *corev1.Affinity) {
+	k.Affinity = affinity
+}
+
+// GetTopologySpreadConstraints ...
+func (k *KogitoServiceSpec) GetTopologySpreadConstraints() []corev1.TopologySpreadConstraint {
+	return k.TopologySpreadConstraints
+}
+
+// SetTopologySpreadConstraints ...
+func (k *KogitoServiceSpec) SetTopologySpreadConstraints(constraints []corev1.TopologySpreadConstraint) {
+	k.TopologySpreadConstraints = constraints
+}
+
+// GetPriorityClassName ...
+func (k *KogitoServiceSpec) GetPriorityClassName() string {
+	return k.PriorityClassName
+}
+
+// SetPriorityClassName ...
+func (k *KogitoServiceSpec) SetPriorityClassName(priorityClassName string) {
+	k.PriorityClassName = priorityClassName
+}
+
+// GetPodDisruptionBudget ...
+func (k *KogitoServiceSpec) GetPodDisruptionBudget() api.PodDisruptionBudgetInterface {
+	return &k.PodDisruptionBudget
+}
+
+// SetPodDisruptionBudget ...
+func (k *KogitoServiceSpec) SetPodDisruptionBudget(podDisruptionBudget api.PodDisruptionBudgetInterface) {
+	if newPodDisruptionBudget, ok := podDisruptionBudget.(*PodDisruptionBudget); ok {
+		k.PodDisruptionBudget = *newPodDisruptionBudget
+	}
+}
+
+// GetPodTemplate ...
+func (k *KogitoServiceSpec) GetPodTemplate() *corev1.PodTemplateSpec {
+	return k.PodTemplate
+}
+
+// SetPodTemplate ...
+func (k *KogitoServiceSpec) SetPodTemplate(podTemplate *corev1.PodTemplateSpec) {
+	k.PodTemplate = podTemplate
+}
+
+// GetServiceAccountName ...
+func (k *KogitoServiceSpec) GetServiceAccountName() string {
+	return k.ServiceAccountName
+}
+
+// SetServiceAccountName ...
+func (k *KogitoServiceSpec) SetServiceAccountName(serviceAccountName string) {
+	k.ServiceAccountName = serviceAccountName
+}
+
+// IsCreateServiceAccount ...
+func (k *KogitoServiceSpec) IsCreateServiceAccount() bool {
+	return k.CreateServiceAccount
+}
+
+// SetCreateServiceAccount ...
+func (k *KogitoServiceSpec) SetCreateServiceAccount(createServiceAccount bool) {
+	k.CreateServiceAccount = createServiceAccount
+}
+
+// GetImagePullSecrets ...
+func (k *KogitoServiceSpec) GetImagePullSecrets() []corev1.LocalObjectReference {
+	return k.ImagePullSecrets
+}
+
+// SetImagePullSecrets ...
+func (k *KogitoServiceSpec) SetImagePullSecrets(imagePullSecrets []corev1.LocalObjectReference) {
+	k.ImagePullSecrets = imagePullSecrets
+}
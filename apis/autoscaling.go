@@ -0,0 +1,40 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+import "k8s.io/apimachinery/pkg/api/resource"
+
+// AutoscalingInterface ...
+type AutoscalingInterface interface {
+	IsEnabled() bool
+	GetMinReplicas() *int32
+	SetMinReplicas(minReplicas *int32)
+	GetMaxReplicas() int32
+	SetMaxReplicas(maxReplicas int32)
+	GetTargetCPUUtilizationPercentage() *int32
+	SetTargetCPUUtilizationPercentage(target *int32)
+	GetTargetMemoryUtilizationPercentage() *int32
+	SetTargetMemoryUtilizationPercentage(target *int32)
+	GetMetrics() []AutoscalingMetricInterface
+}
+
+// AutoscalingMetricInterface ...
+type AutoscalingMetricInterface interface {
+	GetName() string
+	GetTargetAverageValue() resource.Quantity
+}
@@ -0,0 +1,60 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+// KafkaTopicDeletionPolicy defines what happens to the Kafka topics provisioned for a service when it's deleted
+type KafkaTopicDeletionPolicy string
+
+const (
+	// RetainKafkaTopicDeletionPolicy keeps the provisioned topics in the cluster
+	RetainKafkaTopicDeletionPolicy KafkaTopicDeletionPolicy = "Retain"
+	// DeleteKafkaTopicDeletionPolicy deletes the provisioned topics that are no longer used by other services
+	DeleteKafkaTopicDeletionPolicy KafkaTopicDeletionPolicy = "Delete"
+)
+
+// KafkaTopicState describes how a Kafka topic has been bound to a service
+type KafkaTopicState string
+
+const (
+	// ProvisionedKafkaTopicState is a topic created and managed by the operator
+	ProvisionedKafkaTopicState KafkaTopicState = "Provisioned"
+	// AdoptedKafkaTopicState is a topic that already existed in the cluster and is left untouched by the operator
+	AdoptedKafkaTopicState KafkaTopicState = "Adopted"
+)
+
+// KafkaTopicsInterface ...
+type KafkaTopicsInterface interface {
+	GetDeletionPolicy() KafkaTopicDeletionPolicy
+	SetDeletionPolicy(deletionPolicy KafkaTopicDeletionPolicy)
+	GetTopics() []KafkaTopicInterface
+}
+
+// KafkaTopicInterface ...
+type KafkaTopicInterface interface {
+	GetName() string
+	GetPartitions() *int32
+	GetReplicationFactor() *int32
+	GetConfig() map[string]string
+}
+
+// KafkaTopicStatusInterface ...
+type KafkaTopicStatusInterface interface {
+	GetName() string
+	GetNamespace() string
+	GetState() KafkaTopicState
+}
@@ -0,0 +1,38 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+// DeploymentMode defines how a Kogito Runtime is deployed in the cluster.
+type DeploymentMode string
+
+const (
+	// DeploymentDeploymentMode deploys the service with a Deployment and a Service, it's the default mode.
+	DeploymentDeploymentMode DeploymentMode = "Deployment"
+	// KnativeServingDeploymentMode deploys the service as a Knative Serving Service, able to scale to zero.
+	KnativeServingDeploymentMode DeploymentMode = "KnativeServing"
+)
+
+// KnativeServingInterface ...
+type KnativeServingInterface interface {
+	GetMinScale() *int32
+	SetMinScale(minScale *int32)
+	GetMaxScale() *int32
+	SetMaxScale(maxScale *int32)
+	GetContainerConcurrency() *int64
+	SetContainerConcurrency(containerConcurrency *int64)
+}
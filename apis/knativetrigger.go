@@ -0,0 +1,50 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+// KnativeBackoffPolicy is the policy applied by Knative Eventing between two delivery attempts of an event
+type KnativeBackoffPolicy string
+
+const (
+	// LinearKnativeBackoffPolicy waits backoffDelay * <number of retries> before retrying
+	LinearKnativeBackoffPolicy KnativeBackoffPolicy = "linear"
+	// ExponentialKnativeBackoffPolicy waits backoffDelay * 2^<number of retries> before retrying
+	ExponentialKnativeBackoffPolicy KnativeBackoffPolicy = "exponential"
+)
+
+// KnativeTriggerDeliveryInterface ...
+type KnativeTriggerDeliveryInterface interface {
+	IsEmpty() bool
+	GetRetry() *int32
+	SetRetry(retry *int32)
+	GetBackoffPolicy() KnativeBackoffPolicy
+	SetBackoffPolicy(backoffPolicy KnativeBackoffPolicy)
+	GetBackoffDelay() string
+	SetBackoffDelay(backoffDelay string)
+	GetDeadLetterSink() KnativeDeadLetterSinkInterface
+}
+
+// KnativeDeadLetterSinkInterface ...
+type KnativeDeadLetterSinkInterface interface {
+	IsEmpty() bool
+	GetAPIVersion() string
+	GetKind() string
+	GetName() string
+	GetNamespace() string
+	GetURI() string
+}
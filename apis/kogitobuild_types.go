@@ -121,6 +121,12 @@ type KogitoBuildSpecInterface interface {
 	SetArtifact(artifact ArtifactInterface)
 	IsEnableMavenDownloadOutput() bool
 	SetEnableMavenDownloadOutput(enableMavenDownloadOutput bool)
+	GetRegistry() ImageRegistryInterface
+	SetRegistry(registry ImageRegistryInterface)
+	GetServiceAccountName() string
+	SetServiceAccountName(serviceAccountName string)
+	GetImagePullSecrets() []corev1.LocalObjectReference
+	SetImagePullSecrets(imagePullSecrets []corev1.LocalObjectReference)
 }
 
 // KogitoBuildStatusInterface ...
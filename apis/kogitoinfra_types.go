@@ -49,6 +49,8 @@ const (
 	ResourceConfigError KogitoInfraConditionReason = "ResourceConfigError"
 	// ResourceMissingResourceConfig related resource is missing a config information to continue
 	ResourceMissingResourceConfig KogitoInfraConditionReason = "ResourceMissingConfig"
+	// ResourceNamespaceNotWatched related resource is deployed in a namespace not watched by the operator
+	ResourceNamespaceNotWatched KogitoInfraConditionReason = "ResourceNamespaceNotWatched"
 	// ResourceSuccessfullyConfigured ..
 	ResourceSuccessfullyConfigured KogitoInfraConditionReason = "ResourceSuccessfullyConfigured"
 )
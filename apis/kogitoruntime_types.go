@@ -40,11 +40,20 @@ type KogitoRuntimeListInterface interface {
 // KogitoRuntimeSpecInterface ...
 type KogitoRuntimeSpecInterface interface {
 	KogitoServiceSpecInterface
+	SetRuntime(runtime RuntimeType)
 	IsEnableIstio() bool
 	SetEnableIstio(enableIstio bool)
+	GetRollout() RolloutInterface
+	SetRollout(rollout RolloutInterface)
+	GetDeploymentMode() DeploymentMode
+	SetDeploymentMode(deploymentMode DeploymentMode)
+	GetKnativeServing() KnativeServingInterface
+	SetKnativeServing(knativeServing KnativeServingInterface)
 }
 
 // KogitoRuntimeStatusInterface ...
 type KogitoRuntimeStatusInterface interface {
 	KogitoServiceStatusInterface
+	GetRollout() RolloutStatusInterface
+	SetRollout(rollout RolloutStatusInterface)
 }
@@ -76,6 +76,16 @@ type KogitoServiceSpecInterface interface {
 	GetRuntime() RuntimeType
 	IsRouteDisabled() bool
 	SetDisableRoute(disableRoute bool)
+	IsConfigRolloutDisabled() bool
+	SetDisableConfigRollout(disableConfigRollout bool)
+	GetIngress() IngressInterface
+	SetIngress(ingress IngressInterface)
+	GetAutoscaling() AutoscalingInterface
+	SetAutoscaling(autoscaling AutoscalingInterface)
+	GetKafkaTopics() KafkaTopicsInterface
+	SetKafkaTopics(kafkaTopics KafkaTopicsInterface)
+	GetKnativeTriggerDelivery() KnativeTriggerDeliveryInterface
+	SetKnativeTriggerDelivery(delivery KnativeTriggerDeliveryInterface)
 	IsInsecureImageRegistry() bool
 	GetPropertiesConfigMap() string
 	GetInfra() []string
@@ -87,6 +97,26 @@ type KogitoServiceSpecInterface interface {
 	SetProbes(probes KogitoProbeInterface)
 	GetTrustStoreSecret() string
 	SetTrustStoreSecret(trustStore string)
+	GetNodeSelector() map[string]string
+	SetNodeSelector(nodeSelector map[string]string)
+	GetTolerations() []corev1.Toleration
+	SetTolerations(tolerations []corev1.Toleration)
+	GetAffinity() *corev1.Affinity
+	SetAffinity(affinity *corev1.Affinity)
+	GetTopologySpreadConstraints() []corev1.TopologySpreadConstraint
+	SetTopologySpreadConstraints(constraints []corev1.TopologySpreadConstraint)
+	GetPriorityClassName() string
+	SetPriorityClassName(priorityClassName string)
+	GetPodDisruptionBudget() PodDisruptionBudgetInterface
+	SetPodDisruptionBudget(podDisruptionBudget PodDisruptionBudgetInterface)
+	GetPodTemplate() *corev1.PodTemplateSpec
+	SetPodTemplate(podTemplate *corev1.PodTemplateSpec)
+	GetServiceAccountName() string
+	SetServiceAccountName(serviceAccountName string)
+	IsCreateServiceAccount() bool
+	SetCreateServiceAccount(createServiceAccount bool)
+	GetImagePullSecrets() []corev1.LocalObjectReference
+	SetImagePullSecrets(imagePullSecrets []corev1.LocalObjectReference)
 }
 
 // KogitoServiceStatusInterface defines the basic interface for the Kogito Service status.
@@ -111,6 +141,9 @@ type KogitoCloudEventsStatusInterface interface {
 	SetConsumes(consumes []KogitoCloudEventInfoInterface)
 	GetProduces() []KogitoCloudEventInfoInterface
 	SetProduces(produces []KogitoCloudEventInfoInterface)
+	GetTopics() []KafkaTopicStatusInterface
+	SetTopic(name, namespace string, state KafkaTopicState)
+	RemoveTopic(name, namespace string)
 }
 
 // KogitoCloudEventInfoInterface ...
@@ -0,0 +1,37 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+const (
+	// ReconcileAnnotation is the annotation set on a Kogito resource to control its reconciliation.
+	// Set it to "paused" to stop the operator from reconciling the resource, e.g. while debugging its Deployment by hand.
+	ReconcileAnnotation = "kogito.kie.org/reconcile"
+	// ReconcilePaused is the ReconcileAnnotation value to pause the reconciliation until the annotation is removed
+	ReconcilePaused = "paused"
+	// PausedConditionType - The reconciliation of the resource is paused by the ReconcileAnnotation
+	PausedConditionType = "Paused"
+	// ReconcilePausedReason - The resource has the ReconcileAnnotation set to "paused"
+	ReconcilePausedReason = "ReconcilePausedByAnnotation"
+)
+
+// IsReconcilePaused returns true if the given resource has the ReconcileAnnotation set to "paused"
+func IsReconcilePaused(object metav1.Object) bool {
+	return object.GetAnnotations()[ReconcileAnnotation] == ReconcilePaused
+}
@@ -0,0 +1,139 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1
+
+import (
+	"github.com/kiegroup/kogito-operator/apis"
+)
+
+// KnativeTriggerDelivery configures how Knative Eventing delivers the consumed events to the service.
+// It's applied to every Trigger created for the service.
+// +k8s:openapi-gen=true
+// +operator-sdk:csv:customresourcedefinitions:displayName="Knative Trigger Delivery"
+type KnativeTriggerDelivery struct {
+	// Minimum number of retries before the event is sent to the dead letter sink.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Retry"
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Retry *int32 `json:"retry,omitempty"`
+	// Backoff policy applied between two retries, linear or exponential.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Backoff Policy"
+	// +kubebuilder:validation:Enum=linear;exponential
+	// +optional
+	BackoffPolicy api.KnativeBackoffPolicy `json:"backoffPolicy,omitempty"`
+	// Delay before retrying, as an ISO-8601 duration, e.g. "PT0.5S".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Backoff Delay"
+	// +optional
+	BackoffDelay string `json:"backoffDelay,omitempty"`
+	// Sink receiving the events that could not be delivered to the service.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Dead Letter Sink"
+	// +optional
+	DeadLetterSink KnativeDeadLetterSink `json:"deadLetterSink,omitempty"`
+}
+
+// IsEmpty ...
+func (k *KnativeTriggerDelivery) IsEmpty() bool {
+	return k.Retry == nil && len(k.BackoffPolicy) == 0 && len(k.BackoffDelay) == 0 && k.DeadLetterSink.IsEmpty()
+}
+
+// GetRetry ...
+func (k *KnativeTriggerDelivery) GetRetry() *int32 {
+	return k.Retry
+}
+
+// SetRetry ...
+func (k *KnativeTriggerDelivery) SetRetry(retry *int32) {
+	k.Retry = retry
+}
+
+// GetBackoffPolicy ...
+func (k *KnativeTriggerDelivery) GetBackoffPolicy() api.KnativeBackoffPolicy {
+	return k.BackoffPolicy
+}
+
+// SetBackoffPolicy ...
+func (k *KnativeTriggerDelivery) SetBackoffPolicy(backoffPolicy api.KnativeBackoffPolicy) {
+	k.BackoffPolicy = backoffPolicy
+}
+
+// GetBackoffDelay ...
+func (k *KnativeTriggerDelivery) GetBackoffDelay() string {
+	return k.BackoffDelay
+}
+
+// SetBackoffDelay ...
+func (k *KnativeTriggerDelivery) SetBackoffDelay(backoffDelay string) {
+	k.BackoffDelay = backoffDelay
+}
+
+// GetDeadLetterSink ...
+func (k *KnativeTriggerDelivery) GetDeadLetterSink() api.KnativeDeadLetterSinkInterface {
+	return &k.DeadLetterSink
+}
+
+// KnativeDeadLetterSink is the destination of the events that could not be delivered.
+// Either a reference to an addressable resource, e.g. a Knative Service, or an URI.
+// When both are given, the URI is resolved relative to the resource address.
+// +k8s:openapi-gen=true
+type KnativeDeadLetterSink struct {
+	// APIVersion of the referenced resource, e.g. "serving.knative.dev/v1".
+	// +optional
+	APIVersion string `json:"apiVersion,omitempty"`
+	// Kind of the referenced resource, e.g. "Service".
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// Name of the referenced resource.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Namespace of the referenced resource. Defaults to the namespace of the service.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// URI of the sink, e.g. "http://event-display.kogito.svc.cluster.local".
+	// +optional
+	URI string `json:"uri,omitempty"`
+}
+
+// IsEmpty ...
+func (k *KnativeDeadLetterSink) IsEmpty() bool {
+	return len(k.Name) == 0 && len(k.URI) == 0
+}
+
+// GetAPIVersion ...
+func (k *KnativeDeadLetterSink) GetAPIVersion() string {
+	return k.APIVersion
+}
+
+// GetKind ...
+func (k *KnativeDeadLetterSink) GetKind() string {
+	return k.Kind
+}
+
+// GetName ...
+func (k *KnativeDeadLetterSink) GetName() string {
+	return k.Name
+}
+
+// GetNamespace ...
+func (k *KnativeDeadLetterSink) GetNamespace() string {
+	return k.Namespace
+}
+
+// GetURI ...
+func (k *KnativeDeadLetterSink) GetURI() string {
+	return k.URI
+}
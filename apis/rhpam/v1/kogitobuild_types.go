@@ -153,6 +153,34 @@ type KogitoBuildSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Enable Maven Download Output"
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch"
 	EnableMavenDownloadOutput bool `json:"enableMavenDownloadOutput,omitempty"`
+
+	// Registry where the final Kogito service image is pushed to. Usable just on Kubernetes.
+	//
+	// On Kubernetes builds are executed as Jobs by an in-cluster image builder, the final image will be named after the
+	// target KogitoRuntime and pushed to this registry, e.g. "quay.io/mynamespace/my-service:latest".
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Image Registry"
+	Registry ImageRegistry `json:"registry,omitempty"`
+
+	// Name of the ServiceAccount the builds run with.
+	//
+	// If not provided, OpenShift builds run with the "builder" ServiceAccount and Kubernetes builds with the namespace default one.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Service Account Name"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:io.kubernetes:ServiceAccount"
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// Secrets of type "kubernetes.io/dockerconfigjson" holding the credentials to pull the builder and runtime base images.
+	//
+	// OpenShift builds use the first secret to pull the base image. On Kubernetes, the in-cluster image builder uses the
+	// first secret to pull the runtime base image when no push secret is given in the registry, otherwise the push secret
+	// must hold the credentials of both registries.
+	// +optional
+	// +listType=atomic
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
 }
 
 // AddResourceRequest adds new resource request. Works also on an uninitialized Requests field.
@@ -327,7 +355,35 @@ func (k *KogitoBuildSpec) SetEnableMavenDownloadOutput(enableMavenDownloadOutput
 	k.EnableMavenDownloadOutput = enableMavenDownloadOutput
 }
 
+// GetRegistry ...
+func (k *KogitoBuildSpec) GetRegistry() api.ImageRegistryInterface {
+	return &k.Registry
+}
+
+// SetRegistry ...
+func (k *KogitoBuildSpec) SetRegistry(registry api.ImageRegistryInterface) {
+	if newRegistry, ok := registry.(*ImageRegistry); ok {
+		k.Registry = *newRegistry
+	}
+}
+
 // KogitoBuildStatus defines the observed state of KogitoBuild.
+func (k *KogitoBuildSpec) GetServiceAccountName() string {
+	return k.ServiceAccountName
+}
+
+func (k *KogitoBuildSpec) SetServiceAccountName(serviceAccountName string) {
+	k.ServiceAccountName = serviceAccountName
+}
+
+func (k *KogitoBuildSpec) GetImagePullSecrets() []corev1.LocalObjectReference {
+	return k.ImagePullSecrets
+}
+
+func (k *KogitoBuildSpec) SetImagePullSecrets(imagePullSecrets []corev1.LocalObjectReference) {
+	k.ImagePullSecrets = imagePullSecrets
+}
+
 // +k8s:openapi-gen=true
 type KogitoBuildStatus struct {
 	// +listType=atomic
@@ -485,6 +541,7 @@ func (b *Builds) SetCancelled(cancelled []string) {
 // +kubebuilder:printcolumn:name="Git Repository",type="string",JSONPath=".spec.gitSource.uri",description="Git repository URL (RemoteSource builds only)"
 // +operator-sdk:csv:customresourcedefinitions:resources={{ImageStream,image.openshift.io/v1," A Openshift Image Stream"}}
 // +operator-sdk:csv:customresourcedefinitions:resources={{BuildConfig,build.openshift.io/v1," A Openshift Build Config"}}
+// +operator-sdk:csv:customresourcedefinitions:resources={{Job,batch/v1,"A Kubernetes Job"}}
 // +operator-sdk:csv:customresourcedefinitions:displayName="Kogito Build"
 
 // KogitoBuild handles how to build a custom Kogito service in a Kubernetes/OpenShift cluster.
@@ -39,6 +39,29 @@ type KogitoRuntimeSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Runtime"
 	// +kubebuilder:validation:Enum=quarkus;springboot
 	Runtime api.RuntimeType `json:"runtime,omitempty"`
+
+	// Defines how a new version of the service is rolled out.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Rollout"
+	// +optional
+	Rollout Rollout `json:"rollout,omitempty"`
+
+	// How the service is deployed, either with a Deployment or as a Knative Serving Service able to scale to zero.
+	// The KnativeServing mode requires Knative Serving in the cluster, the service is exposed through the Knative URL
+	// instead of a Route or an Ingress, and it doesn't support autoscaling nor the BlueGreen and Canary rollouts.
+	//
+	// Default value: Deployment
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Deployment Mode"
+	// +kubebuilder:validation:Enum=Deployment;KnativeServing
+	// +optional
+	DeploymentMode api.DeploymentMode `json:"deploymentMode,omitempty"`
+
+	// Configuration of the Knative Serving Service, used with the KnativeServing deployment mode.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Knative Serving"
+	// +optional
+	KnativeServing KnativeServing `json:"knativeServing,omitempty"`
 }
 
 // GetRuntime ...
@@ -49,6 +72,11 @@ func (k *KogitoRuntimeSpec) GetRuntime() api.RuntimeType {
 	return k.Runtime
 }
 
+// SetRuntime ...
+func (k *KogitoRuntimeSpec) SetRuntime(runtime api.RuntimeType) {
+	k.Runtime = runtime
+}
+
 // IsEnableIstio ...
 func (k *KogitoRuntimeSpec) IsEnableIstio() bool {
 	return k.EnableIstio
@@ -59,9 +87,64 @@ func (k *KogitoRuntimeSpec) SetEnableIstio(enableIstio bool) {
 	k.EnableIstio = enableIstio
 }
 
+// GetRollout ...
+func (k *KogitoRuntimeSpec) GetRollout() api.RolloutInterface {
+	return &k.Rollout
+}
+
+// SetRollout ...
+func (k *KogitoRuntimeSpec) SetRollout(rollout api.RolloutInterface) {
+	if newRollout, ok := rollout.(*Rollout); ok {
+		k.Rollout = *newRollout
+	}
+}
+
+// GetDeploymentMode ...
+func (k *KogitoRuntimeSpec) GetDeploymentMode() api.DeploymentMode {
+	if len(k.DeploymentMode) == 0 {
+		return api.DeploymentDeploymentMode
+	}
+	return k.DeploymentMode
+}
+
+// SetDeploymentMode ...
+func (k *KogitoRuntimeSpec) SetDeploymentMode(deploymentMode api.DeploymentMode) {
+	k.DeploymentMode = deploymentMode
+}
+
+// GetKnativeServing ...
+func (k *KogitoRuntimeSpec) GetKnativeServing() api.KnativeServingInterface {
+	return &k.KnativeServing
+}
+
+// SetKnativeServing ...
+func (k *KogitoRuntimeSpec) SetKnativeServing(knativeServing api.KnativeServingInterface) {
+	if newKnativeServing, ok := knativeServing.(*KnativeServing); ok {
+		k.KnativeServing = *newKnativeServing
+	}
+}
+
 // KogitoRuntimeStatus defines the observed state of KogitoRuntime.
 type KogitoRuntimeStatus struct {
 	KogitoServiceStatus `json:",inline"`
+
+	// Progress of the rollout of the service when the BlueGreen or Canary strategy is used.
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Rollout"
+	// +optional
+	Rollout RolloutStatus `json:"rollout,omitempty"`
+}
+
+// GetRollout ...
+func (k *KogitoRuntimeStatus) GetRollout() api.RolloutStatusInterface {
+	return &k.Rollout
+}
+
+// SetRollout ...
+func (k *KogitoRuntimeStatus) SetRollout(rollout api.RolloutStatusInterface) {
+	if newRollout, ok := rollout.(*RolloutStatus); ok {
+		k.Rollout = *newRollout
+	}
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,62 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// PodDisruptionBudget defines the PodDisruptionBudget managed by the operator for the pods of the service.
+// It's enabled when either minAvailable or maxUnavailable is set.
+// +k8s:openapi-gen=true
+// +operator-sdk:csv:customresourcedefinitions:displayName="Pod Disruption Budget"
+type PodDisruptionBudget struct {
+	// Number or percentage of pods that must still be available after an eviction. Can't be set along with maxUnavailable.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Min Available"
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+	// Number or percentage of pods that can be unavailable after an eviction. Can't be set along with minAvailable.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Max Unavailable"
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// IsEnabled ...
+func (p *PodDisruptionBudget) IsEnabled() bool {
+	return p.MinAvailable != nil || p.MaxUnavailable != nil
+}
+
+// GetMinAvailable ...
+func (p *PodDisruptionBudget) GetMinAvailable() *intstr.IntOrString {
+	return p.MinAvailable
+}
+
+// SetMinAvailable ...
+func (p *PodDisruptionBudget) SetMinAvailable(minAvailable *intstr.IntOrString) {
+	p.MinAvailable = minAvailable
+}
+
+// GetMaxUnavailable ...
+func (p *PodDisruptionBudget) GetMaxUnavailable() *intstr.IntOrString {
+	return p.MaxUnavailable
+}
+
+// SetMaxUnavailable ...
+func (p *PodDisruptionBudget) SetMaxUnavailable(maxUnavailable *intstr.IntOrString) {
+	p.MaxUnavailable = maxUnavailable
+}
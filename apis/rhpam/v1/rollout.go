@@ -0,0 +1,189 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1
+
+import (
+	"github.com/kiegroup/kogito-operator/apis"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const defaultCanaryWeight int32 = 10
+
+// Rollout defines how a new version of the service is rolled out.
+// +k8s:openapi-gen=true
+// +operator-sdk:csv:customresourcedefinitions:displayName="Rollout"
+type Rollout struct {
+	// Strategy used to roll out a new version of the service, either RollingUpdate, BlueGreen or Canary.
+	// BlueGreen and Canary deploy the new version side by side with the stable one in the "<name>-candidate" Deployment.
+	// Promote or abort them by annotating the KogitoRuntime with "kogito.kie.org/rollout-action" set to "promote" or "abort".
+	//
+	// Default value: RollingUpdate
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Strategy"
+	// +kubebuilder:validation:Enum=RollingUpdate;BlueGreen;Canary
+	// +optional
+	Strategy api.RolloutStrategyType `json:"strategy,omitempty"`
+	// With the BlueGreen strategy, switches the traffic to the new version as soon as it's ready instead of waiting for a promotion.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Auto Promote"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch"
+	// +optional
+	AutoPromote bool `json:"autoPromote,omitempty"`
+	// With the Canary strategy, percentage of the traffic sent to the new version until it's promoted.
+	// Canary rollouts split the traffic with Route weights on OpenShift and with an Istio VirtualService on Kubernetes.
+	//
+	// Default value: 10
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Canary Weight"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:number"
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=99
+	// +optional
+	CanaryWeight int32 `json:"canaryWeight,omitempty"`
+}
+
+// GetStrategy ...
+func (r *Rollout) GetStrategy() api.RolloutStrategyType {
+	if len(r.Strategy) == 0 {
+		return api.RollingUpdateRolloutStrategy
+	}
+	return r.Strategy
+}
+
+// SetStrategy ...
+func (r *Rollout) SetStrategy(strategy api.RolloutStrategyType) {
+	r.Strategy = strategy
+}
+
+// IsAutoPromote ...
+func (r *Rollout) IsAutoPromote() bool {
+	return r.AutoPromote
+}
+
+// SetAutoPromote ...
+func (r *Rollout) SetAutoPromote(autoPromote bool) {
+	r.AutoPromote = autoPromote
+}
+
+// GetCanaryWeight ...
+func (r *Rollout) GetCanaryWeight() int32 {
+	if r.CanaryWeight <= 0 {
+		return defaultCanaryWeight
+	}
+	return r.CanaryWeight
+}
+
+// SetCanaryWeight ...
+func (r *Rollout) SetCanaryWeight(canaryWeight int32) {
+	r.CanaryWeight = canaryWeight
+}
+
+// RolloutStatus is the progress of the rollout of a new version of the service.
+// +k8s:openapi-gen=true
+type RolloutStatus struct {
+	// Phase of the rollout.
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Phase"
+	// +optional
+	Phase api.RolloutPhase `json:"phase,omitempty"`
+	// Revision of the pod template served by the stable Deployment.
+	// +optional
+	StableRevision string `json:"stableRevision,omitempty"`
+	// Revision of the pod template being rolled out in the candidate Deployment.
+	// +optional
+	CandidateRevision string `json:"candidateRevision,omitempty"`
+	// Revision of the last aborted rollout. It won't be rolled out again until the service changes.
+	// +optional
+	AbortedRevision string `json:"abortedRevision,omitempty"`
+	// Percentage of the traffic currently sent to the candidate Deployment.
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Canary Weight"
+	// +optional
+	CanaryWeight int32 `json:"canaryWeight,omitempty"`
+	// Human-readable message describing the rollout phase.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Last time the phase changed.
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// GetPhase ...
+func (r *RolloutStatus) GetPhase() api.RolloutPhase {
+	return r.Phase
+}
+
+// SetPhase ...
+func (r *RolloutStatus) SetPhase(phase api.RolloutPhase) {
+	r.Phase = phase
+}
+
+// GetStableRevision ...
+func (r *RolloutStatus) GetStableRevision() string {
+	return r.StableRevision
+}
+
+// SetStableRevision ...
+func (r *RolloutStatus) SetStableRevision(revision string) {
+	r.StableRevision = revision
+}
+
+// GetCandidateRevision ...
+func (r *RolloutStatus) GetCandidateRevision() string {
+	return r.CandidateRevision
+}
+
+// SetCandidateRevision ...
+func (r *RolloutStatus) SetCandidateRevision(revision string) {
+	r.CandidateRevision = revision
+}
+
+// GetAbortedRevision ...
+func (r *RolloutStatus) GetAbortedRevision() string {
+	return r.AbortedRevision
+}
+
+// SetAbortedRevision ...
+func (r *RolloutStatus) SetAbortedRevision(revision string) {
+	r.AbortedRevision = revision
+}
+
+// GetCanaryWeight ...
+func (r *RolloutStatus) GetCanaryWeight() int32 {
+	return r.CanaryWeight
+}
+
+// SetCanaryWeight ...
+func (r *RolloutStatus) SetCanaryWeight(canaryWeight int32) {
+	r.CanaryWeight = canaryWeight
+}
+
+// GetMessage ...
+func (r *RolloutStatus) GetMessage() string {
+	return r.Message
+}
+
+// SetMessage ...
+func (r *RolloutStatus) SetMessage(message string) {
+	r.Message = message
+}
+
+// GetLastTransitionTime ...
+func (r *RolloutStatus) GetLastTransitionTime() *metav1.Time {
+	return r.LastTransitionTime
+}
+
+// SetLastTransitionTime ...
+func (r *RolloutStatus) SetLastTransitionTime(lastTransitionTime *metav1.Time) {
+	r.LastTransitionTime = lastTransitionTime
+}
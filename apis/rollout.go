@@ -0,0 +1,87 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// RolloutStrategyType is the strategy used to roll out a new version of a Kogito Runtime.
+type RolloutStrategyType string
+
+const (
+	// RollingUpdateRolloutStrategy replaces the pods of the service in place, it's the default strategy.
+	RollingUpdateRolloutStrategy RolloutStrategyType = "RollingUpdate"
+	// BlueGreenRolloutStrategy deploys the new version side by side and switches all the traffic to it once it's ready.
+	BlueGreenRolloutStrategy RolloutStrategyType = "BlueGreen"
+	// CanaryRolloutStrategy deploys the new version side by side and sends a share of the traffic to it.
+	CanaryRolloutStrategy RolloutStrategyType = "Canary"
+)
+
+// RolloutPhase is the phase of a rollout.
+type RolloutPhase string
+
+const (
+	// RolloutProgressingPhase - the new version is being deployed and doesn't receive traffic yet
+	RolloutProgressingPhase RolloutPhase = "Progressing"
+	// RolloutAwaitingPromotionPhase - the new version is ready and waits to be promoted.
+	// With the Canary strategy, it receives its share of the traffic in this phase.
+	RolloutAwaitingPromotionPhase RolloutPhase = "AwaitingPromotion"
+	// RolloutPromotingPhase - the new version has been promoted and replaces the stable one
+	RolloutPromotingPhase RolloutPhase = "Promoting"
+	// RolloutCompletedPhase - the stable version runs the latest revision
+	RolloutCompletedPhase RolloutPhase = "Completed"
+	// RolloutAbortedPhase - the new version has been discarded, the stable one keeps serving the traffic
+	RolloutAbortedPhase RolloutPhase = "Aborted"
+)
+
+const (
+	// RolloutActionAnnotation is the annotation set on a Kogito Runtime to promote or abort the rollout in progress.
+	// The operator removes it once the action has been taken.
+	RolloutActionAnnotation = "kogito.kie.org/rollout-action"
+	// RolloutActionPromote is the RolloutActionAnnotation value to promote the new version
+	RolloutActionPromote = "promote"
+	// RolloutActionAbort is the RolloutActionAnnotation value to abort the rollout
+	RolloutActionAbort = "abort"
+)
+
+// RolloutInterface ...
+type RolloutInterface interface {
+	GetStrategy() RolloutStrategyType
+	SetStrategy(strategy RolloutStrategyType)
+	IsAutoPromote() bool
+	SetAutoPromote(autoPromote bool)
+	GetCanaryWeight() int32
+	SetCanaryWeight(canaryWeight int32)
+}
+
+// RolloutStatusInterface ...
+type RolloutStatusInterface interface {
+	GetPhase() RolloutPhase
+	SetPhase(phase RolloutPhase)
+	GetStableRevision() string
+	SetStableRevision(revision string)
+	GetCandidateRevision() string
+	SetCandidateRevision(revision string)
+	GetAbortedRevision() string
+	SetAbortedRevision(revision string)
+	GetCanaryWeight() int32
+	SetCanaryWeight(canaryWeight int32)
+	GetMessage() string
+	SetMessage(message string)
+	GetLastTransitionTime() *metav1.Time
+	SetLastTransitionTime(lastTransitionTime *metav1.Time)
+}
@@ -21,9 +21,14 @@ import (
 	"github.com/kiegroup/kogito-operator/cmd/kogito/command/completion"
 	"github.com/kiegroup/kogito-operator/cmd/kogito/command/context"
 	"github.com/kiegroup/kogito-operator/cmd/kogito/command/deploy"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/describe"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/get"
 	"github.com/kiegroup/kogito-operator/cmd/kogito/command/install"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/logs"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/pause"
 	"github.com/kiegroup/kogito-operator/cmd/kogito/command/project"
 	"github.com/kiegroup/kogito-operator/cmd/kogito/command/remove"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/wait"
 	"github.com/kiegroup/kogito-operator/core/client"
 	"github.com/kiegroup/kogito-operator/meta"
 	"github.com/spf13/cobra"
@@ -46,6 +51,11 @@ func BuildCommands(kubeClient *client.Client, output io.Writer) *cobra.Command {
 	install.BuildCommands(ctx, rootCommand.Command())
 	remove.BuildCommands(ctx, rootCommand.Command())
 	project.BuildCommands(ctx, rootCommand.Command())
+	get.BuildCommands(ctx, rootCommand.Command())
+	describe.BuildCommands(ctx, rootCommand.Command())
+	logs.BuildCommands(ctx, rootCommand.Command())
+	wait.BuildCommands(ctx, rootCommand.Command())
+	pause.BuildCommands(ctx, rootCommand.Command())
 
 	return rootCommand.Command()
 }
@@ -35,6 +35,11 @@ var (
 	logVerbose    bool
 )
 
+// GetOutputFormat retrieves the output format defined by the user in the command line
+func GetOutputFormat() string {
+	return outputFormat
+}
+
 // GetDefaultLogger retrieves the default logger
 func GetDefaultLogger() *zap.SugaredLogger {
 	return getDefaultLoggerWithOut(logVerbose, outputFormat, commandOutput)
@@ -43,7 +48,7 @@ func GetDefaultLogger() *zap.SugaredLogger {
 // GetDefaultLoggerWithOut returns a logger set to a given output
 func getDefaultLoggerWithOut(verbose bool, outputFormat string, commandOutput io.Writer) *zap.SugaredLogger {
 	var badOutputFormatMsg string
-	if len(outputFormat) > 0 && outputFormat != "json" {
+	if len(outputFormat) > 0 && outputFormat != "json" && outputFormat != "yaml" {
 		badOutputFormatMsg = "'" + outputFormat + "' is not a supported output format"
 		outputFormat = ""
 	}
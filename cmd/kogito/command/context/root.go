@@ -67,7 +67,7 @@ func (i *rootCommand) RegisterHook() {
 
 func (i *rootCommand) InitHook() {
 	i.flags = rootCommandFlags{}
-	i.command.PersistentFlags().StringVarP(&outputFormat, "output", "o", "", "output format (when defined, 'json' is supported)")
+	i.command.PersistentFlags().StringVarP(&outputFormat, "output", "o", "", "output format (when defined, 'json' and 'yaml' are supported)")
 	i.command.PersistentFlags().BoolVarP(&logVerbose, "verbose", "v", false, "verbose output")
 	i.command.PersistentFlags().Bool("version", false, "display version")
 	i.command.Version = version.Version
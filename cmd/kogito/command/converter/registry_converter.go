@@ -0,0 +1,32 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package converter
+
+import (
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/flag"
+)
+
+// FromRegistryFlagsToImageRegistry converts given RegistryFlags into ImageRegistry
+func FromRegistryFlagsToImageRegistry(flags *flag.RegistryFlags) v1beta1.ImageRegistry {
+	return v1beta1.ImageRegistry{
+		URL:      flags.RegistryURL,
+		Secret:   flags.RegistrySecret,
+		Insecure: flags.RegistryInsecure,
+	}
+}
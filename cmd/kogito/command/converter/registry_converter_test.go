@@ -0,0 +1,37 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package converter
+
+import (
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/flag"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_FromRegistryFlagsToImageRegistry(t *testing.T) {
+	flags := &flag.RegistryFlags{
+		RegistryURL:      "quay.io/mynamespace",
+		RegistrySecret:   "my-push-secret",
+		RegistryInsecure: true,
+	}
+
+	registry := FromRegistryFlagsToImageRegistry(flags)
+	assert.Equal(t, "quay.io/mynamespace", registry.URL)
+	assert.Equal(t, "my-push-secret", registry.Secret)
+	assert.True(t, registry.Insecure)
+}
@@ -0,0 +1,242 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package describe
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/context"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/shared"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const emptyValue = "<none>"
+
+type describeFlags struct {
+	name    string
+	project string
+}
+
+type describeCommand struct {
+	context.CommandContext
+	command              *cobra.Command
+	flags                *describeFlags
+	Parent               *cobra.Command
+	resourceCheckService shared.ResourceCheckService
+}
+
+func initDescribeCommand(ctx *context.CommandContext, parent *cobra.Command) context.KogitoCommand {
+	cmd := &describeCommand{
+		CommandContext:       *ctx,
+		Parent:               parent,
+		resourceCheckService: shared.NewResourceCheckService(),
+	}
+	cmd.RegisterHook()
+	cmd.InitHook()
+	return cmd
+}
+
+func (i *describeCommand) Command() *cobra.Command {
+	return i.command
+}
+
+func (i *describeCommand) RegisterHook() {
+	i.command = &cobra.Command{
+		Example: "describe example-quarkus --project kogito",
+		Use:     "describe NAME [flags]",
+		Short:   "Show the details of a Kogito Runtime or Kogito Supporting Service",
+		Long: `describe prints the status of the Kogito Runtime or Kogito Supporting Service with the given name: conditions, deployment and route conditions, resolved image, external URI,
+consumed and produced CloudEvents and the status of every bound Kogito Infra.`,
+		RunE:    i.Exec,
+		PreRun:  i.CommonPreRun,
+		PostRun: i.CommonPostRun,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("requires 1 arg, received %v", len(args))
+			}
+			return nil
+		},
+	}
+}
+
+func (i *describeCommand) InitHook() {
+	i.flags = &describeFlags{}
+	i.Parent.AddCommand(i.command)
+	i.command.Flags().StringVarP(&i.flags.project, "project", "p", "", "The project context name where the service is deployed")
+}
+
+func (i *describeCommand) Exec(cmd *cobra.Command, args []string) (err error) {
+	i.flags.name = args[0]
+	if i.flags.project, err = i.resourceCheckService.EnsureProject(i.Client, i.flags.project); err != nil {
+		return err
+	}
+	service, kind, err := i.fetchKogitoService()
+	if err != nil {
+		return err
+	}
+	infras, err := i.fetchKogitoInfras(service)
+	if err != nil {
+		return err
+	}
+	return printKogitoService(cmd.OutOrStdout(), kind, service, infras)
+}
+
+// fetchKogitoService looks for a KogitoRuntime with the given name, then for a KogitoSupportingService
+func (i *describeCommand) fetchKogitoService() (api.KogitoService, string, error) {
+	log := context.GetDefaultLogger()
+	candidates := []struct {
+		kind    string
+		service api.KogitoService
+	}{
+		{kind: "KogitoRuntime", service: &v1beta1.KogitoRuntime{}},
+		{kind: "KogitoSupportingService", service: &v1beta1.KogitoSupportingService{}},
+	}
+	for _, candidate := range candidates {
+		candidate.service.SetName(i.flags.name)
+		candidate.service.SetNamespace(i.flags.project)
+		if exists, err := kubernetes.ResourceC(i.Client).Fetch(candidate.service); err != nil {
+			return nil, "", err
+		} else if exists {
+			return candidate.service, candidate.kind, nil
+		}
+		log.Debugf("%s with name '%s' not found in the project context (namespace) '%s'", candidate.kind, i.flags.name, i.flags.project)
+	}
+	return nil, "", fmt.Errorf("Looks like a Kogito Runtime or Kogito Supporting Service with the name '%s' doesn't exist in the project context (namespace) '%s'. Please try another name ", i.flags.name, i.flags.project)
+}
+
+// fetchKogitoInfras fetches every KogitoInfra bound to the given service, nil values stand for the ones not found
+func (i *describeCommand) fetchKogitoInfras(service api.KogitoService) (map[string]*v1beta1.KogitoInfra, error) {
+	infras := map[string]*v1beta1.KogitoInfra{}
+	for _, name := range service.GetSpec().GetInfra() {
+		infra := &v1beta1.KogitoInfra{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: service.GetNamespace()}}
+		if exists, err := kubernetes.ResourceC(i.Client).Fetch(infra); err != nil {
+			return nil, err
+		} else if exists {
+			infras[name] = infra
+		} else {
+			infras[name] = nil
+		}
+	}
+	return infras, nil
+}
+
+func printKogitoService(out io.Writer, kind string, service api.KogitoService, infras map[string]*v1beta1.KogitoInfra) error {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	status := service.GetStatus()
+	printField(w, "Name:", service.GetName())
+	printField(w, "Namespace:", service.GetNamespace())
+	printField(w, "Kind:", kind)
+	printField(w, "Image:", status.GetImage())
+	printField(w, "External URI:", status.GetExternalURI())
+
+	fmt.Fprintln(w, "Conditions:")
+	printConditions(w, status.GetConditions())
+
+	fmt.Fprintln(w, "Deployment Conditions:")
+	if len(status.GetDeploymentConditions()) == 0 {
+		fmt.Fprintf(w, "  %s\n", emptyValue)
+	} else {
+		fmt.Fprintln(w, "  TYPE\tSTATUS\tREASON\tMESSAGE")
+		for _, condition := range status.GetDeploymentConditions() {
+			printRow(w, string(condition.Type), string(condition.Status), condition.Reason, condition.Message)
+		}
+	}
+
+	fmt.Fprintln(w, "Route Conditions:")
+	printConditions(w, status.GetRouteConditions())
+
+	fmt.Fprintln(w, "CloudEvents:")
+	var consumes, produces []api.KogitoCloudEventInfoInterface
+	if status.GetCloudEvents() != nil {
+		consumes = status.GetCloudEvents().GetConsumes()
+		produces = status.GetCloudEvents().GetProduces()
+	}
+	if len(consumes) == 0 && len(produces) == 0 {
+		fmt.Fprintf(w, "  %s\n", emptyValue)
+	} else {
+		fmt.Fprintln(w, "  DIRECTION\tTYPE\tSOURCE")
+		for _, event := range consumes {
+			printRow(w, "Consumes", event.GetType(), event.GetSource())
+		}
+		for _, event := range produces {
+			printRow(w, "Produces", event.GetType(), event.GetSource())
+		}
+	}
+
+	fmt.Fprintln(w, "Infra:")
+	if len(service.GetSpec().GetInfra()) == 0 {
+		fmt.Fprintf(w, "  %s\n", emptyValue)
+	} else {
+		fmt.Fprintln(w, "  NAME\tRESOURCE\tCONFIGURED\tREASON\tMESSAGE")
+		for _, name := range service.GetSpec().GetInfra() {
+			infra := infras[name]
+			if infra == nil {
+				printRow(w, name, "", "", "NotFound", "Kogito Infra not found in the project context (namespace)")
+				continue
+			}
+			var resource string
+			if infra.Spec.Resource != nil {
+				resource = fmt.Sprintf("%s/%s", infra.Spec.Resource.Kind, infra.Spec.Resource.Name)
+			}
+			var conditionStatus, reason, message string
+			if infra.Status.Conditions != nil {
+				for _, condition := range *infra.Status.Conditions {
+					if condition.Type == string(api.KogitoInfraConfigured) {
+						conditionStatus, reason, message = string(condition.Status), condition.Reason, condition.Message
+					}
+				}
+			}
+			printRow(w, name, resource, conditionStatus, reason, message)
+		}
+	}
+	return w.Flush()
+}
+
+func printField(w io.Writer, name, value string) {
+	if len(value) == 0 {
+		value = emptyValue
+	}
+	fmt.Fprintf(w, "%s\t%s\n", name, value)
+}
+
+func printConditions(w io.Writer, conditions *[]metav1.Condition) {
+	if conditions == nil || len(*conditions) == 0 {
+		fmt.Fprintf(w, "  %s\n", emptyValue)
+		return
+	}
+	fmt.Fprintln(w, "  TYPE\tSTATUS\tREASON\tMESSAGE\tLAST TRANSITION")
+	for _, condition := range *conditions {
+		printRow(w, condition.Type, string(condition.Status), condition.Reason, condition.Message, condition.LastTransitionTime.String())
+	}
+}
+
+func printRow(w io.Writer, values ...string) {
+	for i, value := range values {
+		if len(value) == 0 {
+			values[i] = emptyValue
+		}
+	}
+	fmt.Fprintf(w, "  %s\n", strings.Join(values, "\t"))
+}
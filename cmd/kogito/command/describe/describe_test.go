@@ -0,0 +1,103 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package describe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/context"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/test"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_DescribeCmd_KogitoRuntime(t *testing.T) {
+	ns := t.Name()
+	cli := fmt.Sprintf("describe example-quarkus --project %s", ns)
+	ctx := test.SetupCliTest(cli,
+		context.CommandFactory{BuildCommands: BuildCommands},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}},
+		&v1beta1.KogitoRuntime{
+			ObjectMeta: metav1.ObjectMeta{Name: "example-quarkus", Namespace: ns},
+			Spec: v1beta1.KogitoRuntimeSpec{KogitoServiceSpec: v1beta1.KogitoServiceSpec{
+				Infra: []string{"kafka-infra", "missing-infra"},
+			}},
+			Status: v1beta1.KogitoRuntimeStatus{KogitoServiceStatus: v1beta1.KogitoServiceStatus{
+				Image:                "quay.io/kiegroup/example-quarkus:latest",
+				ExternalURI:          "http://example-quarkus.apps.cluster",
+				Conditions:           &[]metav1.Condition{{Type: string(api.DeployedConditionType), Status: metav1.ConditionTrue, Reason: "Deployed"}},
+				DeploymentConditions: []appsv1.DeploymentCondition{{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue, Reason: "MinimumReplicasAvailable"}},
+				CloudEvents: v1beta1.KogitoCloudEventsStatus{
+					Consumes: []v1beta1.KogitoCloudEventInfo{{Type: "travellers", Source: "/travels"}},
+					Produces: []v1beta1.KogitoCloudEventInfo{{Type: "processedtravellers"}},
+				},
+			}},
+		},
+		&v1beta1.KogitoInfra{
+			ObjectMeta: metav1.ObjectMeta{Name: "kafka-infra", Namespace: ns},
+			Spec:       v1beta1.KogitoInfraSpec{Resource: &v1beta1.InfraResource{Kind: "Kafka", Name: "kafka"}},
+			Status: v1beta1.KogitoInfraStatus{
+				Conditions: &[]metav1.Condition{{Type: string(api.KogitoInfraConfigured), Status: metav1.ConditionTrue, Reason: "Configured"}},
+			},
+		})
+
+	lines, _, err := ctx.ExecuteCli()
+	assert.NoError(t, err)
+	assert.Contains(t, lines, "KogitoRuntime")
+	assert.Contains(t, lines, "quay.io/kiegroup/example-quarkus:latest")
+	assert.Contains(t, lines, "http://example-quarkus.apps.cluster")
+	assert.Contains(t, lines, "MinimumReplicasAvailable")
+	assert.Regexp(t, `Consumes\s+travellers\s+/travels`, lines)
+	assert.Regexp(t, `Produces\s+processedtravellers`, lines)
+	assert.Regexp(t, `kafka-infra\s+Kafka/kafka\s+True\s+Configured`, lines)
+	assert.Regexp(t, `missing-infra\s+<none>\s+<none>\s+NotFound`, lines)
+}
+
+func Test_DescribeCmd_KogitoSupportingService(t *testing.T) {
+	ns := t.Name()
+	cli := fmt.Sprintf("describe data-index --project %s", ns)
+	ctx := test.SetupCliTest(cli,
+		context.CommandFactory{BuildCommands: BuildCommands},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}},
+		&v1beta1.KogitoSupportingService{
+			ObjectMeta: metav1.ObjectMeta{Name: "data-index", Namespace: ns},
+			Spec:       v1beta1.KogitoSupportingServiceSpec{ServiceType: api.DataIndex},
+		})
+
+	lines, _, err := ctx.ExecuteCli()
+	assert.NoError(t, err)
+	assert.Contains(t, lines, "KogitoSupportingService")
+	assert.Regexp(t, `Route Conditions:\s+<none>`, lines)
+}
+
+func Test_DescribeCmd_NotFound(t *testing.T) {
+	ns := t.Name()
+	cli := fmt.Sprintf("describe example-quarkus --project %s", ns)
+	ctx := test.SetupCliTest(cli,
+		context.CommandFactory{BuildCommands: BuildCommands},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}})
+
+	_, _, err := ctx.ExecuteCli()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "with the name 'example-quarkus' doesn't exist")
+}
@@ -49,6 +49,7 @@ type BuildFlags struct {
 	ArtifactFlags
 	WebHookFlags
 	EnvVarFlags
+	RegistryFlags
 	Name                      string
 	Project                   string
 	IncrementalBuild          bool
@@ -67,6 +68,7 @@ func AddBuildFlags(command *cobra.Command, flags *BuildFlags) {
 	AddArtifactFlags(command, &flags.ArtifactFlags)
 	AddWebHookFlags(command, &flags.WebHookFlags)
 	AddEnvVarFlags(command, &flags.EnvVarFlags, "build-env", "")
+	AddRegistryFlags(command, &flags.RegistryFlags)
 	command.Flags().BoolVar(&flags.IncrementalBuild, "incremental-build", true, "Build should be incremental?")
 	command.Flags().BoolVar(&flags.Native, "native", false, "Use native builds? Be aware that native builds takes more time and consume much more resources from the cluster. Defaults to false. Currently only works with s2i (requires [SOURCE] argument).")
 	command.Flags().StringVar(&flags.MavenMirrorURL, "maven-mirror-url", "", "Internal Maven Mirror to be used during source-to-image builds to considerably increase build speed, e.g: https://my.internal.nexus/content/group/public")
@@ -99,6 +101,9 @@ func CheckBuildArgs(flags *BuildFlags) error {
 	if err := CheckEnvVarArgs(&flags.EnvVarFlags); err != nil {
 		return err
 	}
+	if err := CheckRegistryArgs(&flags.RegistryFlags); err != nil {
+		return err
+	}
 	if len(flags.MavenMirrorURL) > 0 {
 		if _, err := url.ParseRequestURI(flags.MavenMirrorURL); err != nil {
 			return err
@@ -0,0 +1,47 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package flag
+
+import (
+	"github.com/spf13/cobra"
+	"net/url"
+)
+
+// RegistryFlags is common properties used to configure the registry where Kubernetes builds push the final image
+type RegistryFlags struct {
+	RegistryURL      string
+	RegistrySecret   string
+	RegistryInsecure bool
+}
+
+// AddRegistryFlags adds the registry flags to the given command
+func AddRegistryFlags(command *cobra.Command, flags *RegistryFlags) {
+	command.Flags().StringVar(&flags.RegistryURL, "registry-url", "", "Registry and namespace where the built image is pushed on Kubernetes, e.g: quay.io/mynamespace. Required on Kubernetes, ignored on OpenShift")
+	command.Flags().StringVar(&flags.RegistrySecret, "registry-secret", "", "Name of the docker config secret holding the credentials to push the built image on Kubernetes")
+	command.Flags().BoolVar(&flags.RegistryInsecure, "registry-insecure", false, "Push the built image to an insecure (plain HTTP or self signed) registry on Kubernetes. Defaults to false")
+}
+
+// CheckRegistryArgs validates the RegistryFlags flags
+func CheckRegistryArgs(flags *RegistryFlags) error {
+	if len(flags.RegistryURL) > 0 {
+		if _, err := url.Parse("docker://" + flags.RegistryURL); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package get
+
+import (
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/context"
+	"github.com/spf13/cobra"
+)
+
+type getCommand struct {
+	context.CommandContext
+	command *cobra.Command
+	Parent  *cobra.Command
+}
+
+func initGetCommand(ctx *context.CommandContext, parent *cobra.Command) context.KogitoCommand {
+	cmd := getCommand{
+		CommandContext: *ctx,
+		Parent:         parent,
+	}
+	cmd.RegisterHook()
+	cmd.InitHook()
+	return &cmd
+}
+
+func (i *getCommand) Command() *cobra.Command {
+	return i.command
+}
+
+func (i *getCommand) RegisterHook() {
+	i.command = &cobra.Command{
+		Use:    "get",
+		Short:  "Display one or many Kogito resources deployed in your Kogito project",
+		Long:   `get prints a table with the most important information about the Kogito custom resources. Use '--output json' or '--output yaml' to print the whole resources instead.`,
+		PreRun: i.CommonPreRun,
+	}
+}
+
+func (i *getCommand) InitHook() {
+	i.Parent.AddCommand(i.command)
+}
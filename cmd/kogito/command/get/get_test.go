@@ -0,0 +1,129 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package get
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/context"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/test"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_GetKogitoRuntimesCmd_Table(t *testing.T) {
+	ns := t.Name()
+	replicas := int32(2)
+	cli := fmt.Sprintf("get runtimes --project %s", ns)
+	ctx := test.SetupCliTest(cli,
+		context.CommandFactory{BuildCommands: BuildCommands},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}},
+		&v1beta1.KogitoRuntime{
+			ObjectMeta: metav1.ObjectMeta{Name: "example-quarkus", Namespace: ns},
+			Spec:       v1beta1.KogitoRuntimeSpec{KogitoServiceSpec: v1beta1.KogitoServiceSpec{Replicas: &replicas}},
+			Status: v1beta1.KogitoRuntimeStatus{KogitoServiceStatus: v1beta1.KogitoServiceStatus{
+				Image:       "quay.io/kiegroup/example-quarkus:latest",
+				ExternalURI: "http://example-quarkus.apps.cluster",
+				Conditions:  &[]metav1.Condition{{Type: string(api.DeployedConditionType), Status: metav1.ConditionTrue}},
+			}},
+		})
+
+	lines, _, err := ctx.ExecuteCli()
+	assert.NoError(t, err)
+	assert.Contains(t, lines, "NAME")
+	assert.Contains(t, lines, "example-quarkus")
+	assert.Contains(t, lines, "quay.io/kiegroup/example-quarkus:latest")
+	assert.Contains(t, lines, "http://example-quarkus.apps.cluster")
+	assert.Regexp(t, `example-quarkus\s+2\s+`, lines)
+}
+
+func Test_GetKogitoRuntimesCmd_NoResources(t *testing.T) {
+	ns := t.Name()
+	cli := fmt.Sprintf("get runtimes --project %s", ns)
+	ctx := test.SetupCliTest(cli,
+		context.CommandFactory{BuildCommands: BuildCommands},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}})
+
+	lines, _, err := ctx.ExecuteCli()
+	assert.NoError(t, err)
+	assert.Contains(t, lines, "No Kogito Runtimes found")
+}
+
+func Test_GetKogitoBuildCmd_JSON(t *testing.T) {
+	ns := t.Name()
+	cli := fmt.Sprintf("get build example-build --project %s -o json", ns)
+	ctx := test.SetupCliTest(cli,
+		context.CommandFactory{BuildCommands: BuildCommands},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}},
+		&v1beta1.KogitoBuild{
+			ObjectMeta: metav1.ObjectMeta{Name: "example-build", Namespace: ns},
+			Spec:       v1beta1.KogitoBuildSpec{Type: api.RemoteSourceBuildType},
+		})
+
+	lines, _, err := ctx.ExecuteCli()
+	assert.NoError(t, err)
+	assert.Contains(t, lines, `"kind": "KogitoBuild"`)
+	assert.Contains(t, lines, `"name": "example-build"`)
+	assert.Contains(t, lines, `"type": "RemoteSource"`)
+}
+
+func Test_GetKogitoInfrasCmd_YAML(t *testing.T) {
+	ns := t.Name()
+	cli := fmt.Sprintf("get infras --project %s -o yaml", ns)
+	ctx := test.SetupCliTest(cli,
+		context.CommandFactory{BuildCommands: BuildCommands},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}},
+		&v1beta1.KogitoInfra{
+			ObjectMeta: metav1.ObjectMeta{Name: "kafka-infra", Namespace: ns},
+			Spec:       v1beta1.KogitoInfraSpec{Resource: &v1beta1.InfraResource{Kind: "Kafka", APIVersion: "kafka.strimzi.io/v1beta2", Name: "kafka"}},
+		})
+
+	lines, _, err := ctx.ExecuteCli()
+	assert.NoError(t, err)
+	assert.Contains(t, lines, "kind: KogitoInfraList")
+	assert.Contains(t, lines, "kind: KogitoInfra\n")
+	assert.Contains(t, lines, "name: kafka-infra")
+}
+
+func Test_GetKogitoSupportingServiceCmd_NotFound(t *testing.T) {
+	ns := t.Name()
+	cli := fmt.Sprintf("get supporting-services data-index --project %s", ns)
+	ctx := test.SetupCliTest(cli,
+		context.CommandFactory{BuildCommands: BuildCommands},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}})
+
+	_, _, err := ctx.ExecuteCli()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Kogito Supporting Service with the name 'data-index' doesn't exist")
+}
+
+func Test_GetKogitoRuntimesCmd_InvalidOutput(t *testing.T) {
+	ns := t.Name()
+	cli := fmt.Sprintf("get runtimes --project %s -o xml", ns)
+	ctx := test.SetupCliTest(cli,
+		context.CommandFactory{BuildCommands: BuildCommands},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}})
+
+	_, _, err := ctx.ExecuteCli()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "output format 'xml' is not supported")
+}
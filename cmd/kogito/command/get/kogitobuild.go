@@ -0,0 +1,65 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package get
+
+import (
+	"strconv"
+
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/context"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func initGetKogitoBuildCommand(ctx *context.CommandContext, parent *cobra.Command) context.KogitoCommand {
+	return initGetResourceCommand(ctx, parent, resourceDescriptor{
+		use:         "builds",
+		aliases:     []string{"build", "kogitobuilds", "kogitobuild"},
+		displayName: "Kogito Build",
+		newObject:   func() client.Object { return &v1beta1.KogitoBuild{} },
+		newList:     func() client.ObjectList { return &v1beta1.KogitoBuildList{} },
+		columns:     []string{"NAME", "TYPE", "RUNTIME", "NATIVE", "KOGITO RUNTIME", "LATEST BUILD", "STATUS", "AGE"},
+		row: func(object client.Object) []string {
+			build := object.(*v1beta1.KogitoBuild)
+			return []string{
+				build.Name,
+				string(build.Spec.Type),
+				string(build.Spec.Runtime),
+				strconv.FormatBool(build.Spec.Native),
+				build.Spec.TargetKogitoRuntime,
+				build.Status.LatestBuild,
+				latestBuildCondition(build),
+				age(build),
+			}
+		},
+	})
+}
+
+// latestBuildCondition returns the type of the most recent condition added to the build status
+func latestBuildCondition(build *v1beta1.KogitoBuild) string {
+	if build.Status.Conditions == nil || len(*build.Status.Conditions) == 0 {
+		return ""
+	}
+	latest := (*build.Status.Conditions)[0]
+	for _, condition := range *build.Status.Conditions {
+		if condition.LastTransitionTime.After(latest.LastTransitionTime.Time) {
+			latest = condition
+		}
+	}
+	return latest.Type
+}
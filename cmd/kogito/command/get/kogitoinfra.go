@@ -0,0 +1,59 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package get
+
+import (
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/context"
+	"github.com/spf13/cobra"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func initGetKogitoInfraCommand(ctx *context.CommandContext, parent *cobra.Command) context.KogitoCommand {
+	return initGetResourceCommand(ctx, parent, resourceDescriptor{
+		use:         "infras",
+		aliases:     []string{"infra", "kogitoinfras", "kogitoinfra"},
+		displayName: "Kogito Infra",
+		newObject:   func() client.Object { return &v1beta1.KogitoInfra{} },
+		newList:     func() client.ObjectList { return &v1beta1.KogitoInfraList{} },
+		columns:     []string{"NAME", "KIND", "RESOURCE NAME", "CONFIGURED", "REASON", "AGE"},
+		row: func(object client.Object) []string {
+			infra := object.(*v1beta1.KogitoInfra)
+			var kind, name, reason string
+			if infra.Spec.Resource != nil {
+				kind = infra.Spec.Resource.Kind
+				name = infra.Spec.Resource.Name
+			}
+			if infra.Status.Conditions != nil {
+				if condition := apimeta.FindStatusCondition(*infra.Status.Conditions, string(api.KogitoInfraConfigured)); condition != nil {
+					reason = condition.Reason
+				}
+			}
+			return []string{
+				infra.Name,
+				kind,
+				name,
+				conditionStatus(infra.Status.Conditions, string(api.KogitoInfraConfigured)),
+				reason,
+				age(infra),
+			}
+		},
+	})
+}
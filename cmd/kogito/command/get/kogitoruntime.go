@@ -0,0 +1,57 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package get
+
+import (
+	"strconv"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/context"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func initGetKogitoRuntimeCommand(ctx *context.CommandContext, parent *cobra.Command) context.KogitoCommand {
+	return initGetResourceCommand(ctx, parent, resourceDescriptor{
+		use:         "runtimes",
+		aliases:     []string{"runtime", "kogitoruntimes", "kogitoruntime"},
+		displayName: "Kogito Runtime",
+		newObject:   func() client.Object { return &v1beta1.KogitoRuntime{} },
+		newList:     func() client.ObjectList { return &v1beta1.KogitoRuntimeList{} },
+		columns:     []string{"NAME", "REPLICAS", "IMAGE", "ENDPOINT", "DEPLOYED", "AGE"},
+		row: func(object client.Object) []string {
+			runtime := object.(*v1beta1.KogitoRuntime)
+			return []string{
+				runtime.Name,
+				replicas(runtime.Spec.Replicas),
+				runtime.Status.Image,
+				runtime.Status.ExternalURI,
+				conditionStatus(runtime.Status.Conditions, string(api.DeployedConditionType)),
+				age(runtime),
+			}
+		},
+	})
+}
+
+func replicas(replicas *int32) string {
+	if replicas == nil {
+		return ""
+	}
+	return strconv.Itoa(int(*replicas))
+}
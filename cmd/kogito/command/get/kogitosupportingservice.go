@@ -0,0 +1,49 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package get
+
+import (
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/context"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func initGetKogitoSupportingServiceCommand(ctx *context.CommandContext, parent *cobra.Command) context.KogitoCommand {
+	return initGetResourceCommand(ctx, parent, resourceDescriptor{
+		use:         "supporting-services",
+		aliases:     []string{"supporting-service", "kogitosupportingservices", "kogitosupportingservice"},
+		displayName: "Kogito Supporting Service",
+		newObject:   func() client.Object { return &v1beta1.KogitoSupportingService{} },
+		newList:     func() client.ObjectList { return &v1beta1.KogitoSupportingServiceList{} },
+		columns:     []string{"NAME", "TYPE", "REPLICAS", "IMAGE", "ENDPOINT", "DEPLOYED", "AGE"},
+		row: func(object client.Object) []string {
+			service := object.(*v1beta1.KogitoSupportingService)
+			return []string{
+				service.Name,
+				string(service.Spec.ServiceType),
+				replicas(service.Spec.Replicas),
+				service.Status.Image,
+				service.Status.ExternalURI,
+				conditionStatus(service.Status.Conditions, string(api.DeployedConditionType)),
+				age(service),
+			}
+		},
+	})
+}
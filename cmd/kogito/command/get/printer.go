@@ -0,0 +1,130 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package get
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/kiegroup/kogito-operator/meta"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	tableOutputFormat = ""
+	jsonOutputFormat  = "json"
+	yamlOutputFormat  = "yaml"
+	emptyValue        = "<none>"
+)
+
+// validateOutputFormat verifies if the given output format is supported by the get command
+func validateOutputFormat(format string) error {
+	switch format {
+	case tableOutputFormat, jsonOutputFormat, yamlOutputFormat:
+		return nil
+	}
+	return fmt.Errorf("output format '%s' is not supported, use one of '%s' or '%s'", format, jsonOutputFormat, yamlOutputFormat)
+}
+
+// printObject prints the given object (a single resource or a list) in JSON or YAML format
+func printObject(out io.Writer, format string, obj runtime.Object) error {
+	if err := setTypeMeta(obj); err != nil {
+		return err
+	}
+	var content []byte
+	var err error
+	if format == jsonOutputFormat {
+		content, err = json.MarshalIndent(obj, "", "    ")
+		content = append(content, '\n')
+	} else {
+		content, err = yaml.Marshal(obj)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = out.Write(content)
+	return err
+}
+
+// printTable prints the given rows as a table aligned by columns
+func printTable(out io.Writer, columns []string, rows [][]string) error {
+	writer := tabwriter.NewWriter(out, 0, 8, 3, ' ', 0)
+	if _, err := fmt.Fprintln(writer, strings.Join(columns, "\t")); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		for i, value := range row {
+			if len(value) == 0 {
+				row[i] = emptyValue
+			}
+		}
+		if _, err := fmt.Fprintln(writer, strings.Join(row, "\t")); err != nil {
+			return err
+		}
+	}
+	return writer.Flush()
+}
+
+// setTypeMeta fills the apiVersion and kind of the given object and of every item if it's a list.
+// Objects read through the typed client don't have this information set.
+func setTypeMeta(obj runtime.Object) error {
+	if err := setGroupVersionKind(obj); err != nil {
+		return err
+	}
+	if !apimeta.IsListType(obj) {
+		return nil
+	}
+	return apimeta.EachListItem(obj, setGroupVersionKind)
+}
+
+func setGroupVersionKind(obj runtime.Object) error {
+	gvk, err := apiutil.GVKForObject(obj, meta.GetRegisteredSchema())
+	if err != nil {
+		return err
+	}
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+	return nil
+}
+
+// age returns a human readable duration since the object creation
+func age(object metav1.Object) string {
+	if object.GetCreationTimestamp().Time.IsZero() {
+		return ""
+	}
+	return duration.HumanDuration(time.Since(object.GetCreationTimestamp().Time))
+}
+
+// conditionStatus returns the status of the condition with the given type, empty if it's not found
+func conditionStatus(conditions *[]metav1.Condition, conditionType string) string {
+	if conditions == nil {
+		return ""
+	}
+	if condition := apimeta.FindStatusCondition(*conditions, conditionType); condition != nil {
+		return string(condition.Status)
+	}
+	return ""
+}
@@ -0,0 +1,153 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package get
+
+import (
+	"fmt"
+
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/context"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/shared"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/spf13/cobra"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// resourceDescriptor describes how a given Kogito custom resource is fetched and printed by the get command
+type resourceDescriptor struct {
+	// use is the name of the sub command
+	use string
+	// aliases for the sub command, usually the singular form and the short name
+	aliases []string
+	// displayName is the human readable name of the resource
+	displayName string
+	// newObject creates an empty instance of the resource
+	newObject func() client.Object
+	// newList creates an empty list of the resource
+	newList func() client.ObjectList
+	// columns are the table headers
+	columns []string
+	// row converts the given resource into a table row, must have the same size of columns
+	row func(object client.Object) []string
+}
+
+type getResourceFlags struct {
+	project string
+}
+
+type getResourceCommand struct {
+	context.CommandContext
+	command              *cobra.Command
+	flags                *getResourceFlags
+	Parent               *cobra.Command
+	descriptor           resourceDescriptor
+	resourceCheckService shared.ResourceCheckService
+}
+
+func initGetResourceCommand(ctx *context.CommandContext, parent *cobra.Command, descriptor resourceDescriptor) context.KogitoCommand {
+	cmd := &getResourceCommand{
+		CommandContext:       *ctx,
+		Parent:               parent,
+		descriptor:           descriptor,
+		resourceCheckService: shared.NewResourceCheckService(),
+	}
+	cmd.RegisterHook()
+	cmd.InitHook()
+	return cmd
+}
+
+func (i *getResourceCommand) Command() *cobra.Command {
+	return i.command
+}
+
+func (i *getResourceCommand) RegisterHook() {
+	i.command = &cobra.Command{
+		Example: fmt.Sprintf("%s --project kogito -o yaml", i.descriptor.use),
+		Use:     fmt.Sprintf("%s [NAME] [flags]", i.descriptor.use),
+		Aliases: i.descriptor.aliases,
+		Short:   fmt.Sprintf("Display one or many %ss deployed in the OpenShift/Kubernetes cluster", i.descriptor.displayName),
+		Long:    fmt.Sprintf(`%s lists every %s in the project context (namespace). When NAME is given, only this resource is displayed.`, i.descriptor.use, i.descriptor.displayName),
+		RunE:    i.Exec,
+		PreRun:  i.CommonPreRun,
+		PostRun: i.CommonPostRun,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 1 {
+				return fmt.Errorf("requires at most 1 arg, received %v", len(args))
+			}
+			return nil
+		},
+	}
+}
+
+func (i *getResourceCommand) InitHook() {
+	i.flags = &getResourceFlags{}
+	i.Parent.AddCommand(i.command)
+	i.command.Flags().StringVarP(&i.flags.project, "project", "p", "", "The project context name from where the resources will be fetched")
+}
+
+func (i *getResourceCommand) Exec(cmd *cobra.Command, args []string) (err error) {
+	log := context.GetDefaultLogger()
+	format := context.GetOutputFormat()
+	if err = validateOutputFormat(format); err != nil {
+		return err
+	}
+	if i.flags.project, err = i.resourceCheckService.EnsureProject(i.Client, i.flags.project); err != nil {
+		return err
+	}
+
+	var result runtime.Object
+	var objects []client.Object
+	if len(args) == 1 {
+		object := i.descriptor.newObject()
+		object.SetName(args[0])
+		object.SetNamespace(i.flags.project)
+		if exists, err := kubernetes.ResourceC(i.Client).Fetch(object); err != nil {
+			return err
+		} else if !exists {
+			return fmt.Errorf("%s with the name '%s' doesn't exist in the project context (namespace) '%s'", i.descriptor.displayName, args[0], i.flags.project)
+		}
+		result = object
+		objects = append(objects, object)
+	} else {
+		list := i.descriptor.newList()
+		if err = kubernetes.ResourceC(i.Client).ListWithNamespace(i.flags.project, list); err != nil {
+			return err
+		}
+		if err = apimeta.EachListItem(list, func(item runtime.Object) error {
+			objects = append(objects, item.(client.Object))
+			return nil
+		}); err != nil {
+			return err
+		}
+		if len(objects) == 0 && format == tableOutputFormat {
+			log.Infof("No %ss found in the project context (namespace) '%s'", i.descriptor.displayName, i.flags.project)
+			return nil
+		}
+		result = list
+	}
+
+	if format != tableOutputFormat {
+		return printObject(cmd.OutOrStdout(), format, result)
+	}
+	var rows [][]string
+	for _, object := range objects {
+		rows = append(rows, i.descriptor.row(object))
+	}
+	return printTable(cmd.OutOrStdout(), i.descriptor.columns, rows)
+}
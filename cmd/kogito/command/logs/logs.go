@@ -0,0 +1,235 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package logs
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/context"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/shared"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/kogitobuild"
+	buildv1 "github.com/openshift/api/build/v1"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// jobNameLabel is the label added by the Job controller to the pods it creates, used to find the builds pods on Kubernetes
+	jobNameLabel = "job-name"
+)
+
+type logsFlags struct {
+	name     string
+	project  string
+	follow   bool
+	previous bool
+	build    bool
+}
+
+type logsCommand struct {
+	context.CommandContext
+	command              *cobra.Command
+	flags                *logsFlags
+	Parent               *cobra.Command
+	resourceCheckService shared.ResourceCheckService
+}
+
+func initLogsCommand(ctx *context.CommandContext, parent *cobra.Command) context.KogitoCommand {
+	cmd := &logsCommand{
+		CommandContext:       *ctx,
+		Parent:               parent,
+		resourceCheckService: shared.NewResourceCheckService(),
+	}
+	cmd.RegisterHook()
+	cmd.InitHook()
+	return cmd
+}
+
+func (i *logsCommand) Command() *cobra.Command {
+	return i.command
+}
+
+func (i *logsCommand) RegisterHook() {
+	i.command = &cobra.Command{
+		Example: "logs example-quarkus --follow --project kogito",
+		Use:     "logs NAME [flags]",
+		Short:   "Print the logs of a Kogito Service or of the latest build of a Kogito Build",
+		Long: `logs prints the logs of every replica of the Kogito Service (Kogito Runtime or Kogito Supporting Service) with the given name.
+When more than one pod or container is found, every line is prefixed with the pod and container name.
+Use '--build' to print the logs of the latest build started for the Kogito Build with the given name instead.`,
+		RunE:    i.Exec,
+		PreRun:  i.CommonPreRun,
+		PostRun: i.CommonPostRun,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("requires 1 arg, received %v", len(args))
+			}
+			return nil
+		},
+	}
+}
+
+func (i *logsCommand) InitHook() {
+	i.flags = &logsFlags{}
+	i.Parent.AddCommand(i.command)
+	i.command.Flags().StringVarP(&i.flags.project, "project", "p", "", "The project context name where the service is deployed")
+	i.command.Flags().BoolVarP(&i.flags.follow, "follow", "f", false, "Specify if the logs should be streamed")
+	i.command.Flags().BoolVar(&i.flags.previous, "previous", false, "Print the logs of the previous instance of the containers, if they were restarted")
+	i.command.Flags().BoolVar(&i.flags.build, "build", false, "Print the logs of the latest build of the Kogito Build with the given name")
+}
+
+func (i *logsCommand) Exec(cmd *cobra.Command, args []string) (err error) {
+	i.flags.name = args[0]
+	if i.flags.project, err = i.resourceCheckService.EnsureProject(i.Client, i.flags.project); err != nil {
+		return err
+	}
+	var pods []corev1.Pod
+	if i.flags.build {
+		pods, err = i.getBuildPods()
+	} else {
+		pods, err = i.getServicePods()
+	}
+	if err != nil {
+		return err
+	}
+	return i.streamLogs(pods, cmd.OutOrStdout())
+}
+
+// getServicePods fetches the pods of the Kogito Service, ignoring the build pods sharing the same application label
+func (i *logsCommand) getServicePods() ([]corev1.Pod, error) {
+	pods, err := i.listPods(map[string]string{framework.LabelAppKey: i.flags.name})
+	if err != nil {
+		return nil, err
+	}
+	var servicePods []corev1.Pod
+	for _, pod := range pods {
+		if _, isBuild := pod.Labels[kogitobuild.LabelKeyBuildType]; isBuild {
+			continue
+		}
+		if _, isBuild := pod.Labels[buildv1.BuildLabel]; isBuild {
+			continue
+		}
+		servicePods = append(servicePods, pod)
+	}
+	if len(servicePods) == 0 {
+		return nil, fmt.Errorf("Looks like there are no pods for the Kogito Service with the name '%s' in the project context (namespace) '%s' ", i.flags.name, i.flags.project)
+	}
+	return servicePods, nil
+}
+
+// getBuildPods fetches the pods of the latest build started for the KogitoBuild
+func (i *logsCommand) getBuildPods() ([]corev1.Pod, error) {
+	if err := i.resourceCheckService.CheckKogitoBuildExists(i.Client, i.flags.name, i.flags.project); err != nil {
+		return nil, err
+	}
+	build := &v1beta1.KogitoBuild{ObjectMeta: metav1.ObjectMeta{Name: i.flags.name, Namespace: i.flags.project}}
+	if _, err := kubernetes.ResourceC(i.Client).Fetch(build); err != nil {
+		return nil, err
+	}
+	latestBuild := build.GetStatus().GetLatestBuild()
+	if len(latestBuild) == 0 {
+		return nil, fmt.Errorf("Looks like the Kogito Build with the name '%s' hasn't started any build yet ", i.flags.name)
+	}
+	buildLabel := jobNameLabel
+	if i.Client.IsOpenshift() {
+		buildLabel = buildv1.BuildLabel
+	}
+	pods, err := i.listPods(map[string]string{buildLabel: latestBuild})
+	if err != nil {
+		return nil, err
+	}
+	if len(pods) == 0 {
+		return nil, fmt.Errorf("Looks like there are no pods for the build '%s' in the project context (namespace) '%s' ", latestBuild, i.flags.project)
+	}
+	return pods, nil
+}
+
+func (i *logsCommand) listPods(labels map[string]string) ([]corev1.Pod, error) {
+	pods := &corev1.PodList{}
+	if err := kubernetes.ResourceC(i.Client).ListWithNamespaceAndLabel(i.flags.project, pods, labels); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(pods.Items, func(a, b int) bool {
+		return pods.Items[a].Name < pods.Items[b].Name
+	})
+	return pods.Items, nil
+}
+
+// streamLogs prints the logs of the init containers in order, then multiplexes the logs of every other container
+func (i *logsCommand) streamLogs(pods []corev1.Pod, out io.Writer) error {
+	log := context.GetDefaultLogger()
+	mutex := &sync.Mutex{}
+	multiplex := len(pods) > 1
+	for _, pod := range pods {
+		multiplex = multiplex || len(pod.Spec.InitContainers)+len(pod.Spec.Containers) > 1
+	}
+
+	for _, pod := range pods {
+		for _, container := range pod.Spec.InitContainers {
+			if err := i.streamContainerLogs(pod.Name, container.Name, out, mutex, multiplex); err != nil {
+				return err
+			}
+		}
+	}
+
+	var errs []error
+	wg := sync.WaitGroup{}
+	for _, pod := range pods {
+		for _, container := range pod.Spec.Containers {
+			wg.Add(1)
+			go func(podName, containerName string) {
+				defer wg.Done()
+				if err := i.streamContainerLogs(podName, containerName, out, mutex, multiplex); err != nil {
+					log.Debugf("Error while streaming logs from container %s of pod %s: %v", containerName, podName, err)
+					mutex.Lock()
+					errs = append(errs, err)
+					mutex.Unlock()
+				}
+			}(pod.Name, container.Name)
+		}
+	}
+	wg.Wait()
+	if len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+func (i *logsCommand) streamContainerLogs(podName, containerName string, out io.Writer, mutex *sync.Mutex, prefixed bool) error {
+	var prefix string
+	if prefixed {
+		prefix = fmt.Sprintf("[%s/%s] ", podName, containerName)
+	}
+	writer := newPrefixedWriter(out, prefix, mutex)
+	options := &corev1.PodLogOptions{
+		Container: containerName,
+		Follow:    i.flags.follow,
+		Previous:  i.flags.previous,
+	}
+	if err := kubernetes.PodC(i.Client).StreamLogs(i.flags.project, podName, options, writer); err != nil {
+		return err
+	}
+	return writer.Flush()
+}
@@ -0,0 +1,115 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package logs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/context"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/test"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/kogitobuild"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newPod(name, namespace string, labels map[string]string, containers ...string) *corev1.Pod {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels}}
+	for _, container := range containers {
+		pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{Name: container})
+	}
+	return pod
+}
+
+func Test_LogsCmd_SingleReplica(t *testing.T) {
+	ns := t.Name()
+	cli := fmt.Sprintf("logs example-quarkus --project %s", ns)
+	ctx := test.SetupCliTest(cli,
+		context.CommandFactory{BuildCommands: BuildCommands},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}},
+		newPod("example-quarkus-1", ns, map[string]string{framework.LabelAppKey: "example-quarkus"}, "example-quarkus"))
+
+	lines, _, err := ctx.ExecuteCli()
+	assert.NoError(t, err)
+	assert.Equal(t, "fake logs\n", lines)
+}
+
+func Test_LogsCmd_AllReplicas(t *testing.T) {
+	ns := t.Name()
+	cli := fmt.Sprintf("logs example-quarkus --project %s --follow", ns)
+	ctx := test.SetupCliTest(cli,
+		context.CommandFactory{BuildCommands: BuildCommands},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}},
+		newPod("example-quarkus-1", ns, map[string]string{framework.LabelAppKey: "example-quarkus"}, "example-quarkus"),
+		newPod("example-quarkus-2", ns, map[string]string{framework.LabelAppKey: "example-quarkus"}, "example-quarkus"),
+		newPod("example-quarkus-build", ns, map[string]string{framework.LabelAppKey: "example-quarkus", kogitobuild.LabelKeyBuildType: "RemoteSource"}, "builder"))
+
+	lines, _, err := ctx.ExecuteCli()
+	assert.NoError(t, err)
+	assert.Contains(t, lines, "[example-quarkus-1/example-quarkus] fake logs\n")
+	assert.Contains(t, lines, "[example-quarkus-2/example-quarkus] fake logs\n")
+	assert.NotContains(t, lines, "example-quarkus-build")
+}
+
+func Test_LogsCmd_NoPods(t *testing.T) {
+	ns := t.Name()
+	cli := fmt.Sprintf("logs example-quarkus --project %s", ns)
+	ctx := test.SetupCliTest(cli,
+		context.CommandFactory{BuildCommands: BuildCommands},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}})
+
+	_, _, err := ctx.ExecuteCli()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "there are no pods for the Kogito Service with the name 'example-quarkus'")
+}
+
+func Test_LogsCmd_LatestBuild(t *testing.T) {
+	ns := t.Name()
+	cli := fmt.Sprintf("logs example-build --build --project %s", ns)
+	buildPod := newPod("example-build-2-xyz", ns, map[string]string{jobNameLabel: "example-build-2"}, "image-builder")
+	buildPod.Spec.InitContainers = []corev1.Container{{Name: "builder"}}
+	ctx := test.SetupCliTest(cli,
+		context.CommandFactory{BuildCommands: BuildCommands},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}},
+		&v1beta1.KogitoBuild{
+			ObjectMeta: metav1.ObjectMeta{Name: "example-build", Namespace: ns},
+			Status:     v1beta1.KogitoBuildStatus{LatestBuild: "example-build-2"},
+		},
+		newPod("example-build-1-abc", ns, map[string]string{jobNameLabel: "example-build-1"}, "image-builder"),
+		buildPod)
+
+	lines, _, err := ctx.ExecuteCli()
+	assert.NoError(t, err)
+	assert.Equal(t, "[example-build-2-xyz/builder] fake logs\n[example-build-2-xyz/image-builder] fake logs\n", lines)
+}
+
+func Test_LogsCmd_BuildNotStarted(t *testing.T) {
+	ns := t.Name()
+	cli := fmt.Sprintf("logs example-build --build --project %s", ns)
+	ctx := test.SetupCliTest(cli,
+		context.CommandFactory{BuildCommands: BuildCommands},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}},
+		&v1beta1.KogitoBuild{ObjectMeta: metav1.ObjectMeta{Name: "example-build", Namespace: ns}})
+
+	_, _, err := ctx.ExecuteCli()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "hasn't started any build yet")
+}
@@ -0,0 +1,71 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package logs
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// prefixedWriter writes every complete line to the shared output with the given prefix.
+// Writers sharing the same mutex can be used concurrently without mixing their lines.
+type prefixedWriter struct {
+	out    io.Writer
+	prefix string
+	mutex  *sync.Mutex
+	buffer []byte
+}
+
+func newPrefixedWriter(out io.Writer, prefix string, mutex *sync.Mutex) *prefixedWriter {
+	return &prefixedWriter{out: out, prefix: prefix, mutex: mutex}
+}
+
+func (w *prefixedWriter) Write(p []byte) (int, error) {
+	w.buffer = append(w.buffer, p...)
+	for {
+		index := bytes.IndexByte(w.buffer, '\n')
+		if index < 0 {
+			return len(p), nil
+		}
+		if err := w.writeLine(w.buffer[:index+1]); err != nil {
+			return 0, err
+		}
+		w.buffer = w.buffer[index+1:]
+	}
+}
+
+// Flush writes the remaining content that doesn't end with a line break
+func (w *prefixedWriter) Flush() error {
+	if len(w.buffer) == 0 {
+		return nil
+	}
+	err := w.writeLine(append(w.buffer, '\n'))
+	w.buffer = nil
+	return err
+}
+
+func (w *prefixedWriter) writeLine(line []byte) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if _, err := io.WriteString(w.out, w.prefix); err != nil {
+		return err
+	}
+	_, err := w.out.Write(line)
+	return err
+}
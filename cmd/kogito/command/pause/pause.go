@@ -0,0 +1,209 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pause
+
+import (
+	"fmt"
+	"strings"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/context"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/shared"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// reconcileAction describes how a command changes the reconciliation of a Kogito resource
+type reconcileAction struct {
+	use   string
+	short string
+	long  string
+	// pause tells if the command pauses or resumes the reconciliation
+	pause bool
+}
+
+var (
+	pauseAction = reconcileAction{
+		use:   "pause",
+		short: "Pause the reconciliation of a Kogito resource",
+		long: `pause annotates the Kogito resource with the given name with "` + api.ReconcileAnnotation + `: ` + api.ReconcilePaused + `", so the operator stops reconciling it.
+Manual changes to the resources it owns, e.g. its Deployment, won't be reverted until the reconciliation is resumed with "kogito resume".`,
+		pause: true,
+	}
+	resumeAction = reconcileAction{
+		use:   "resume",
+		short: "Resume the reconciliation of a paused Kogito resource",
+		long: `resume removes the "` + api.ReconcileAnnotation + `" annotation from the Kogito resource with the given name, so the operator reconciles it again.
+Manual changes to the resources it owns are reverted to match the resource specification.`,
+	}
+)
+
+// kogitoResource describes a Kogito custom resource whose reconciliation can be paused
+type kogitoResource struct {
+	// kind is the value accepted by the '--kind' flag
+	kind string
+	// displayName is the human readable name of the resource
+	displayName string
+	newObject   func() client.Object
+}
+
+// kogitoResources are looked up in this order when no kind is given
+var kogitoResources = []kogitoResource{
+	{kind: "runtime", displayName: "Kogito Runtime", newObject: func() client.Object { return &v1beta1.KogitoRuntime{} }},
+	{kind: "supporting-service", displayName: "Kogito Supporting Service", newObject: func() client.Object { return &v1beta1.KogitoSupportingService{} }},
+	{kind: "infra", displayName: "Kogito Infra", newObject: func() client.Object { return &v1beta1.KogitoInfra{} }},
+	{kind: "build", displayName: "Kogito Build", newObject: func() client.Object { return &v1beta1.KogitoBuild{} }},
+}
+
+type reconcileFlags struct {
+	name    string
+	project string
+	kind    string
+}
+
+type reconcileCommand struct {
+	context.CommandContext
+	command              *cobra.Command
+	flags                *reconcileFlags
+	Parent               *cobra.Command
+	action               reconcileAction
+	resourceCheckService shared.ResourceCheckService
+}
+
+func initReconcileCommand(ctx *context.CommandContext, parent *cobra.Command, action reconcileAction) context.KogitoCommand {
+	cmd := &reconcileCommand{
+		CommandContext:       *ctx,
+		Parent:               parent,
+		action:               action,
+		resourceCheckService: shared.NewResourceCheckService(),
+	}
+	cmd.RegisterHook()
+	cmd.InitHook()
+	return cmd
+}
+
+func (i *reconcileCommand) Command() *cobra.Command {
+	return i.command
+}
+
+func (i *reconcileCommand) RegisterHook() {
+	i.command = &cobra.Command{
+		Example: fmt.Sprintf("%s example-quarkus --project kogito", i.action.use),
+		Use:     fmt.Sprintf("%s NAME [flags]", i.action.use),
+		Short:   i.action.short,
+		Long:    i.action.long,
+		RunE:    i.Exec,
+		PreRun:  i.CommonPreRun,
+		PostRun: i.CommonPostRun,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("requires 1 arg, received %v", len(args))
+			}
+			if len(i.flags.kind) > 0 && i.getResource(i.flags.kind) == nil {
+				return fmt.Errorf("kind '%s' not supported, valid ones are %s", i.flags.kind, strings.Join(getKinds(), ", "))
+			}
+			return nil
+		},
+	}
+}
+
+func (i *reconcileCommand) InitHook() {
+	i.flags = &reconcileFlags{}
+	i.Parent.AddCommand(i.command)
+	i.command.Flags().StringVarP(&i.flags.project, "project", "p", "", "The project context name where the resource is deployed")
+	i.command.Flags().StringVar(&i.flags.kind, "kind", "",
+		fmt.Sprintf("The kind of the resource, one of %s. If not set, the resources are looked up in this order", strings.Join(getKinds(), ", ")))
+}
+
+func (i *reconcileCommand) Exec(_ *cobra.Command, args []string) (err error) {
+	log := context.GetDefaultLogger()
+	i.flags.name = args[0]
+	if i.flags.project, err = i.resourceCheckService.EnsureProject(i.Client, i.flags.project); err != nil {
+		return err
+	}
+	object, resource, err := i.fetchResource()
+	if err != nil {
+		return err
+	}
+	if api.IsReconcilePaused(object) == i.action.pause {
+		log.Infof("Reconciliation of %s '%s' is already %s", resource.displayName, i.flags.name, i.state())
+		return nil
+	}
+	annotations := object.GetAnnotations()
+	if i.action.pause {
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[api.ReconcileAnnotation] = api.ReconcilePaused
+	} else {
+		delete(annotations, api.ReconcileAnnotation)
+	}
+	object.SetAnnotations(annotations)
+	if err = kubernetes.ResourceC(i.Client).Update(object); err != nil {
+		return fmt.Errorf("Error while updating %s '%s': %v ", resource.displayName, i.flags.name, err)
+	}
+	log.Infof("Reconciliation of %s '%s' %s", resource.displayName, i.flags.name, i.state())
+	return nil
+}
+
+// fetchResource looks for the Kogito resource with the given name, restricted to the kind set with '--kind' if any
+func (i *reconcileCommand) fetchResource() (client.Object, *kogitoResource, error) {
+	log := context.GetDefaultLogger()
+	candidates := kogitoResources
+	if len(i.flags.kind) > 0 {
+		candidates = []kogitoResource{*i.getResource(i.flags.kind)}
+	}
+	for _, candidate := range candidates {
+		object := candidate.newObject()
+		object.SetName(i.flags.name)
+		object.SetNamespace(i.flags.project)
+		if exists, err := kubernetes.ResourceC(i.Client).Fetch(object); err != nil {
+			return nil, nil, err
+		} else if exists {
+			return object, &candidate, nil
+		}
+		log.Debugf("%s with name '%s' not found in the project context (namespace) '%s'", candidate.displayName, i.flags.name, i.flags.project)
+	}
+	return nil, nil, fmt.Errorf("Looks like a Kogito resource with the name '%s' doesn't exist in the project context (namespace) '%s'. Please try another name ", i.flags.name, i.flags.project)
+}
+
+func (i *reconcileCommand) getResource(kind string) *kogitoResource {
+	for _, resource := range kogitoResources {
+		if resource.kind == kind {
+			return &resource
+		}
+	}
+	return nil
+}
+
+func (i *reconcileCommand) state() string {
+	if i.action.pause {
+		return "paused"
+	}
+	return "resumed"
+}
+
+func getKinds() []string {
+	var kinds []string
+	for _, resource := range kogitoResources {
+		kinds = append(kinds, resource.kind)
+	}
+	return kinds
+}
@@ -0,0 +1,122 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pause
+
+import (
+	"fmt"
+	"testing"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/context"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/test"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_PauseCmd_KogitoRuntime(t *testing.T) {
+	ns := t.Name()
+	cli := fmt.Sprintf("pause example-quarkus --project %s", ns)
+	ctx := test.SetupCliTest(cli,
+		context.CommandFactory{BuildCommands: BuildCommands},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}},
+		&v1beta1.KogitoRuntime{ObjectMeta: metav1.ObjectMeta{Name: "example-quarkus", Namespace: ns}})
+
+	lines, _, err := ctx.ExecuteCli()
+	assert.NoError(t, err)
+	assert.Contains(t, lines, "Reconciliation of Kogito Runtime 'example-quarkus' paused")
+
+	runtime := &v1beta1.KogitoRuntime{ObjectMeta: metav1.ObjectMeta{Name: "example-quarkus", Namespace: ns}}
+	exists, err := kubernetes.ResourceC(ctx.GetClient()).Fetch(runtime)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.True(t, api.IsReconcilePaused(runtime))
+
+	lines, _, err = ctx.ExecuteCli()
+	assert.NoError(t, err)
+	assert.Contains(t, lines, "is already paused")
+}
+
+func Test_ResumeCmd_KogitoInfra(t *testing.T) {
+	ns := t.Name()
+	cli := fmt.Sprintf("resume kafka-infra --project %s", ns)
+	ctx := test.SetupCliTest(cli,
+		context.CommandFactory{BuildCommands: BuildCommands},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}},
+		&v1beta1.KogitoInfra{ObjectMeta: metav1.ObjectMeta{
+			Name:        "kafka-infra",
+			Namespace:   ns,
+			Annotations: map[string]string{api.ReconcileAnnotation: api.ReconcilePaused},
+		}})
+
+	lines, _, err := ctx.ExecuteCli()
+	assert.NoError(t, err)
+	assert.Contains(t, lines, "Reconciliation of Kogito Infra 'kafka-infra' resumed")
+
+	infra := &v1beta1.KogitoInfra{ObjectMeta: metav1.ObjectMeta{Name: "kafka-infra", Namespace: ns}}
+	exists, err := kubernetes.ResourceC(ctx.GetClient()).Fetch(infra)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.False(t, api.IsReconcilePaused(infra))
+	assert.NotContains(t, infra.GetAnnotations(), api.ReconcileAnnotation)
+}
+
+func Test_PauseCmd_WithKind(t *testing.T) {
+	ns := t.Name()
+	cli := fmt.Sprintf("pause example-quarkus --kind build --project %s", ns)
+	ctx := test.SetupCliTest(cli,
+		context.CommandFactory{BuildCommands: BuildCommands},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}},
+		&v1beta1.KogitoRuntime{ObjectMeta: metav1.ObjectMeta{Name: "example-quarkus", Namespace: ns}},
+		&v1beta1.KogitoBuild{ObjectMeta: metav1.ObjectMeta{Name: "example-quarkus", Namespace: ns}})
+
+	lines, _, err := ctx.ExecuteCli()
+	assert.NoError(t, err)
+	assert.Contains(t, lines, "Reconciliation of Kogito Build 'example-quarkus' paused")
+
+	runtime := &v1beta1.KogitoRuntime{ObjectMeta: metav1.ObjectMeta{Name: "example-quarkus", Namespace: ns}}
+	_, err = kubernetes.ResourceC(ctx.GetClient()).Fetch(runtime)
+	assert.NoError(t, err)
+	assert.False(t, api.IsReconcilePaused(runtime))
+}
+
+func Test_PauseCmd_InvalidKind(t *testing.T) {
+	ns := t.Name()
+	cli := fmt.Sprintf("pause example-quarkus --kind deployment --project %s", ns)
+	ctx := test.SetupCliTest(cli,
+		context.CommandFactory{BuildCommands: BuildCommands},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}})
+
+	_, _, err := ctx.ExecuteCli()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "kind 'deployment' not supported")
+}
+
+func Test_PauseCmd_NotFound(t *testing.T) {
+	ns := t.Name()
+	cli := fmt.Sprintf("pause example-quarkus --project %s", ns)
+	ctx := test.SetupCliTest(cli,
+		context.CommandFactory{BuildCommands: BuildCommands},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}})
+
+	_, _, err := ctx.ExecuteCli()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "doesn't exist in the project context")
+}
@@ -112,6 +112,7 @@ func (i buildService) InstallBuildService(flags *flag.BuildFlags, resource strin
 			TargetKogitoRuntime:       flags.TargetRuntime,
 			Artifact:                  converter.FromArtifactFlagsToArtifact(&flags.ArtifactFlags),
 			EnableMavenDownloadOutput: flags.EnableMavenDownloadOutput,
+			Registry:                  converter.FromRegistryFlagsToImageRegistry(&flags.RegistryFlags),
 		},
 	}
 
@@ -132,9 +133,9 @@ func (i buildService) InstallBuildService(flags *flag.BuildFlags, resource strin
 }
 
 func (i buildService) validatePreRequisite(flags *flag.BuildFlags, log *zap.SugaredLogger) error {
-	if !i.Client.IsOpenshift() {
-		log.Info("Kogito Build is only supported on Openshift.")
-		return fmt.Errorf("kogito build only supported on Openshift. Provide image flag to deploy Kogito service on K8s")
+	if !i.Client.IsOpenshift() && len(flags.RegistryURL) == 0 {
+		log.Info("Kogito Build on Kubernetes requires a registry to push the built image.")
+		return fmt.Errorf("kogito build on Kubernetes requires the registry-url flag. Provide image flag to deploy Kogito service from an existing image")
 	}
 
 	if flags.Native {
@@ -150,8 +151,13 @@ func (i buildService) DeleteBuildService(name, project string) (err error) {
 	log := context.GetDefaultLogger()
 
 	if !i.Client.IsOpenshift() {
-		log.Info("Delete Kogito Build is only supported on OpenShift.")
-		return nil
+		// on Kubernetes services are mostly deployed from existing images, builds are optional
+		if exists, err := kubernetes.ResourceC(i.Client).Fetch(&v1beta1.KogitoBuild{ObjectMeta: v1.ObjectMeta{Name: name, Namespace: project}}); err != nil {
+			return err
+		} else if !exists {
+			log.Debugf("No Kogito Build %s found in namespace %s", name, project)
+			return nil
+		}
 	}
 	if err := i.resourceCheckService.CheckKogitoBuildExists(i.Client, name, project); err != nil {
 		return err
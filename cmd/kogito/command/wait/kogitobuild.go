@@ -0,0 +1,94 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package wait
+
+import (
+	"fmt"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/context"
+	buildv1 "github.com/openshift/api/build/v1"
+	"github.com/spf13/cobra"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func initWaitKogitoBuildCommand(ctx *context.CommandContext, parent *cobra.Command) context.KogitoCommand {
+	return initWaitResourceCommand(ctx, parent, resourceDescriptor{
+		use:         "build",
+		aliases:     []string{"kogitobuild"},
+		displayName: "Kogito Build",
+		conditions: []string{
+			string(api.KogitoBuildSuccessful),
+			string(api.KogitoBuildRunning),
+			string(api.KogitoBuildFailure),
+		},
+		newObject: func() client.Object { return &v1beta1.KogitoBuild{} },
+		check: func(object client.Object, condition string) (bool, string, error) {
+			return checkBuildCondition(object.(*v1beta1.KogitoBuild), condition)
+		},
+	})
+}
+
+// checkBuildCondition checks the conditions and the phase of the latest build set by the operator on a Kogito Build
+func checkBuildCondition(build *v1beta1.KogitoBuild, condition string) (bool, string, error) {
+	if build.Status.Conditions == nil || len(*build.Status.Conditions) == 0 {
+		return false, "waiting for the operator to report the status", nil
+	}
+	if apimeta.IsStatusConditionTrue(*build.Status.Conditions, condition) {
+		return true, "", nil
+	}
+	if len(build.Status.LatestBuild) == 0 {
+		return false, "no build started yet", nil
+	}
+	phase := latestBuildPhase(build)
+	if condition != string(api.KogitoBuildFailure) {
+		// errors aren't reported in the conditions, the build will never run though
+		if phase == buildv1.BuildPhaseError {
+			return false, "", fmt.Errorf("build '%s' is in the %s phase", build.Status.LatestBuild, phase)
+		}
+		failed := apimeta.FindStatusCondition(*build.Status.Conditions, string(api.KogitoBuildFailure))
+		if failed != nil && failed.Status == metav1.ConditionTrue && failed.Reason != string(api.OperatorFailureReason) {
+			return false, "", fmt.Errorf("build '%s' %s: %s", build.Status.LatestBuild, failed.Reason, failed.Message)
+		}
+	}
+	return false, fmt.Sprintf("build '%s' is %s", build.Status.LatestBuild, phase), nil
+}
+
+// latestBuildPhase finds the phase of the latest build in the builds grouped by phase
+func latestBuildPhase(build *v1beta1.KogitoBuild) buildv1.BuildPhase {
+	phases := map[buildv1.BuildPhase][]string{
+		buildv1.BuildPhaseNew:       build.Status.Builds.New,
+		buildv1.BuildPhasePending:   build.Status.Builds.Pending,
+		buildv1.BuildPhaseRunning:   build.Status.Builds.Running,
+		buildv1.BuildPhaseComplete:  build.Status.Builds.Complete,
+		buildv1.BuildPhaseFailed:    build.Status.Builds.Failed,
+		buildv1.BuildPhaseError:     build.Status.Builds.Error,
+		buildv1.BuildPhaseCancelled: build.Status.Builds.Cancelled,
+	}
+	for phase, builds := range phases {
+		for _, name := range builds {
+			if name == build.Status.LatestBuild {
+				return phase
+			}
+		}
+	}
+	return buildv1.BuildPhaseNew
+}
@@ -0,0 +1,54 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package wait
+
+import (
+	"fmt"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/context"
+	"github.com/spf13/cobra"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func initWaitKogitoInfraCommand(ctx *context.CommandContext, parent *cobra.Command) context.KogitoCommand {
+	return initWaitResourceCommand(ctx, parent, resourceDescriptor{
+		use:         "infra",
+		aliases:     []string{"kogitoinfra"},
+		displayName: "Kogito Infra",
+		conditions:  []string{string(api.KogitoInfraConfigured)},
+		newObject:   func() client.Object { return &v1beta1.KogitoInfra{} },
+		check: func(object client.Object, condition string) (bool, string, error) {
+			infra := object.(*v1beta1.KogitoInfra)
+			if infra.Status.Conditions == nil {
+				return false, "waiting for the operator to report the status", nil
+			}
+			configured := apimeta.FindStatusCondition(*infra.Status.Conditions, condition)
+			if configured == nil {
+				return false, "waiting for the operator to report the status", nil
+			}
+			if configured.Status == metav1.ConditionTrue {
+				return true, "", nil
+			}
+			return false, fmt.Sprintf("not %s yet, %s: %s", condition, configured.Reason, configured.Message), nil
+		},
+	})
+}
@@ -0,0 +1,41 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package wait
+
+import (
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/context"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func initWaitKogitoRuntimeCommand(ctx *context.CommandContext, parent *cobra.Command) context.KogitoCommand {
+	return initWaitResourceCommand(ctx, parent, resourceDescriptor{
+		use:         "runtime",
+		aliases:     []string{"kogitoruntime"},
+		displayName: "Kogito Runtime",
+		conditions:  serviceConditions,
+		newObject:   func() client.Object { return &v1beta1.KogitoRuntime{} },
+		check: func(object client.Object, condition string) (bool, string, error) {
+			return checkServiceCondition(object.(*v1beta1.KogitoRuntime).Status.Conditions, condition)
+		},
+		externalURI: func(object client.Object) string {
+			return object.(*v1beta1.KogitoRuntime).Status.ExternalURI
+		},
+	})
+}
@@ -0,0 +1,196 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package wait
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/context"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/shared"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultTimeout = 5 * time.Minute
+	httpTimeout    = 10 * time.Second
+)
+
+// pollInterval is the interval between two checks of the resource
+var pollInterval = 2 * time.Second
+
+// resourceDescriptor describes how a given Kogito custom resource is fetched and checked by the wait command
+type resourceDescriptor struct {
+	// use is the name of the sub command
+	use string
+	// aliases for the sub command
+	aliases []string
+	// displayName is the human readable name of the resource
+	displayName string
+	// conditions are the values accepted by the '--for' flag, the first one is the default
+	conditions []string
+	// newObject creates an empty instance of the resource
+	newObject func() client.Object
+	// check tells if the resource has reached the given condition, describing its current state otherwise.
+	// An error is returned when the resource won't reach the condition anymore.
+	check func(object client.Object, condition string) (met bool, progress string, err error)
+	// externalURI gets the URI exposing the resource, nil when the resource isn't exposed
+	externalURI func(object client.Object) string
+}
+
+type waitResourceFlags struct {
+	name      string
+	project   string
+	condition string
+	timeout   time.Duration
+	http      bool
+}
+
+type waitResourceCommand struct {
+	context.CommandContext
+	command              *cobra.Command
+	flags                *waitResourceFlags
+	Parent               *cobra.Command
+	descriptor           resourceDescriptor
+	resourceCheckService shared.ResourceCheckService
+}
+
+func initWaitResourceCommand(ctx *context.CommandContext, parent *cobra.Command, descriptor resourceDescriptor) context.KogitoCommand {
+	cmd := &waitResourceCommand{
+		CommandContext:       *ctx,
+		Parent:               parent,
+		descriptor:           descriptor,
+		resourceCheckService: shared.NewResourceCheckService(),
+	}
+	cmd.RegisterHook()
+	cmd.InitHook()
+	return cmd
+}
+
+func (i *waitResourceCommand) Command() *cobra.Command {
+	return i.command
+}
+
+func (i *waitResourceCommand) RegisterHook() {
+	i.command = &cobra.Command{
+		Example: fmt.Sprintf("wait %s example-quarkus --for=%s --timeout=10m --project kogito", i.descriptor.use, i.descriptor.conditions[0]),
+		Use:     fmt.Sprintf("%s NAME [flags]", i.descriptor.use),
+		Aliases: i.descriptor.aliases,
+		Short:   fmt.Sprintf("Wait for a %s to reach a given condition", i.descriptor.displayName),
+		Long: fmt.Sprintf(`%s waits until the %s with the given name has the condition set with '--for' (one of %s) in its status.
+The command fails with the reason reported by the operator when the resource won't reach the condition or when the timeout expires.`,
+			i.descriptor.use, i.descriptor.displayName, strings.Join(i.descriptor.conditions, ", ")),
+		RunE:    i.Exec,
+		PreRun:  i.CommonPreRun,
+		PostRun: i.CommonPostRun,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("requires 1 arg, received %v", len(args))
+			}
+			if !i.isSupportedCondition() {
+				return fmt.Errorf("condition '%s' not supported, valid ones are %s", i.flags.condition, strings.Join(i.descriptor.conditions, ", "))
+			}
+			if i.flags.timeout <= 0 {
+				return fmt.Errorf("timeout must be greater than 0, received %s", i.flags.timeout)
+			}
+			return nil
+		},
+	}
+}
+
+func (i *waitResourceCommand) InitHook() {
+	i.flags = &waitResourceFlags{}
+	i.Parent.AddCommand(i.command)
+	i.command.Flags().StringVarP(&i.flags.project, "project", "p", "", "The project context name where the resource is deployed")
+	i.command.Flags().StringVar(&i.flags.condition, "for", i.descriptor.conditions[0], fmt.Sprintf("The condition to wait for. Valid values are %s", strings.Join(i.descriptor.conditions, ", ")))
+	i.command.Flags().DurationVar(&i.flags.timeout, "timeout", defaultTimeout, "The maximum time to wait, e.g. 30s, 10m")
+	if i.descriptor.externalURI != nil {
+		i.command.Flags().BoolVar(&i.flags.http, "http", false, "Also wait for the external URI of the service to answer HTTP 200")
+	}
+}
+
+func (i *waitResourceCommand) isSupportedCondition() bool {
+	for _, condition := range i.descriptor.conditions {
+		if condition == i.flags.condition {
+			return true
+		}
+	}
+	return false
+}
+
+func (i *waitResourceCommand) Exec(cmd *cobra.Command, args []string) (err error) {
+	log := context.GetDefaultLogger()
+	i.flags.name = args[0]
+	if i.flags.project, err = i.resourceCheckService.EnsureProject(i.Client, i.flags.project); err != nil {
+		return err
+	}
+
+	var progress string
+	httpClient := &http.Client{Timeout: httpTimeout}
+	err = wait.PollImmediate(pollInterval, i.flags.timeout, func() (bool, error) {
+		object := i.descriptor.newObject()
+		object.SetName(i.flags.name)
+		object.SetNamespace(i.flags.project)
+		if exists, err := kubernetes.ResourceC(i.Client).Fetch(object); err != nil {
+			return false, err
+		} else if !exists {
+			return false, fmt.Errorf("%s with the name '%s' doesn't exist in the project context (namespace) '%s'", i.descriptor.displayName, i.flags.name, i.flags.project)
+		}
+		met, current, err := i.descriptor.check(object, i.flags.condition)
+		if err != nil {
+			return false, fmt.Errorf("%s '%s' won't reach the condition %s: %v", i.descriptor.displayName, i.flags.name, i.flags.condition, err)
+		}
+		if met && i.flags.http {
+			met, current = probeExternalURI(httpClient, i.descriptor.externalURI(object))
+		}
+		if !met && current != progress {
+			log.Infof("%s '%s': %s", i.descriptor.displayName, i.flags.name, current)
+		}
+		progress = current
+		return met, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return fmt.Errorf("timed out after %s waiting for the %s '%s' to reach the condition %s, last state: %s",
+			i.flags.timeout, i.descriptor.displayName, i.flags.name, i.flags.condition, progress)
+	} else if err != nil {
+		return err
+	}
+	log.Infof("%s '%s' has reached the condition %s", i.descriptor.displayName, i.flags.name, i.flags.condition)
+	return nil
+}
+
+// probeExternalURI tells if the given URI answers HTTP 200, describing the answer otherwise
+func probeExternalURI(httpClient *http.Client, uri string) (bool, string) {
+	if len(uri) == 0 {
+		return false, "waiting for the external URI to be published in the status"
+	}
+	response, err := httpClient.Get(uri)
+	if err != nil {
+		return false, fmt.Sprintf("GET %s failed: %v", uri, err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return false, fmt.Sprintf("GET %s answered %s", uri, response.Status)
+	}
+	return true, ""
+}
@@ -0,0 +1,68 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package wait
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// serviceConditions are the conditions a Kogito Service can be waited for
+var serviceConditions = []string{
+	string(api.DeployedConditionType),
+	string(api.ProvisioningConditionType),
+	string(api.FailedConditionType),
+}
+
+// checkServiceCondition checks the conditions set by the operator on a Kogito Service.
+// A failure is final only when the operator flags it as unrecoverable, otherwise the service is still being provisioned.
+func checkServiceCondition(conditions *[]metav1.Condition, condition string) (bool, string, error) {
+	if conditions == nil || len(*conditions) == 0 {
+		return false, "waiting for the operator to report the status", nil
+	}
+	if apimeta.IsStatusConditionTrue(*conditions, condition) {
+		return true, "", nil
+	}
+	failed := apimeta.FindStatusCondition(*conditions, string(api.FailedConditionType))
+	if failed != nil && failed.Status == metav1.ConditionTrue {
+		provisioning := apimeta.FindStatusCondition(*conditions, string(api.ProvisioningConditionType))
+		if provisioning != nil && provisioning.Reason == string(infrastructure.FailedProvisioningReason) {
+			return false, "", fmt.Errorf("%s: %s", failed.Reason, failed.Message)
+		}
+		return false, fmt.Sprintf("failing with %s: %s", failed.Reason, failed.Message), nil
+	}
+	return false, describeConditions(*conditions), nil
+}
+
+// describeConditions prints the given conditions as a comma separated list, e.g. "Deployed=False (Reason)"
+func describeConditions(conditions []metav1.Condition) string {
+	var states []string
+	for _, condition := range conditions {
+		state := fmt.Sprintf("%s=%s", condition.Type, condition.Status)
+		if len(condition.Reason) > 0 {
+			state = fmt.Sprintf("%s (%s)", state, condition.Reason)
+		}
+		states = append(states, state)
+	}
+	return strings.Join(states, ", ")
+}
@@ -0,0 +1,57 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package wait
+
+import (
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/context"
+	"github.com/spf13/cobra"
+)
+
+type waitCommand struct {
+	context.CommandContext
+	command *cobra.Command
+	Parent  *cobra.Command
+}
+
+func initWaitCommand(ctx *context.CommandContext, parent *cobra.Command) context.KogitoCommand {
+	cmd := waitCommand{
+		CommandContext: *ctx,
+		Parent:         parent,
+	}
+	cmd.RegisterHook()
+	cmd.InitHook()
+	return &cmd
+}
+
+func (i *waitCommand) Command() *cobra.Command {
+	return i.command
+}
+
+func (i *waitCommand) RegisterHook() {
+	i.command = &cobra.Command{
+		Use:   "wait",
+		Short: "Wait for a Kogito resource to reach a given condition",
+		Long: `wait blocks until the Kogito custom resource with the given name reaches the condition set with '--for', printing its progress meanwhile.
+It exits with an error holding the failure reason reported by the operator when the resource fails or the timeout expires.`,
+		PreRun: i.CommonPreRun,
+	}
+}
+
+func (i *waitCommand) InitHook() {
+	i.Parent.AddCommand(i.command)
+}
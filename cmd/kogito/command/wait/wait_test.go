@@ -0,0 +1,179 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package wait
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/context"
+	"github.com/kiegroup/kogito-operator/cmd/kogito/command/test"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newKogitoRuntime(name, namespace string, conditions ...metav1.Condition) *v1beta1.KogitoRuntime {
+	return &v1beta1.KogitoRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Status:     v1beta1.KogitoRuntimeStatus{KogitoServiceStatus: v1beta1.KogitoServiceStatus{Conditions: &conditions}},
+	}
+}
+
+func Test_WaitCmd_RuntimeDeployed(t *testing.T) {
+	ns := t.Name()
+	cli := fmt.Sprintf("wait runtime example-quarkus --project %s", ns)
+	ctx := test.SetupCliTest(cli,
+		context.CommandFactory{BuildCommands: BuildCommands},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}},
+		newKogitoRuntime("example-quarkus", ns, metav1.Condition{Type: string(api.DeployedConditionType), Status: metav1.ConditionTrue}))
+
+	lines, _, err := ctx.ExecuteCli()
+	assert.NoError(t, err)
+	assert.Contains(t, lines, "Kogito Runtime 'example-quarkus' has reached the condition Deployed")
+}
+
+func Test_WaitCmd_RuntimeTimeout(t *testing.T) {
+	ns := t.Name()
+	cli := fmt.Sprintf("wait runtime example-quarkus --timeout 1s --project %s", ns)
+	ctx := test.SetupCliTest(cli,
+		context.CommandFactory{BuildCommands: BuildCommands},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}},
+		newKogitoRuntime("example-quarkus", ns,
+			metav1.Condition{Type: string(api.DeployedConditionType), Status: metav1.ConditionFalse},
+			metav1.Condition{Type: string(api.ProvisioningConditionType), Status: metav1.ConditionTrue, Reason: string(infrastructure.ProvisioningInProgressReason)},
+			metav1.Condition{Type: string(api.FailedConditionType), Status: metav1.ConditionTrue, Reason: string(infrastructure.KogitoInfraNotReadyReason), Message: "infra not ready"}))
+
+	lines, _, err := ctx.ExecuteCli()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "timed out after 1s")
+	assert.Contains(t, err.Error(), string(infrastructure.KogitoInfraNotReadyReason))
+	assert.Contains(t, lines, "failing with KogitoInfraNotReadyReason: infra not ready")
+}
+
+func Test_WaitCmd_RuntimeUnrecoverableFailure(t *testing.T) {
+	ns := t.Name()
+	cli := fmt.Sprintf("wait runtime example-quarkus --project %s", ns)
+	ctx := test.SetupCliTest(cli,
+		context.CommandFactory{BuildCommands: BuildCommands},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}},
+		newKogitoRuntime("example-quarkus", ns,
+			metav1.Condition{Type: string(api.ProvisioningConditionType), Status: metav1.ConditionFalse, Reason: string(infrastructure.FailedProvisioningReason)},
+			metav1.Condition{Type: string(api.FailedConditionType), Status: metav1.ConditionTrue, Reason: string(infrastructure.ImageNotFound), Message: "image not found"}))
+
+	_, _, err := ctx.ExecuteCli()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "ImageNotFound: image not found")
+}
+
+func Test_WaitCmd_RuntimeExternalURI(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	ns := t.Name()
+	runtime := newKogitoRuntime("example-quarkus", ns, metav1.Condition{Type: string(api.DeployedConditionType), Status: metav1.ConditionTrue})
+	runtime.Status.ExternalURI = server.URL
+	cli := fmt.Sprintf("wait runtime example-quarkus --http --project %s", ns)
+	ctx := test.SetupCliTest(cli,
+		context.CommandFactory{BuildCommands: BuildCommands},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}},
+		runtime)
+
+	_, _, err := ctx.ExecuteCli()
+	assert.NoError(t, err)
+}
+
+func Test_WaitCmd_UnsupportedCondition(t *testing.T) {
+	ns := t.Name()
+	cli := fmt.Sprintf("wait infra kafka --for=Deployed --project %s", ns)
+	ctx := test.SetupCliTest(cli,
+		context.CommandFactory{BuildCommands: BuildCommands},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}})
+
+	_, _, err := ctx.ExecuteCli()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "condition 'Deployed' not supported")
+}
+
+func Test_WaitCmd_InfraConfigured(t *testing.T) {
+	ns := t.Name()
+	cli := fmt.Sprintf("wait infra kafka --project %s", ns)
+	ctx := test.SetupCliTest(cli,
+		context.CommandFactory{BuildCommands: BuildCommands},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}},
+		&v1beta1.KogitoInfra{
+			ObjectMeta: metav1.ObjectMeta{Name: "kafka", Namespace: ns},
+			Status: v1beta1.KogitoInfraStatus{Conditions: &[]metav1.Condition{
+				{Type: string(api.KogitoInfraConfigured), Status: metav1.ConditionTrue, Reason: string(api.ResourceSuccessfullyConfigured)},
+			}},
+		})
+
+	_, _, err := ctx.ExecuteCli()
+	assert.NoError(t, err)
+}
+
+func Test_WaitCmd_BuildFailed(t *testing.T) {
+	ns := t.Name()
+	cli := fmt.Sprintf("wait build example-build --project %s", ns)
+	ctx := test.SetupCliTest(cli,
+		context.CommandFactory{BuildCommands: BuildCommands},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}},
+		&v1beta1.KogitoBuild{
+			ObjectMeta: metav1.ObjectMeta{Name: "example-build", Namespace: ns},
+			Status: v1beta1.KogitoBuildStatus{
+				LatestBuild: "example-build-2",
+				Builds:      v1beta1.Builds{Complete: []string{"example-build-1"}, Failed: []string{"example-build-2"}},
+				Conditions: &[]metav1.Condition{
+					{Type: string(api.KogitoBuildSuccessful), Status: metav1.ConditionFalse, Reason: string(api.BuildPhaseFailedReason)},
+					{Type: string(api.KogitoBuildFailure), Status: metav1.ConditionTrue, Reason: string(api.BuildPhaseFailedReason), Message: "maven build failed"},
+				},
+			},
+		})
+
+	_, _, err := ctx.ExecuteCli()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "build 'example-build-2' Failed: maven build failed")
+}
+
+func Test_WaitCmd_BuildRunning(t *testing.T) {
+	ns := t.Name()
+	cli := fmt.Sprintf("wait build example-build --timeout 1s --project %s", ns)
+	ctx := test.SetupCliTest(cli,
+		context.CommandFactory{BuildCommands: BuildCommands},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}},
+		&v1beta1.KogitoBuild{
+			ObjectMeta: metav1.ObjectMeta{Name: "example-build", Namespace: ns},
+			Status: v1beta1.KogitoBuildStatus{
+				LatestBuild: "example-build-1",
+				Builds:      v1beta1.Builds{Running: []string{"example-build-1"}},
+				Conditions: &[]metav1.Condition{
+					{Type: string(api.KogitoBuildRunning), Status: metav1.ConditionTrue, Reason: string(api.BuildPhaseRunningReason)},
+				},
+			},
+		})
+
+	lines, _, err := ctx.ExecuteCli()
+	assert.Error(t, err)
+	assert.Contains(t, lines, "build 'example-build-1' is Running")
+}
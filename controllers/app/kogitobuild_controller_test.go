@@ -67,7 +67,8 @@ func TestReconcileKogitoBuildSimple(t *testing.T) {
 
 	// first reconciliation
 	result := test.AssertReconcileMustRequeue(t, r, instance)
-	assert.Equal(t, time.Second*10, result.RequeueAfter)
+	assert.GreaterOrEqual(t, result.RequeueAfter, time.Second*10)
+	assert.LessOrEqual(t, result.RequeueAfter, time.Second*11)
 
 	// verifying if all images have been created
 	kogitoISList := &imagev1.ImageStreamList{}
@@ -150,7 +151,8 @@ func TestReconcileKogitoBuildMultiple(t *testing.T) {
 
 	// first reconciliation
 	result := test.AssertReconcileMustRequeue(t, r, instanceRemote)
-	assert.Equal(t, time.Second*10, result.RequeueAfter)
+	assert.GreaterOrEqual(t, result.RequeueAfter, time.Second*10)
+	assert.LessOrEqual(t, result.RequeueAfter, time.Second*11)
 	// we won't requeue since the Kogito ImageStreams should be created for the first instance
 	result = test.AssertReconcileMustNotRequeue(t, r, instanceLocal)
 	// now we create the objects for Remote
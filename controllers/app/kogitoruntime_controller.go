@@ -37,6 +37,14 @@ import (
 //+kubebuilder:rbac:groups=image.openshift.io,resources=imagestreams;imagestreamtags,verbs=get;create;list;watch;delete;update
 //+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings;roles,verbs=get;create;list;watch;delete;update
 //+kubebuilder:rbac:groups=route.openshift.io,resources=routes,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=kafka.strimzi.io,resources=kafkatopics;kafkausers,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=eventing.knative.dev,resources=triggers,verbs=get;list;watch;create;delete;update
+//+kubebuilder:rbac:groups=sources.knative.dev,resources=sinkbindings,verbs=get;list;watch;create;delete;update
+//+kubebuilder:rbac:groups=networking.istio.io,resources=virtualservices,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=serving.knative.dev,resources=services,verbs=get;create;list;watch;delete;update
 //+kubebuilder:rbac:groups=core,resources=configmaps;events;pods;secrets;serviceaccounts;services,verbs=create;delete;get;list;patch;update;watch
 
 // NewKogitoRuntimeReconciler ...
@@ -91,6 +91,44 @@ func TestReconcileKogitoRuntime_Reconcile(t *testing.T) {
 	assert.True(t, util.MapContains(deployment.Annotations, operator.KogitoRuntimeKey, "true"))
 }
 
+func TestReconcileKogitoRuntime_Paused(t *testing.T) {
+	replicas := int32(1)
+	instance := &v1beta1.KogitoRuntime{
+		ObjectMeta: v1.ObjectMeta{
+			Name:        "example-quarkus",
+			Namespace:   t.Name(),
+			Annotations: map[string]string{api.ReconcileAnnotation: api.ReconcilePaused},
+		},
+		Spec: v1beta1.KogitoRuntimeSpec{
+			KogitoServiceSpec: v1beta1.KogitoServiceSpec{Replicas: &replicas},
+		},
+	}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	r := NewKogitoRuntimeReconciler(cli, meta.GetRegisteredSchema())
+
+	test.AssertReconcileMustNotRequeue(t, r, instance)
+
+	_, err := kubernetes.ResourceC(cli).Fetch(instance)
+	assert.NoError(t, err)
+	assert.True(t, meta2.IsStatusConditionTrue(*instance.Status.Conditions, api.PausedConditionType))
+	deployment := &appsv1.Deployment{ObjectMeta: v1.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(deployment)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	// resume
+	delete(instance.Annotations, api.ReconcileAnnotation)
+	assert.NoError(t, kubernetes.ResourceC(cli).Update(instance))
+	test.AssertReconcileMustNotRequeue(t, r, instance)
+
+	_, err = kubernetes.ResourceC(cli).Fetch(instance)
+	assert.NoError(t, err)
+	assert.Nil(t, meta2.FindStatusCondition(*instance.Status.Conditions, api.PausedConditionType))
+	exists, err = kubernetes.ResourceC(cli).Fetch(deployment)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+}
+
 // see https://issues.redhat.com/browse/KOGITO-2535
 func TestReconcileKogitoRuntime_CustomImage(t *testing.T) {
 	replicas := int32(1)
@@ -0,0 +1,86 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package app
+
+import (
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/controllers/common"
+	"github.com/kiegroup/kogito-operator/core/client"
+	app2 "github.com/kiegroup/kogito-operator/version/app"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+//+kubebuilder:webhook:path=/mutate-app-kiegroup-org-v1beta1-kogitoruntime,mutating=true,failurePolicy=fail,sideEffects=None,groups=app.kiegroup.org,resources=kogitoruntimes,verbs=create;update,versions=v1beta1,name=mkogitoruntime.app.kiegroup.org,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-app-kiegroup-org-v1beta1-kogitoruntime,mutating=false,failurePolicy=fail,sideEffects=None,groups=app.kiegroup.org,resources=kogitoruntimes,verbs=create;update,versions=v1beta1,name=vkogitoruntime.app.kiegroup.org,admissionReviewVersions=v1
+
+// NewKogitoRuntimeWebhook ...
+func NewKogitoRuntimeWebhook(client *client.Client, scheme *runtime.Scheme) *common.KogitoRuntimeWebhook {
+	return &common.KogitoRuntimeWebhook{
+		KogitoWebhook: common.KogitoWebhook{
+			Client:        client,
+			Scheme:        scheme,
+			Version:       app2.Version,
+			WebhookObject: &v1beta1.KogitoRuntime{},
+		},
+	}
+}
+
+//+kubebuilder:webhook:path=/mutate-app-kiegroup-org-v1beta1-kogitobuild,mutating=true,failurePolicy=fail,sideEffects=None,groups=app.kiegroup.org,resources=kogitobuilds,verbs=create;update,versions=v1beta1,name=mkogitobuild.app.kiegroup.org,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-app-kiegroup-org-v1beta1-kogitobuild,mutating=false,failurePolicy=fail,sideEffects=None,groups=app.kiegroup.org,resources=kogitobuilds,verbs=create;update,versions=v1beta1,name=vkogitobuild.app.kiegroup.org,admissionReviewVersions=v1
+
+// NewKogitoBuildWebhook ...
+func NewKogitoBuildWebhook(client *client.Client, scheme *runtime.Scheme) *common.KogitoBuildWebhook {
+	return &common.KogitoBuildWebhook{
+		KogitoWebhook: common.KogitoWebhook{
+			Client:        client,
+			Scheme:        scheme,
+			Version:       app2.Version,
+			WebhookObject: &v1beta1.KogitoBuild{},
+		},
+	}
+}
+
+//+kubebuilder:webhook:path=/mutate-app-kiegroup-org-v1beta1-kogitoinfra,mutating=true,failurePolicy=fail,sideEffects=None,groups=app.kiegroup.org,resources=kogitoinfras,verbs=create;update,versions=v1beta1,name=mkogitoinfra.app.kiegroup.org,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-app-kiegroup-org-v1beta1-kogitoinfra,mutating=false,failurePolicy=fail,sideEffects=None,groups=app.kiegroup.org,resources=kogitoinfras,verbs=create;update,versions=v1beta1,name=vkogitoinfra.app.kiegroup.org,admissionReviewVersions=v1
+
+// NewKogitoInfraWebhook ...
+func NewKogitoInfraWebhook(client *client.Client, scheme *runtime.Scheme) *common.KogitoInfraWebhook {
+	return &common.KogitoInfraWebhook{
+		KogitoWebhook: common.KogitoWebhook{
+			Client:        client,
+			Scheme:        scheme,
+			Version:       app2.Version,
+			WebhookObject: &v1beta1.KogitoInfra{},
+		},
+	}
+}
+
+//+kubebuilder:webhook:path=/mutate-app-kiegroup-org-v1beta1-kogitosupportingservice,mutating=true,failurePolicy=fail,sideEffects=None,groups=app.kiegroup.org,resources=kogitosupportingservices,verbs=create;update,versions=v1beta1,name=mkogitosupportingservice.app.kiegroup.org,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-app-kiegroup-org-v1beta1-kogitosupportingservice,mutating=false,failurePolicy=fail,sideEffects=None,groups=app.kiegroup.org,resources=kogitosupportingservices,verbs=create;update,versions=v1beta1,name=vkogitosupportingservice.app.kiegroup.org,admissionReviewVersions=v1
+
+// NewKogitoSupportingServiceWebhook ...
+func NewKogitoSupportingServiceWebhook(client *client.Client, scheme *runtime.Scheme) *common.KogitoSupportingServiceWebhook {
+	return &common.KogitoSupportingServiceWebhook{
+		KogitoWebhook: common.KogitoWebhook{
+			Client:        client,
+			Scheme:        scheme,
+			Version:       app2.Version,
+			WebhookObject: &v1beta1.KogitoSupportingService{},
+		},
+	}
+}
@@ -0,0 +1,162 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	"context"
+	"sync"
+
+	kogitocli "github.com/kiegroup/kogito-operator/core/client"
+	"github.com/kiegroup/kogito-operator/core/framework/util"
+	"github.com/kiegroup/kogito-operator/core/logger"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const apiDiscoveryControllerName = "apidiscovery"
+
+// every CustomResourceDefinition event is mapped to this single request, so a burst of changes triggers only one discovery refresh
+var apiDiscoveryRequest = reconcile.Request{NamespacedName: types.NamespacedName{Name: apiDiscoveryControllerName}}
+
+// deferredWatch holds a watch that can only be started once its API is served by the cluster
+type deferredWatch struct {
+	available func() bool
+	start     func() error
+}
+
+var (
+	deferredWatchesMutex sync.Mutex
+	deferredWatches      []deferredWatch
+)
+
+// watchWhenAvailable starts the watch right away if the API group is available, otherwise defers it until
+// the APIDiscoveryReconciler detects the group after a CustomResourceDefinition is installed
+func watchWhenAvailable(cli *kogitocli.Client, group string, start func() error) error {
+	return deferWatch(func() bool { return cli.HasServerGroup(group) }, start)
+}
+
+// watchWhenVersionAvailable starts the watch right away if the API group version is available, otherwise defers it
+// like watchWhenAvailable. Used for the APIs whose group is always served but not every version, e.g. autoscaling/v2.
+func watchWhenVersionAvailable(cli *kogitocli.Client, groupVersion schema.GroupVersion, start func() error) error {
+	return deferWatch(func() bool { return cli.HasServerGroupVersion(groupVersion) }, start)
+}
+
+func deferWatch(available func() bool, start func() error) error {
+	deferredWatchesMutex.Lock()
+	defer deferredWatchesMutex.Unlock()
+	if available() {
+		return start()
+	}
+	deferredWatches = append(deferredWatches, deferredWatch{available: available, start: start})
+	return nil
+}
+
+// startAvailableWatches starts the deferred watches whose APIs are now served by the cluster
+func startAvailableWatches() error {
+	deferredWatchesMutex.Lock()
+	defer deferredWatchesMutex.Unlock()
+	var pending []deferredWatch
+	var resultErr error
+	for _, watch := range deferredWatches {
+		if !watch.available() {
+			pending = append(pending, watch)
+			continue
+		}
+		if err := watch.start(); err != nil {
+			pending = append(pending, watch)
+			resultErr = err
+		}
+	}
+	deferredWatches = pending
+	return resultErr
+}
+
+// APIDiscoveryReconciler keeps the cached API discovery in sync with the CustomResourceDefinitions installed in the cluster,
+// starting the watches on optional APIs (e.g. Knative, Strimzi) as soon as they are installed
+type APIDiscoveryReconciler struct {
+	*kogitocli.Client
+}
+
+// Reconcile invalidates the cached API discovery and starts the deferred watches whose APIs became available
+func (r *APIDiscoveryReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logger.FromContext(ctx)
+	log.Debug("CustomResourceDefinitions changed, refreshing API discovery")
+	r.InvalidateDiscovery()
+	if err := startAvailableWatches(); err != nil {
+		log.Error(err, "Failed to start watches for newly available APIs")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager registers the controller with manager
+func (r *APIDiscoveryReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// CustomResourceDefinitions are cluster-scoped, so an operator restricted to some namespaces polls the discovery API instead
+	if len(util.GetWatchNamespaces()) > 0 {
+		return mgr.Add(manager.RunnableFunc(r.pollAPIDiscovery))
+	}
+	pred := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			// new APIs are only served once their definition is established
+			return isCRDEstablished(e.ObjectOld) != isCRDEstablished(e.ObjectNew)
+		},
+	}
+	options := controllerOptionsFor(apiDiscoveryControllerName)
+	options.Reconciler = r
+	c, err := controller.New(apiDiscoveryControllerName, mgr, options)
+	if err != nil {
+		return err
+	}
+	return c.Watch(&source.Kind{Type: &apiextensionsv1.CustomResourceDefinition{}},
+		handler.EnqueueRequestsFromMapFunc(func(client.Object) []reconcile.Request {
+			return []reconcile.Request{apiDiscoveryRequest}
+		}), pred)
+}
+
+// pollAPIDiscovery refreshes the API discovery periodically until the manager stops
+func (r *APIDiscoveryReconciler) pollAPIDiscovery(ctx context.Context) error {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		// errors are already logged, the next poll will retry
+		_, _ = r.Reconcile(ctx, apiDiscoveryRequest)
+	}, kogitocli.DiscoveryRefreshInterval)
+	return nil
+}
+
+func isCRDEstablished(object client.Object) bool {
+	crd, ok := object.(*apiextensionsv1.CustomResourceDefinition)
+	if !ok {
+		return false
+	}
+	for _, condition := range crd.Status.Conditions {
+		if condition.Type == apiextensionsv1.Established {
+			return condition.Status == apiextensionsv1.ConditionTrue
+		}
+	}
+	return false
+}
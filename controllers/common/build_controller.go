@@ -29,6 +29,7 @@ import (
 	"github.com/kiegroup/kogito-operator/core/operator"
 	buildv1 "github.com/openshift/api/build/v1"
 	imagev1 "github.com/openshift/api/image/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -77,9 +78,16 @@ func (r *KogitoBuildReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		log.Warn("Kogito Build not found")
 		return
 	}
+	if paused, pauseErr := skipPausedReconciliation(buildContext, kogitoBuildKind, instance, instance.GetStatus()); paused || pauseErr != nil {
+		return ctrl.Result{}, pauseErr
+	}
 
 	buildStatusHandler := kogitobuild.NewStatusHandler(buildContext, buildHandler)
 	defer buildStatusHandler.HandleStatusChange(instance, resultErr)
+	defer func() {
+		reason := infrastructure.NewReconciliationErrorHandler(buildContext).GetReasonForError(resultErr)
+		observeReconcile(kogitoBuildKind, result, resultErr, string(reason))
+	}()
 
 	if len(instance.GetSpec().GetRuntime()) == 0 {
 		instance.GetSpec().SetRuntime(api.QuarkusRuntimeType)
@@ -90,15 +98,18 @@ func (r *KogitoBuildReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		instance.GetSpec().SetTargetKogitoRuntime(instance.GetName())
 	}
 
-	// create the Kogito Image Streams to build the service if needed
-	buildImageHandler := kogitobuild.NewImageSteamHandler(buildContext)
-	created, resultErr := buildImageHandler.CreateRequiredKogitoImageStreams(instance)
-	if resultErr != nil {
-		return result, fmt.Errorf("Error while creating Kogito ImageStreams: %s ", resultErr)
-	}
-	if created {
-		result = reconcile.Result{RequeueAfter: imageStreamCreationReconcileTimeout, Requeue: true}
-		return
+	// create the Kogito Image Streams to build the service if needed, on Kubernetes images are pulled straight from the registry
+	if r.IsOpenshift() {
+		buildImageHandler := kogitobuild.NewImageSteamHandler(buildContext)
+		created, err := buildImageHandler.CreateRequiredKogitoImageStreams(instance)
+		if err != nil {
+			resultErr = fmt.Errorf("Error while creating Kogito ImageStreams: %s ", err)
+			return
+		}
+		if created {
+			result = reconcile.Result{RequeueAfter: infrastructure.RequeueAfter(imageStreamCreationReconcileTimeout), Requeue: true}
+			return
+		}
 	}
 
 	// get the build manager to start the reconciliation logic
@@ -112,9 +123,11 @@ func (r *KogitoBuildReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 // SetupWithManager registers the controller with manager
 func (r *KogitoBuildReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	b := ctrl.NewControllerManagedBy(mgr).For(r.ReconcilingObject)
+	b := ctrl.NewControllerManagedBy(mgr).For(r.ReconcilingObject).WithOptions(controllerOptionsFor(kogitoBuildKind))
 	if r.IsOpenshift() {
 		b.Owns(&buildv1.BuildConfig{}).Owns(&imagev1.ImageStream{})
+	} else {
+		b.Owns(&corev1.ConfigMap{}).Owns(&batchv1.Job{})
 	}
 	return b.Complete(r)
 }
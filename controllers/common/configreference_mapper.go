@@ -0,0 +1,80 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	"context"
+
+	kogitocli "github.com/kiegroup/kogito-operator/core/client"
+	"github.com/kiegroup/kogito-operator/core/kogitoservice"
+	"github.com/kiegroup/kogito-operator/core/logger"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// newConfigReferenceMapper maps a ConfigMap or a Secret to the Kogito services of the given kind whose Deployments consume it,
+// so that a change in the configuration rolls out the pods.
+// The watched type is given by config, since the objects of a metadata only watch don't carry it.
+func newConfigReferenceMapper(cli *kogitocli.Client, scheme *runtime.Scheme, owner client.Object, config client.Object) (handler.MapFunc, error) {
+	ownerGVK, err := apiutil.GVKForObject(owner, scheme)
+	if err != nil {
+		return nil, err
+	}
+	_, isSecret := config.(*corev1.Secret)
+	return func(object client.Object) []reconcile.Request {
+		ctx := context.TODO()
+		log := logger.FromContext(ctx)
+		deployments := &appsv1.DeploymentList{}
+		if err := cli.ControlCli.List(ctx, deployments, client.InNamespace(object.GetNamespace())); err != nil {
+			log.Error(err, "Failed to list Deployments consuming configuration", "namespace", object.GetNamespace())
+			return nil
+		}
+		var requests []reconcile.Request
+		for _, deployment := range deployments.Items {
+			controller := metav1.GetControllerOf(&deployment)
+			if controller == nil || controller.APIVersion != ownerGVK.GroupVersion().String() || controller.Kind != ownerGVK.Kind {
+				continue
+			}
+			configMaps, secrets := kogitoservice.GetPodConfigReferences(&deployment.Spec.Template.Spec)
+			references := configMaps
+			if isSecret {
+				references = secrets
+			}
+			if containsReference(references, object.GetName()) {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: controller.Name, Namespace: deployment.Namespace}})
+			}
+		}
+		return requests
+	}, nil
+}
+
+func containsReference(references []string, name string) bool {
+	for _, reference := range references {
+		if reference == name {
+			return true
+		}
+	}
+	return false
+}
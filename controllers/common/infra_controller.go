@@ -21,10 +21,13 @@ import (
 	"context"
 	"reflect"
 
+	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
+	postgresql "github.com/kiegroup/kogito-operator/core/infrastructure/postgresql/v1beta1"
 	"github.com/kiegroup/kogito-operator/core/kogitoinfra"
 	"github.com/kiegroup/kogito-operator/core/logger"
 	"github.com/kiegroup/kogito-operator/core/manager"
 	"github.com/kiegroup/kogito-operator/core/operator"
+	"knative.dev/eventing/pkg/apis/eventing"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -52,12 +55,15 @@ type KogitoInfraReconciler struct {
 //+kubebuilder:rbac:groups=infinispan.org,resources=infinispans,verbs=get;create;list;delete;watch
 //+kubebuilder:rbac:groups=kafka.strimzi.io,resources=kafkas;kafkatopics,verbs=get;create;list;delete;watch
 //+kubebuilder:rbac:groups=keycloak.org,resources=keycloaks,verbs=get;create;list;delete;watch
+//+kubebuilder:rbac:groups=keycloak.org,resources=keycloakrealms,verbs=get;list;watch
+//+kubebuilder:rbac:groups=keycloak.org,resources=keycloakclients,verbs=get;create;list;update;delete;watch
 //+kubebuilder:rbac:groups=apps,resources=deployments/finalizers,verbs=update
 //+kubebuilder:rbac:groups=eventing.knative.dev,resources=brokers,verbs=get;list;watch
 //+kubebuilder:rbac:groups=eventing.knative.dev,resources=triggers,verbs=get;list;watch;create;delete;update
 //+kubebuilder:rbac:groups=sources.knative.dev,resources=sinkbindings,verbs=get;list;watch;create;delete;update
 //+kubebuilder:rbac:groups=integreatly.org,resources=grafanadashboards,verbs=get;create;list;watch;create;delete;update
 //+kubebuilder:rbac:groups=mongodbcommunity.mongodb.com,resources=mongodbcommunity,verbs=get;create;list;watch;delete
+//+kubebuilder:rbac:groups=postgres-operator.crunchydata.com,resources=postgresclusters,verbs=get;list;watch
 
 // Reconcile reads that state of the cluster for a KogitoInfra object and makes changes based on the state read
 // and what is in the KogitoInfra.Spec
@@ -83,6 +89,12 @@ func (r *KogitoInfraReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		log.Debug("KogitoInfra instance not found")
 		return reconcile.Result{}, nil
 	}
+	if !instance.GetDeletionTimestamp().IsZero() {
+		return reconcile.Result{}, kogitoinfra.NewKeycloakClientFinalizerHandler(kogitoContext).Finalize(instance)
+	}
+	if paused, pauseErr := skipPausedReconciliation(kogitoContext, kogitoInfraKind, instance, instance.GetStatus()); paused || pauseErr != nil {
+		return reconcile.Result{}, pauseErr
+	}
 	var resultErr error
 	statusHandler := kogitoinfra.NewStatusHandler(kogitoContext, infraHandler)
 	defer statusHandler.UpdateBaseStatus(instance, &resultErr)
@@ -127,7 +139,7 @@ func (r *KogitoInfraReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return reconcilerHandler.GetReconcileResultFor(resultErr, false)
 	}
 
-	return reconcile.Result{}, nil
+	return reconcilerHandler.GetReconcileResultFor(nil, false)
 }
 
 // SetupWithManager registers the controller with manager
@@ -138,12 +150,30 @@ func (r *KogitoInfraReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			return reflect.DeepEqual(e.ObjectNew.GetOwnerReferences(), e.ObjectOld.GetOwnerReferences())
 		},
 	}
-	b := ctrl.NewControllerManagedBy(mgr).For(r.ReconcilingObject, builder.WithPredicates(pred))
+	b := ctrl.NewControllerManagedBy(mgr).For(r.ReconcilingObject, builder.WithPredicates(pred)).
+		WithOptions(controllerOptionsFor(kogitoInfraKind))
 	b = kogitoinfra.AppendInfinispanWatchedObjects(b)
-	b = kogitoinfra.AppendKafkaWatchedObjects(b)
 	b = kogitoinfra.AppendKeycloakWatchedObjects(b)
 	b = kogitoinfra.AppendMongoDBWatchedObjects(b)
 	b = kogitoinfra.AppendConfigMapWatchedObjects(b)
 	b = kogitoinfra.AppendSecretWatchedObjects(b)
-	return b.Complete(r)
+	c, err := b.Build(r)
+	if err != nil {
+		return err
+	}
+	// the Crunchy Data PostgreSQL Operator, Strimzi and Knative Eventing might be installed after the operator,
+	// so their watches are started as soon as their APIs are available
+	if err = watchWhenAvailable(r.Client, postgresql.GroupVersion.Group, func() error {
+		return kogitoinfra.AppendPostgreSQLWatchedObjects(c, r.Client, r.Scheme, r.ReconcilingObject)
+	}); err != nil {
+		return err
+	}
+	if err = watchWhenAvailable(r.Client, v1beta2.SchemeGroupVersion.Group, func() error {
+		return kogitoinfra.AppendKafkaWatchedObjects(c, r.Client, r.Scheme, r.ReconcilingObject)
+	}); err != nil {
+		return err
+	}
+	return watchWhenAvailable(r.Client, eventing.GroupName, func() error {
+		return kogitoinfra.AppendKnativeWatchedObjects(c, r.Client, r.Scheme, r.ReconcilingObject)
+	})
 }
@@ -0,0 +1,54 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	kogitocli "github.com/kiegroup/kogito-operator/core/client"
+	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
+	"github.com/kiegroup/kogito-operator/core/kogitoservice"
+	"knative.dev/eventing/pkg/apis/eventing"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/eventing/pkg/apis/sources"
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// watchMessagingObjects watches the Strimzi and Knative Eventing objects created for the services of the given type.
+// Both might be installed after the operator, so the watches are started as soon as their APIs are available.
+func watchMessagingObjects(c controller.Controller, cli *kogitocli.Client, ownerType client.Object) error {
+	ownerHandler := &handler.EnqueueRequestForOwner{OwnerType: ownerType, IsController: true}
+	if err := watchWhenAvailable(cli, v1beta2.SchemeGroupVersion.Group, func() error {
+		if err := c.Watch(&source.Kind{Type: &v1beta2.KafkaTopic{}}, handler.EnqueueRequestsFromMapFunc(kogitoservice.MapKafkaTopicToServices)); err != nil {
+			return err
+		}
+		return c.Watch(&source.Kind{Type: &v1beta2.KafkaUser{}}, ownerHandler)
+	}); err != nil {
+		return err
+	}
+	if err := watchWhenAvailable(cli, eventing.GroupName, func() error {
+		return c.Watch(&source.Kind{Type: &eventingv1.Trigger{}}, ownerHandler)
+	}); err != nil {
+		return err
+	}
+	return watchWhenAvailable(cli, sources.GroupName, func() error {
+		return c.Watch(&source.Kind{Type: &sourcesv1.SinkBinding{}}, ownerHandler)
+	})
+}
@@ -0,0 +1,57 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/metrics"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// kinds used to label the reconciliation outcomes and the controller settings in the operator metrics
+const (
+	kogitoRuntimeKind           = "KogitoRuntime"
+	kogitoSupportingServiceKind = "KogitoSupportingService"
+	kogitoBuildKind             = "KogitoBuild"
+	kogitoInfraKind             = "KogitoInfra"
+	runtimeDeploymentKind       = "KogitoRuntimeDeployment"
+)
+
+// getReconcileResultFor converts the given error into the reconcile result and records the reconciliation outcome in the operator metrics
+func getReconcileResultFor(context operator.Context, kind string, err error) (ctrl.Result, error) {
+	errorHandler := infrastructure.NewReconciliationErrorHandler(context)
+	result, resultErr := errorHandler.GetReconcileResultFor(err)
+	observeReconcile(kind, result, resultErr, string(errorHandler.GetReasonForError(err)))
+	return result, resultErr
+}
+
+// observeReconcile records the reconciliation outcome in the operator metrics
+func observeReconcile(kind string, result ctrl.Result, err error, reason string) {
+	switch {
+	case err != nil:
+		metrics.ObserveReconcile(kind, metrics.ReconcileError, reason)
+	case result.Requeue || result.RequeueAfter > 0:
+		metrics.ObserveReconcile(kind, metrics.ReconcileRequeue, reason)
+	case len(reason) > 0:
+		// the error was handled, but the reconciliation won't happen again
+		metrics.ObserveReconcile(kind, metrics.ReconcileError, reason)
+	default:
+		metrics.ObserveReconcile(kind, metrics.ReconcileSuccess, reason)
+	}
+}
@@ -0,0 +1,152 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/metrics"
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+)
+
+const (
+	// overall rate limiting applied to every controller queue, same as the controller-runtime defaults
+	rateLimiterQPS   = 10
+	rateLimiterBurst = 100
+)
+
+// configurableControllers are the controllers whose number of workers can be set
+var configurableControllers = []string{kogitoRuntimeKind, kogitoSupportingServiceKind, kogitoBuildKind, kogitoInfraKind, runtimeDeploymentKind, apiDiscoveryControllerName}
+
+// MaxConcurrentReconcilesPerController holds the number of workers of specific controllers.
+// It can be set as a flag with a comma separated list of controller=workers, e.g. KogitoRuntime=4,KogitoBuild=2
+type MaxConcurrentReconcilesPerController map[string]int
+
+// String flag.Value implementation
+func (m MaxConcurrentReconcilesPerController) String() string {
+	var values []string
+	for name, workers := range m {
+		values = append(values, fmt.Sprintf("%s=%d", name, workers))
+	}
+	sort.Strings(values)
+	return strings.Join(values, ",")
+}
+
+// Set flag.Value implementation
+func (m MaxConcurrentReconcilesPerController) Set(value string) error {
+	for _, entry := range strings.Split(value, ",") {
+		if len(strings.TrimSpace(entry)) == 0 {
+			continue
+		}
+		nameAndWorkers := strings.SplitN(entry, "=", 2)
+		if len(nameAndWorkers) != 2 {
+			return fmt.Errorf("invalid entry %q, expected controller=workers", entry)
+		}
+		workers, err := strconv.Atoi(strings.TrimSpace(nameAndWorkers[1]))
+		if err != nil || workers < 1 {
+			return fmt.Errorf("invalid number of workers in %q, expected a positive integer", entry)
+		}
+		// controllers are named after the lowercase kind they reconcile
+		name := strings.ToLower(strings.TrimSpace(nameAndWorkers[0]))
+		if !isConfigurableController(name) {
+			return fmt.Errorf("unknown controller in %q, expected one of %s", entry, strings.Join(configurableControllers, ", "))
+		}
+		m[name] = workers
+	}
+	return nil
+}
+
+func isConfigurableController(name string) bool {
+	for _, controllerName := range configurableControllers {
+		if strings.ToLower(controllerName) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ControllerOptions tunes how many reconciliations run in parallel and how the failed ones are retried
+type ControllerOptions struct {
+	// MaxConcurrentReconciles is the number of workers of every controller not listed in MaxConcurrentReconcilesPerController
+	MaxConcurrentReconciles              int
+	MaxConcurrentReconcilesPerController MaxConcurrentReconcilesPerController
+	// RetryBaseDelay and RetryMaxDelay are the bounds of the exponential backoff applied to the failed reconciliations
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+	// RequeueJitterFactor is the maximum fraction of the requeue interval randomly added to it
+	RequeueJitterFactor float64
+}
+
+// DefaultControllerOptions creates the options with the same values used by controller-runtime
+func DefaultControllerOptions() ControllerOptions {
+	return ControllerOptions{
+		MaxConcurrentReconciles:              1,
+		MaxConcurrentReconcilesPerController: MaxConcurrentReconcilesPerController{},
+		RetryBaseDelay:                       5 * time.Millisecond,
+		RetryMaxDelay:                        1000 * time.Second,
+		RequeueJitterFactor:                  infrastructure.DefaultRequeueJitterFactor,
+	}
+}
+
+var controllerOptions = DefaultControllerOptions()
+
+// ConfigureControllers sets the options of the controllers set up afterwards and exposes them in the operator metrics
+func ConfigureControllers(options ControllerOptions) error {
+	if options.MaxConcurrentReconciles < 1 {
+		return fmt.Errorf("max concurrent reconciles must be a positive integer, got %d", options.MaxConcurrentReconciles)
+	}
+	if options.RetryBaseDelay <= 0 || options.RetryMaxDelay < options.RetryBaseDelay {
+		return fmt.Errorf("retry delays must be positive and the max delay (%s) can't be lower than the base delay (%s)", options.RetryMaxDelay, options.RetryBaseDelay)
+	}
+	if options.RequeueJitterFactor < 0 {
+		return fmt.Errorf("requeue jitter factor can't be negative, got %f", options.RequeueJitterFactor)
+	}
+	if options.MaxConcurrentReconcilesPerController == nil {
+		options.MaxConcurrentReconcilesPerController = MaxConcurrentReconcilesPerController{}
+	}
+	controllerOptions = options
+	infrastructure.SetRequeueJitterFactor(options.RequeueJitterFactor)
+	metrics.SetRetryDelay(options.RetryBaseDelay, options.RetryMaxDelay)
+	metrics.SetRequeueJitterFactor(options.RequeueJitterFactor)
+	return nil
+}
+
+// controllerOptionsFor creates the workers and the rate limiter of the given controller
+func controllerOptionsFor(name string) controller.Options {
+	name = strings.ToLower(name)
+	workers, ok := controllerOptions.MaxConcurrentReconcilesPerController[name]
+	if !ok {
+		workers = controllerOptions.MaxConcurrentReconciles
+	}
+	metrics.SetMaxConcurrentReconciles(name, workers)
+	return controller.Options{
+		MaxConcurrentReconciles: workers,
+		// every controller must have its own rate limiter, since it tracks the failures per item
+		RateLimiter: workqueue.NewMaxOfRateLimiter(
+			workqueue.NewItemExponentialFailureRateLimiter(controllerOptions.RetryBaseDelay, controllerOptions.RetryMaxDelay),
+			&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(rateLimiterQPS), rateLimiterBurst)},
+		),
+	}
+}
@@ -0,0 +1,37 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaxConcurrentReconcilesPerController_Set(t *testing.T) {
+	workers := MaxConcurrentReconcilesPerController{}
+	assert.NoError(t, workers.Set("KogitoRuntime=4, kogitobuild=2,"))
+	assert.Equal(t, MaxConcurrentReconcilesPerController{"kogitoruntime": 4, "kogitobuild": 2}, workers)
+	assert.Equal(t, "kogitobuild=2,kogitoruntime=4", workers.String())
+
+	err := workers.Set("KogitoRuntimes=4")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown controller")
+	assert.Error(t, workers.Set("KogitoRuntime=0"))
+	assert.Error(t, workers.Set("KogitoRuntime"))
+}
@@ -0,0 +1,43 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/metrics"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// skipPausedReconciliation keeps the Paused condition of the given resource in sync with its reconcile annotation.
+// Returns true if the reconciliation of the resource is paused and must be skipped.
+func skipPausedReconciliation(context operator.Context, kind string, instance client.Object, status framework.ConditionsHolder) (bool, error) {
+	paused := api.IsReconcilePaused(instance)
+	if framework.SetPausedCondition(status, paused) {
+		if err := kubernetes.ResourceC(context.Client).UpdateStatus(instance); err != nil {
+			return paused, err
+		}
+	}
+	if paused {
+		context.Log.Info("Reconciliation paused, remove the annotation to resume it", "annotation", api.ReconcileAnnotation)
+		metrics.ObserveReconcile(kind, metrics.ReconcilePaused, "")
+	}
+	return paused, nil
+}
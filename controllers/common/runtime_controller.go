@@ -22,21 +22,30 @@ import (
 
 	kogitocli "github.com/kiegroup/kogito-operator/core/client"
 	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	istio "github.com/kiegroup/kogito-operator/core/infrastructure/istio/v1beta1"
+	serving "github.com/kiegroup/kogito-operator/core/infrastructure/serving/v1"
 	"github.com/kiegroup/kogito-operator/core/kogitoservice"
 	"github.com/kiegroup/kogito-operator/core/logger"
 	"github.com/kiegroup/kogito-operator/core/manager"
+	"github.com/kiegroup/kogito-operator/core/metrics"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	"github.com/kiegroup/kogito-operator/core/shared"
 	imagev1 "github.com/openshift/api/image/v1"
 	routev1 "github.com/openshift/api/route/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 // KogitoRuntimeReconciler reconciles a KogitoRuntime object
@@ -62,6 +71,14 @@ type KogitoRuntimeReconciler struct {
 //+kubebuilder:rbac:groups=image.openshift.io,resources=imagestreams;imagestreamtags,verbs=get;create;list;watch;delete;update
 //+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings;roles,verbs=get;create;list;watch;delete;update
 //+kubebuilder:rbac:groups=route.openshift.io,resources=routes,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=networking.istio.io,resources=virtualservices,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=serving.knative.dev,resources=services,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=kafka.strimzi.io,resources=kafkatopics;kafkausers,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=eventing.knative.dev,resources=triggers,verbs=get;list;watch;create;delete;update
+//+kubebuilder:rbac:groups=sources.knative.dev,resources=sinkbindings,verbs=get;list;watch;create;delete;update
 //+kubebuilder:rbac:groups=core,resources=configmaps;events;pods;secrets;serviceaccounts;services,verbs=create;delete;get;list;patch;update;watch
 
 // Reconcile reads that state of the cluster for a KogitoRuntime object and makes changes based on the state read
@@ -84,16 +101,29 @@ func (r *KogitoRuntimeReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	runtimeHandler := r.RuntimeHandler(kogitoContext)
 	instance, err := runtimeHandler.FetchKogitoRuntimeInstance(req.NamespacedName)
 	if err != nil {
-		return
+		return getReconcileResultFor(kogitoContext, kogitoRuntimeKind, err)
 	}
 	if instance == nil {
 		log.Debug("KogitoRuntime instance not found")
+		metrics.ForgetInfraWaits(req.NamespacedName.String())
+		kogitoservice.ForgetServiceMetadata(req.NamespacedName)
 		return
 	}
+	if !instance.GetDeletionTimestamp().IsZero() {
+		metrics.ForgetInfraWaits(req.NamespacedName.String())
+		kogitoservice.ForgetServiceMetadata(req.NamespacedName)
+		if err = kogitoservice.NewKafkaUserFinalizerHandler(kogitoContext).Finalize(instance); err == nil {
+			err = kogitoservice.NewKafkaTopicsFinalizerHandler(kogitoContext).Finalize(instance)
+		}
+		return getReconcileResultFor(kogitoContext, kogitoRuntimeKind, err)
+	}
+	if paused, pauseErr := skipPausedReconciliation(kogitoContext, kogitoRuntimeKind, instance, instance.GetStatus()); paused || pauseErr != nil {
+		return ctrl.Result{}, pauseErr
+	}
 
 	rbacHandler := infrastructure.NewRBACHandler(kogitoContext)
 	if err = rbacHandler.SetupRBAC(req.Namespace); err != nil {
-		return
+		return getReconcileResultFor(kogitoContext, kogitoRuntimeKind, err)
 	}
 
 	supportingServiceHandler := r.SupportServiceHandler(kogitoContext)
@@ -108,25 +138,25 @@ func (r *KogitoRuntimeReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	infraHandler := r.InfraHandler(kogitoContext)
 	err = kogitoservice.NewServiceDeployer(kogitoContext, definition, instance, infraHandler).Deploy()
 	if err != nil {
-		return infrastructure.NewReconciliationErrorHandler(kogitoContext).GetReconcileResultFor(err)
+		return getReconcileResultFor(kogitoContext, kogitoRuntimeKind, err)
 	}
 
 	protoBufConfigMapReconciler := shared.NewProtoBufConfigMapReconciler(kogitoContext, instance)
 	err = protoBufConfigMapReconciler.Reconcile()
 	if err != nil {
 		log.Error(err, "Fail to create Proto Buf config map of Kogito runtime")
-		return infrastructure.NewReconciliationErrorHandler(kogitoContext).GetReconcileResultFor(err)
+		return getReconcileResultFor(kogitoContext, kogitoRuntimeKind, err)
 	}
 
 	protoBufHandler := shared.NewProtoBufHandler(kogitoContext, supportingServiceHandler)
 	err = protoBufHandler.MountProtoBufConfigMapOnDataIndex(instance)
 	if err != nil {
 		log.Error(err, "Fail to mount Proto Buf config map of Kogito runtime on DataIndex")
-		return infrastructure.NewReconciliationErrorHandler(kogitoContext).GetReconcileResultFor(err)
+		return getReconcileResultFor(kogitoContext, kogitoRuntimeKind, err)
 	}
 
 	log.Debug("Finish reconciliation", "requeue", result.Requeue, "requeueAfter", result.RequeueAfter)
-	return
+	return getReconcileResultFor(kogitoContext, kogitoRuntimeKind, nil)
 }
 
 // SetupWithManager registers the controller with manager
@@ -137,16 +167,62 @@ func (r *KogitoRuntimeReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			return false
 		},
 		UpdateFunc: func(e event.UpdateEvent) bool {
-			return e.ObjectNew.GetDeletionTimestamp().IsZero()
+			// services being deleted are reconciled to release the Kafka topics they provisioned and their KafkaUser
+			return e.ObjectNew.GetDeletionTimestamp().IsZero() || controllerutil.ContainsFinalizer(e.ObjectNew, kogitoservice.KafkaTopicsFinalizer) ||
+				controllerutil.ContainsFinalizer(e.ObjectNew, kogitoservice.KafkaUserFinalizer)
 		},
 	}
 	b := ctrl.NewControllerManagedBy(mgr).
 		For(r.ReconcilingObject, builder.WithPredicates(pred)).
-		Owns(&corev1.Service{}).Owns(&appsv1.Deployment{}).Owns(&corev1.ConfigMap{})
+		WithOptions(controllerOptionsFor(kogitoRuntimeKind)).
+		Owns(&corev1.Service{}).Owns(&appsv1.Deployment{}).Owns(&corev1.ConfigMap{}).Owns(&corev1.ServiceAccount{})
+
+	// consumed ConfigMaps and Secrets are not always owned by the service, e.g. the ones provided by the users or by KogitoInfra.
+	// Only their names are needed, so just their metadata is cached.
+	configMapMapper, err := newConfigReferenceMapper(r.Client, r.Scheme, r.ReconcilingObject, &corev1.ConfigMap{})
+	if err != nil {
+		return err
+	}
+	secretMapper, err := newConfigReferenceMapper(r.Client, r.Scheme, r.ReconcilingObject, &corev1.Secret{})
+	if err != nil {
+		return err
+	}
+	b.Watches(&source.Kind{Type: &corev1.ConfigMap{}}, handler.EnqueueRequestsFromMapFunc(configMapMapper), builder.OnlyMetadata).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(secretMapper), builder.OnlyMetadata)
 
 	if r.IsOpenshift() {
 		b.Owns(&routev1.Route{}).Owns(&imagev1.ImageStream{})
+	} else {
+		b.Owns(&networkingv1.Ingress{})
 	}
 
-	return b.Complete(r)
+	c, err := b.Build(r)
+	if err != nil {
+		return err
+	}
+	// Knative might be installed after the operator, so the watch is started as soon as its API is available
+	if err = watchWhenAvailable(r.Client, serving.GroupVersion.Group, func() error {
+		return c.Watch(&source.Kind{Type: &serving.Service{}}, &handler.EnqueueRequestForOwner{OwnerType: r.ReconcilingObject, IsController: true})
+	}); err != nil {
+		return err
+	}
+	// the VirtualService splitting the traffic during a Canary rollout requires Istio, which might be installed after the operator
+	if err = watchWhenAvailable(r.Client, istio.GroupVersion.Group, func() error {
+		return c.Watch(&source.Kind{Type: &istio.VirtualService{}}, &handler.EnqueueRequestForOwner{OwnerType: r.ReconcilingObject, IsController: true})
+	}); err != nil {
+		return err
+	}
+	// autoscaling/v2 is only served since Kubernetes 1.23
+	if err = watchWhenVersionAvailable(r.Client, autoscalingv2.SchemeGroupVersion, func() error {
+		return c.Watch(&source.Kind{Type: &autoscalingv2.HorizontalPodAutoscaler{}}, &handler.EnqueueRequestForOwner{OwnerType: r.ReconcilingObject, IsController: true})
+	}); err != nil {
+		return err
+	}
+	// policy/v1 is only served since Kubernetes 1.21
+	if err = watchWhenVersionAvailable(r.Client, policyv1.SchemeGroupVersion, func() error {
+		return c.Watch(&source.Kind{Type: &policyv1.PodDisruptionBudget{}}, &handler.EnqueueRequestForOwner{OwnerType: r.ReconcilingObject, IsController: true})
+	}); err != nil {
+		return err
+	}
+	return watchMessagingObjects(c, r.Client, r.ReconcilingObject)
 }
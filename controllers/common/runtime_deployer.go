@@ -64,7 +64,9 @@ func (d *runtimeDeployerHandler) OnDeploymentCreate(deployment *v1.Deployment) e
 		framework.SetEnvVar(envVarExternalURL, d.instance.GetStatus().GetExternalURI(), &deployment.Spec.Template.Spec.Containers[0])
 	}
 	// sa
-	deployment.Spec.Template.Spec.ServiceAccountName = infrastructure.RuntimeServiceAccountName
+	if len(deployment.Spec.Template.Spec.ServiceAccountName) == 0 {
+		deployment.Spec.Template.Spec.ServiceAccountName = infrastructure.RuntimeServiceAccountName
+	}
 	// istio
 	if d.instance.GetRuntimeSpec().IsEnableIstio() {
 		framework.AddIstioInjectSidecarAnnotation(&deployment.Spec.Template.ObjectMeta)
@@ -0,0 +1,71 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/kogitoservice"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// KogitoRuntimeWebhook defaults and validates KogitoRuntime objects before they are persisted
+type KogitoRuntimeWebhook struct {
+	KogitoWebhook
+}
+
+// SetupWebhookWithManager registers the webhook with manager
+func (w *KogitoRuntimeWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return w.setupWebhookWithManager(mgr, w, w)
+}
+
+// Default sets the default values of the KogitoRuntime being created or updated
+func (w *KogitoRuntimeWebhook) Default(ctx context.Context, obj runtime.Object) error {
+	instance, ok := obj.(api.KogitoRuntimeInterface)
+	if !ok {
+		return fmt.Errorf("expected a KogitoRuntime but got a %T", obj)
+	}
+	kogitoservice.SetRuntimeDefaults(instance)
+	return nil
+}
+
+// ValidateCreate validates the KogitoRuntime being created
+func (w *KogitoRuntimeWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	return w.validate(ctx, obj)
+}
+
+// ValidateUpdate validates the KogitoRuntime being updated
+func (w *KogitoRuntimeWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) error {
+	return w.validate(ctx, newObj)
+}
+
+// ValidateDelete does nothing, deletion is always allowed
+func (w *KogitoRuntimeWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) error {
+	return nil
+}
+
+func (w *KogitoRuntimeWebhook) validate(ctx context.Context, obj runtime.Object) error {
+	instance, ok := obj.(api.KogitoRuntimeInterface)
+	if !ok {
+		return fmt.Errorf("expected a KogitoRuntime but got a %T", obj)
+	}
+	return toInvalidError(instance, kogitoservice.ValidateRuntime(instance))
+}
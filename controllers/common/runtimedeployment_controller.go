@@ -19,12 +19,14 @@ package common
 
 import (
 	"context"
+	api "github.com/kiegroup/kogito-operator/apis"
 	kogitocli "github.com/kiegroup/kogito-operator/core/client"
 	dep "github.com/kiegroup/kogito-operator/core/deployment"
 	"github.com/kiegroup/kogito-operator/core/framework/util"
 	"github.com/kiegroup/kogito-operator/core/infrastructure"
 	"github.com/kiegroup/kogito-operator/core/logger"
 	"github.com/kiegroup/kogito-operator/core/manager"
+	"github.com/kiegroup/kogito-operator/core/metrics"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -61,22 +63,27 @@ func (r *RuntimeDeploymentReconciler) Reconcile(ctx context.Context, req ctrl.Re
 	deploymentHandler := infrastructure.NewDeploymentHandler(kogitoContext)
 	deployment, err := deploymentHandler.FetchDeployment(req.NamespacedName)
 	if err != nil {
-		return
+		return getReconcileResultFor(kogitoContext, runtimeDeploymentKind, err)
 	}
 	if deployment == nil {
 		log.Debug("KogitoDeployment instance not found")
 		return
 	}
+	if api.IsReconcilePaused(deployment) {
+		log.Info("Reconciliation paused, remove the annotation to resume it", "annotation", api.ReconcileAnnotation)
+		metrics.ObserveReconcile(runtimeDeploymentKind, metrics.ReconcilePaused, "")
+		return
+	}
 
 	runtimeHandler := r.RuntimeHandler(kogitoContext)
 	supportingServiceHandler := r.SupportServiceHandler(kogitoContext)
 	depProcessor := dep.NewDeploymentProcessor(kogitoContext, deployment, runtimeHandler, supportingServiceHandler)
 	err = depProcessor.Process()
 	if err != nil {
-		return infrastructure.NewReconciliationErrorHandler(kogitoContext).GetReconcileResultFor(err)
+		return getReconcileResultFor(kogitoContext, runtimeDeploymentKind, err)
 	}
 	log.Debug("Finish reconciliation", "requeue", result.Requeue, "requeueAfter", result.RequeueAfter)
-	return
+	return getReconcileResultFor(kogitoContext, runtimeDeploymentKind, nil)
 }
 
 // SetupWithManager registers the controller with manager
@@ -96,6 +103,7 @@ func (r *RuntimeDeploymentReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	}
 
 	b := ctrl.NewControllerManagedBy(mgr).
-		For(&appsv1.Deployment{}, builder.WithPredicates(pred))
+		For(&appsv1.Deployment{}, builder.WithPredicates(pred)).
+		WithOptions(controllerOptionsFor(runtimeDeploymentKind))
 	return b.Complete(r)
 }
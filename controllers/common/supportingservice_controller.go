@@ -21,22 +21,29 @@ import (
 	"context"
 
 	kogitocli "github.com/kiegroup/kogito-operator/core/client"
-	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/kogitoservice"
 	"github.com/kiegroup/kogito-operator/core/kogitosupportingservice"
 	"github.com/kiegroup/kogito-operator/core/logger"
 	"github.com/kiegroup/kogito-operator/core/manager"
+	"github.com/kiegroup/kogito-operator/core/metrics"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	app2 "github.com/kiegroup/kogito-operator/version/app"
 	imgv1 "github.com/openshift/api/image/v1"
 	routev1 "github.com/openshift/api/route/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 // KogitoSupportingServiceReconciler reconciles a KogitoSupportingService object
@@ -63,7 +70,13 @@ type KogitoSupportingServiceReconciler struct {
 //+kubebuilder:rbac:groups=integreatly.org,resources=grafanadashboards,verbs=get;create;list;watch;delete;update
 //+kubebuilder:rbac:groups=image.openshift.io,resources=imagestreams;imagestreamtags,verbs=get;create;list;watch;delete;update
 //+kubebuilder:rbac:groups=route.openshift.io,resources=routes,verbs=get;create;list;watch;delete;update
-//+kubebuilder:rbac:groups=core,resources=configmaps;events;pods;secrets;services,verbs=create;delete;get;list;patch;update;watch
+//+kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=kafka.strimzi.io,resources=kafkatopics;kafkausers,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=eventing.knative.dev,resources=triggers,verbs=get;list;watch;create;delete;update
+//+kubebuilder:rbac:groups=sources.knative.dev,resources=sinkbindings,verbs=get;list;watch;create;delete;update
+//+kubebuilder:rbac:groups=core,resources=configmaps;events;pods;secrets;serviceaccounts;services,verbs=create;delete;get;list;patch;update;watch
 
 // Reconcile reads that state of the cluster for a KogitoSupportingService object and makes changes based on the state read
 // and what is in the KogitoSupportingService.Spec
@@ -85,16 +98,29 @@ func (r *KogitoSupportingServiceReconciler) Reconcile(ctx context.Context, req c
 	supportingServiceHandler := r.SupportingServiceHandler(kogitoContext)
 	instance, resultErr := supportingServiceHandler.FetchKogitoSupportingService(req.NamespacedName)
 	if resultErr != nil {
-		return
+		return getReconcileResultFor(kogitoContext, kogitoSupportingServiceKind, resultErr)
 	}
 	if instance == nil {
 		log.Debug("kogitoSupportingService Instance not found")
+		metrics.ForgetInfraWaits(req.NamespacedName.String())
+		kogitoservice.ForgetServiceMetadata(req.NamespacedName)
 		return
 	}
+	if !instance.GetDeletionTimestamp().IsZero() {
+		metrics.ForgetInfraWaits(req.NamespacedName.String())
+		kogitoservice.ForgetServiceMetadata(req.NamespacedName)
+		if resultErr = kogitoservice.NewKafkaUserFinalizerHandler(kogitoContext).Finalize(instance); resultErr == nil {
+			resultErr = kogitoservice.NewKafkaTopicsFinalizerHandler(kogitoContext).Finalize(instance)
+		}
+		return getReconcileResultFor(kogitoContext, kogitoSupportingServiceKind, resultErr)
+	}
+	if paused, pauseErr := skipPausedReconciliation(kogitoContext, kogitoSupportingServiceKind, instance, instance.GetStatus()); paused || pauseErr != nil {
+		return ctrl.Result{}, pauseErr
+	}
 
 	supportingServiceManager := manager.NewKogitoSupportingServiceManager(kogitoContext, supportingServiceHandler)
 	if resultErr = supportingServiceManager.EnsureSingletonService(req.Namespace, instance.GetSupportingServiceSpec().GetServiceType()); resultErr != nil {
-		return
+		return getReconcileResultFor(kogitoContext, kogitoSupportingServiceKind, resultErr)
 	}
 
 	runtimeHandler := r.RuntimeHandler(kogitoContext)
@@ -102,10 +128,7 @@ func (r *KogitoSupportingServiceReconciler) Reconcile(ctx context.Context, req c
 	reconcileHandler := kogitosupportingservice.NewReconcilerHandler(kogitoContext, infraHandler, supportingServiceHandler, runtimeHandler)
 	reconciler := reconcileHandler.GetSupportingServiceReconciler(instance)
 	resultErr = reconciler.Reconcile()
-	if resultErr != nil {
-		return infrastructure.NewReconciliationErrorHandler(kogitoContext).GetReconcileResultFor(resultErr)
-	}
-	return
+	return getReconcileResultFor(kogitoContext, kogitoSupportingServiceKind, resultErr)
 }
 
 // SetupWithManager registers the controller with manager
@@ -116,16 +139,50 @@ func (r *KogitoSupportingServiceReconciler) SetupWithManager(mgr ctrl.Manager) e
 			return false
 		},
 		UpdateFunc: func(e event.UpdateEvent) bool {
-			return e.ObjectNew.GetDeletionTimestamp().IsZero()
+			// services being deleted are reconciled to release the Kafka topics they provisioned and their KafkaUser
+			return e.ObjectNew.GetDeletionTimestamp().IsZero() || controllerutil.ContainsFinalizer(e.ObjectNew, kogitoservice.KafkaTopicsFinalizer) ||
+				controllerutil.ContainsFinalizer(e.ObjectNew, kogitoservice.KafkaUserFinalizer)
 		},
 	}
 
 	b := ctrl.NewControllerManagedBy(mgr).
 		For(r.ReconcilingObject, builder.WithPredicates(pred)).
-		Owns(&corev1.Service{}).Owns(&appsv1.Deployment{}).Owns(&corev1.ConfigMap{})
+		WithOptions(controllerOptionsFor(kogitoSupportingServiceKind)).
+		Owns(&corev1.Service{}).Owns(&appsv1.Deployment{}).Owns(&corev1.ConfigMap{}).Owns(&corev1.ServiceAccount{})
+
+	// consumed ConfigMaps and Secrets are not always owned by the service, e.g. the ones provided by the users or by KogitoInfra.
+	// Only their names are needed, so just their metadata is cached.
+	configMapMapper, err := newConfigReferenceMapper(r.Client, r.Scheme, r.ReconcilingObject, &corev1.ConfigMap{})
+	if err != nil {
+		return err
+	}
+	secretMapper, err := newConfigReferenceMapper(r.Client, r.Scheme, r.ReconcilingObject, &corev1.Secret{})
+	if err != nil {
+		return err
+	}
+	b.Watches(&source.Kind{Type: &corev1.ConfigMap{}}, handler.EnqueueRequestsFromMapFunc(configMapMapper), builder.OnlyMetadata).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(secretMapper), builder.OnlyMetadata)
 
 	if r.IsOpenshift() {
 		b.Owns(&routev1.Route{}).Owns(&imgv1.ImageStream{})
+	} else {
+		b.Owns(&networkingv1.Ingress{})
+	}
+	c, err := b.Build(r)
+	if err != nil {
+		return err
+	}
+	// autoscaling/v2 is only served since Kubernetes 1.23
+	if err = watchWhenVersionAvailable(r.Client, autoscalingv2.SchemeGroupVersion, func() error {
+		return c.Watch(&source.Kind{Type: &autoscalingv2.HorizontalPodAutoscaler{}}, &handler.EnqueueRequestForOwner{OwnerType: r.ReconcilingObject, IsController: true})
+	}); err != nil {
+		return err
+	}
+	// policy/v1 is only served since Kubernetes 1.21
+	if err = watchWhenVersionAvailable(r.Client, policyv1.SchemeGroupVersion, func() error {
+		return c.Watch(&source.Kind{Type: &policyv1.PodDisruptionBudget{}}, &handler.EnqueueRequestForOwner{OwnerType: r.ReconcilingObject, IsController: true})
+	}); err != nil {
+		return err
 	}
-	return b.Complete(r)
+	return watchMessagingObjects(c, r.Client, r.ReconcilingObject)
 }
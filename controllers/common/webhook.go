@@ -0,0 +1,65 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	"context"
+
+	kogitocli "github.com/kiegroup/kogito-operator/core/client"
+	"github.com/kiegroup/kogito-operator/core/logger"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// KogitoWebhook holds what's common to the admission webhooks of the Kogito custom resources
+type KogitoWebhook struct {
+	*kogitocli.Client
+	Scheme        *runtime.Scheme
+	Version       string
+	WebhookObject client.Object
+}
+
+func (w *KogitoWebhook) newContext(ctx context.Context) operator.Context {
+	return operator.Context{
+		Client:  w.Client,
+		Log:     logger.FromContext(ctx),
+		Scheme:  w.Scheme,
+		Version: w.Version,
+	}
+}
+
+func (w *KogitoWebhook) setupWebhookWithManager(mgr ctrl.Manager, defaulter admission.CustomDefaulter, validator admission.CustomValidator) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(w.WebhookObject).
+		WithDefaulter(defaulter).
+		WithValidator(validator).
+		Complete()
+}
+
+// toInvalidError converts the given validation errors in the Invalid status error returned to the API server
+func toInvalidError(obj client.Object, errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(obj.GetObjectKind().GroupVersionKind().GroupKind(), obj.GetName(), errs)
+}
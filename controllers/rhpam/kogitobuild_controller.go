@@ -33,6 +33,8 @@ import (
 //+kubebuilder:rbac:groups=apps,resources=deployments/finalizers,verbs=update
 //+kubebuilder:rbac:groups=build.openshift.io,resources=builds;buildconfigs,verbs=get;create;list;watch;delete;update
 //+kubebuilder:rbac:groups=image.openshift.io,resources=imagestreams;imagestreamtags,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;create;list;watch;delete;update
+//+kubebuilder:rbac:groups=core,resources=configmaps;secrets,verbs=get;create;list;watch;delete;update
 
 // NewKogitoBuildReconciler ...
 func NewKogitoBuildReconciler(client *client.Client, scheme *runtime.Scheme) *common.KogitoBuildReconciler {
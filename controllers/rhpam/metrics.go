@@ -0,0 +1,34 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rhpam
+
+import (
+	v1 "github.com/kiegroup/kogito-operator/apis/rhpam/v1"
+	"github.com/kiegroup/kogito-operator/core/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewKogitoResourcesCollector creates the collector exposing the health of the Kogito custom resources in the operator metrics
+func NewKogitoResourcesCollector(reader client.Reader) prometheus.Collector {
+	return metrics.NewKogitoResourcesCollector(reader, metrics.KogitoResourceLists{
+		NewKogitoRuntimeList: func() client.ObjectList { return &v1.KogitoRuntimeList{} },
+		NewKogitoInfraList:   func() client.ObjectList { return &v1.KogitoInfraList{} },
+		NewKogitoBuildList:   func() client.ObjectList { return &v1.KogitoBuildList{} },
+	})
+}
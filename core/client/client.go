@@ -62,11 +62,13 @@ type Client struct {
 	Discovery              discovery.DiscoveryInterface
 	DeploymentCli          appsv1.AppsV1Interface
 	KubernetesExtensionCli kubernetes.Interface
+	// RestConfig is the configuration used to connect to the cluster, required to stream data to pods
+	RestConfig *restclient.Config
 }
 
 // NewForConsole will create a brand new client using the local machine
 func NewForConsole(scheme *runtime.Scheme) *Client {
-	client, err := NewClientBuilder(scheme).WithBuildClient().WithDiscoveryClient().Build()
+	client, err := NewClientBuilder(scheme).WithBuildClient().WithDiscoveryClient().WithKubernetesExtensionClient().Build()
 	if err != nil {
 		panic(err)
 	}
@@ -111,6 +113,37 @@ func (c *Client) HasServerGroup(groupName string) bool {
 	return false
 }
 
+// HasServerGroupVersion detects if the given api group version is served by the server, e.g. autoscaling/v2
+func (c *Client) HasServerGroupVersion(groupVersion schema.GroupVersion) bool {
+	if c.Discovery != nil {
+		groups, err := c.Discovery.ServerGroups()
+		if err != nil {
+			log.Warn("Impossible to get server groups using discovery API", "error", err)
+			return false
+		}
+		for _, group := range groups.Groups {
+			if group.Name != groupVersion.Group {
+				continue
+			}
+			for _, version := range group.Versions {
+				if version.Version == groupVersion.Version {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	log.Warn("Tried to discover the platform, but no discovery API is available")
+	return false
+}
+
+// InvalidateDiscovery drops any cached discovery information, so the next lookup reflects the APIs currently installed in the cluster
+func (c *Client) InvalidateDiscovery() {
+	if cached, ok := c.Discovery.(discovery.CachedDiscoveryInterface); ok {
+		cached.Invalidate()
+	}
+}
+
 func newKubeClient(config *restclient.Config, scheme *runtime.Scheme, useDynamicRestMapper bool) (client.Client, error) {
 	log.Debug("Creating a new core client for kube connection")
 	var options client.Options
@@ -138,6 +138,7 @@ func (builder *builderStruct) Build() (*Client, error) {
 		}
 	}
 
+	client.RestConfig = config
 	client.ControlCli = builder.controllerCli
 	if client.ControlCli == nil {
 
@@ -152,10 +153,11 @@ func (builder *builderStruct) Build() (*Client, error) {
 	}
 
 	if builder.isDiscoveryClient {
-		client.Discovery, err = discovery.NewDiscoveryClientForConfig(config)
+		discoveryCli, err := discovery.NewDiscoveryClientForConfig(config)
 		if err != nil {
 			return nil, fmt.Errorf("Impossible to create new Discovery client: %v", err)
 		}
+		client.Discovery = NewCachedDiscoveryClient(discoveryCli, DiscoveryRefreshInterval)
 	}
 	if builder.isBuildClient {
 		client.BuildCli, err = buildv1.NewForConfig(config)
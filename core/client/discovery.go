@@ -0,0 +1,95 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
+)
+
+const (
+	// DiscoveryRefreshInterval is the maximum time the server groups are kept in cache before being fetched again
+	DiscoveryRefreshInterval = 5 * time.Minute
+)
+
+// cachedDiscoveryClient wraps a discovery client, keeping the server groups in memory until they expire or are invalidated.
+// Every other discovery call is delegated to the wrapped client.
+type cachedDiscoveryClient struct {
+	discovery.DiscoveryInterface
+	refreshInterval time.Duration
+	mutex           sync.RWMutex
+	groups          *metav1.APIGroupList
+	fetchedAt       time.Time
+}
+
+// NewCachedDiscoveryClient creates a discovery client that caches the server groups for the given refresh interval
+func NewCachedDiscoveryClient(delegate discovery.DiscoveryInterface, refreshInterval time.Duration) discovery.CachedDiscoveryInterface {
+	return &cachedDiscoveryClient{
+		DiscoveryInterface: delegate,
+		refreshInterval:    refreshInterval,
+	}
+}
+
+// ServerGroups returns the cached server groups, fetching them from the server when the cache is empty or expired
+func (c *cachedDiscoveryClient) ServerGroups() (*metav1.APIGroupList, error) {
+	c.mutex.RLock()
+	if c.isFreshLocked() {
+		defer c.mutex.RUnlock()
+		return c.groups, nil
+	}
+	c.mutex.RUnlock()
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// another caller might have refreshed the cache while we were waiting for the lock
+	if c.isFreshLocked() {
+		return c.groups, nil
+	}
+	groups, err := c.DiscoveryInterface.ServerGroups()
+	if err != nil {
+		return nil, err
+	}
+	c.groups = groups
+	c.fetchedAt = time.Now()
+	return c.groups, nil
+}
+
+// Fresh returns true if the server groups were fetched within the refresh interval
+func (c *cachedDiscoveryClient) Fresh() bool {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.isFreshLocked()
+}
+
+// Invalidate drops the cached server groups, forcing the next call to query the server
+func (c *cachedDiscoveryClient) Invalidate() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.groups = nil
+	c.fetchedAt = time.Time{}
+	if cached, ok := c.DiscoveryInterface.(discovery.CachedDiscoveryInterface); ok {
+		cached.Invalidate()
+	}
+}
+
+func (c *cachedDiscoveryClient) isFreshLocked() bool {
+	return c.groups != nil && time.Since(c.fetchedAt) < c.refreshInterval
+}
@@ -0,0 +1,93 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	discfake "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+// countingDiscovery counts the ServerGroups calls that reach the server
+type countingDiscovery struct {
+	*discfake.FakeDiscovery
+	calls int
+}
+
+func (d *countingDiscovery) ServerGroups() (*metav1.APIGroupList, error) {
+	d.calls++
+	return d.FakeDiscovery.ServerGroups()
+}
+
+func newCountingDiscovery(groups ...string) *countingDiscovery {
+	disco := &discfake.FakeDiscovery{Fake: &clienttesting.Fake{}}
+	for _, group := range groups {
+		disco.Resources = append(disco.Resources, &metav1.APIResourceList{GroupVersion: group + "/v1"})
+	}
+	return &countingDiscovery{FakeDiscovery: disco}
+}
+
+func Test_cachedDiscoveryClient_ServerGroups(t *testing.T) {
+	delegate := newCountingDiscovery("serving.knative.dev")
+	cli := &Client{Discovery: NewCachedDiscoveryClient(delegate, time.Hour)}
+
+	assert.True(t, cli.HasServerGroup("serving.knative.dev"))
+	assert.False(t, cli.HasServerGroup("kafka.strimzi.io"))
+	assert.False(t, cli.IsOpenshift())
+	assert.Equal(t, 1, delegate.calls)
+}
+
+func Test_cachedDiscoveryClient_ServerGroupVersions(t *testing.T) {
+	delegate := newCountingDiscovery("autoscaling")
+	cli := &Client{Discovery: NewCachedDiscoveryClient(delegate, time.Hour)}
+
+	assert.True(t, cli.HasServerGroupVersion(schema.GroupVersion{Group: "autoscaling", Version: "v1"}))
+	assert.False(t, cli.HasServerGroupVersion(schema.GroupVersion{Group: "autoscaling", Version: "v2"}))
+	assert.False(t, cli.HasServerGroupVersion(schema.GroupVersion{Group: "policy", Version: "v1"}))
+	assert.Equal(t, 1, delegate.calls)
+}
+
+func Test_cachedDiscoveryClient_Invalidate(t *testing.T) {
+	delegate := newCountingDiscovery("serving.knative.dev")
+	cli := &Client{Discovery: NewCachedDiscoveryClient(delegate, time.Hour)}
+
+	assert.False(t, cli.HasServerGroup("kafka.strimzi.io"))
+	delegate.Resources = append(delegate.Resources, &metav1.APIResourceList{GroupVersion: "kafka.strimzi.io/v1beta2"})
+	// still served from the cache
+	assert.False(t, cli.HasServerGroup("kafka.strimzi.io"))
+
+	cli.InvalidateDiscovery()
+	assert.False(t, cli.Discovery.(discovery.CachedDiscoveryInterface).Fresh())
+	assert.True(t, cli.HasServerGroup("kafka.strimzi.io"))
+	assert.Equal(t, 2, delegate.calls)
+}
+
+func Test_cachedDiscoveryClient_Expired(t *testing.T) {
+	delegate := newCountingDiscovery("serving.knative.dev")
+	cli := &Client{Discovery: NewCachedDiscoveryClient(delegate, 0)}
+
+	assert.True(t, cli.HasServerGroup("serving.knative.dev"))
+	assert.True(t, cli.HasServerGroup("serving.knative.dev"))
+	assert.Equal(t, 2, delegate.calls)
+}
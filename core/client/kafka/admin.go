@@ -0,0 +1,225 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kafka
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+)
+
+const (
+	defaultTimeout  = 10 * time.Second
+	defaultClientID = "kogito-operator"
+	// numPartitionsConfig and defaultReplicationFactorConfig are the broker defaults of the topics created without them
+	numPartitionsConfig            = "num.partitions"
+	defaultReplicationFactorConfig = "default.replication.factor"
+)
+
+// kafkaVersion is the oldest broker version supported by the admin client
+var kafkaVersion = sarama.V2_0_0_0
+
+// Config is the connection to a Kafka cluster
+type Config struct {
+	// BootstrapServers are the host:port of the brokers used to discover the cluster
+	BootstrapServers []string
+	// TLS encrypts the connections when set
+	TLS *tls.Config
+	// SASL authenticates the connections when set
+	SASL *SASLConfig
+	// Timeout of the connection and of each request, 10 seconds by default
+	Timeout time.Duration
+	// ClientID identifies the client in the broker logs and quotas
+	ClientID string
+}
+
+func (c Config) getTimeout() time.Duration {
+	if c.Timeout <= 0 {
+		return defaultTimeout
+	}
+	return c.Timeout
+}
+
+// newSaramaConfig translates the connection to the configuration of the sarama client
+func (c Config) newSaramaConfig() (*sarama.Config, error) {
+	config := sarama.NewConfig()
+	config.Version = kafkaVersion
+	config.ClientID = c.ClientID
+	if len(config.ClientID) == 0 {
+		config.ClientID = defaultClientID
+	}
+	timeout := c.getTimeout()
+	config.Net.DialTimeout = timeout
+	config.Net.ReadTimeout = timeout
+	config.Net.WriteTimeout = timeout
+	config.Admin.Timeout = timeout
+	if c.TLS != nil {
+		config.Net.TLS.Enable = true
+		config.Net.TLS.Config = c.TLS
+	}
+	if c.SASL != nil {
+		if err := setSASL(config, c.SASL); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// SASLConfig is the SASL authentication of the connections
+type SASLConfig struct {
+	// Mechanism is one of PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512
+	Mechanism string
+	Username  string
+	Password  string
+}
+
+// TopicConfig is a topic to create. Partitions and replication factor left empty take the defaults of the broker.
+type TopicConfig struct {
+	Name              string
+	Partitions        *int32
+	ReplicationFactor *int32
+	Config            map[string]string
+}
+
+// AdminClient manages the topics of a Kafka cluster through a single connection. Requires brokers 2.0 or newer.
+type AdminClient interface {
+	// ListTopics lists the topics of the cluster, internal topics excluded
+	ListTopics() ([]string, error)
+	// CreateTopic creates the given topic, returns false when it already exists
+	CreateTopic(topic TopicConfig) (bool, error)
+	// Close closes the connection to the cluster
+	Close() error
+}
+
+type adminClient struct {
+	admin sarama.ClusterAdmin
+	// brokerDefaults are the partitions and replication factor of the topics created without them, read once when first needed
+	brokerDefaults *sarama.TopicDetail
+}
+
+// NewAdminClient connects to the given cluster on the first reachable bootstrap server. The client must be closed once done.
+func NewAdminClient(config Config) (AdminClient, error) {
+	if len(config.BootstrapServers) == 0 {
+		return nil, errors.New("no kafka bootstrap servers given")
+	}
+	saramaConfig, err := config.newSaramaConfig()
+	if err != nil {
+		return nil, err
+	}
+	admin, err := sarama.NewClusterAdmin(config.BootstrapServers, saramaConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to reach the kafka cluster: %w", err)
+	}
+	return &adminClient{admin: admin}, nil
+}
+
+func (a *adminClient) ListTopics() ([]string, error) {
+	// the metadata is enough, ClusterAdmin.ListTopics also describes the configuration of every topic
+	metadata, err := a.admin.DescribeTopics(nil)
+	if err != nil {
+		return nil, err
+	}
+	var topics []string
+	for _, topic := range metadata {
+		if !topic.IsInternal {
+			topics = append(topics, topic.Name)
+		}
+	}
+	sort.Strings(topics)
+	return topics, nil
+}
+
+func (a *adminClient) CreateTopic(topic TopicConfig) (bool, error) {
+	detail := &sarama.TopicDetail{ConfigEntries: map[string]*string{}}
+	for key := range topic.Config {
+		value := topic.Config[key]
+		detail.ConfigEntries[key] = &value
+	}
+	if topic.Partitions != nil {
+		detail.NumPartitions = *topic.Partitions
+	}
+	if topic.ReplicationFactor != nil {
+		detail.ReplicationFactor = int16(*topic.ReplicationFactor)
+	}
+	if topic.Partitions == nil || topic.ReplicationFactor == nil {
+		if a.brokerDefaults == nil {
+			defaults, err := getBrokerDefaults(a.admin)
+			if err != nil {
+				return false, err
+			}
+			a.brokerDefaults = defaults
+		}
+		if detail.NumPartitions == 0 {
+			detail.NumPartitions = a.brokerDefaults.NumPartitions
+		}
+		if detail.ReplicationFactor == 0 {
+			detail.ReplicationFactor = a.brokerDefaults.ReplicationFactor
+		}
+	}
+
+	err := a.admin.CreateTopic(topic.Name, detail, false)
+	if errors.Is(err, sarama.ErrTopicAlreadyExists) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (a *adminClient) Close() error {
+	return a.admin.Close()
+}
+
+// getBrokerDefaults gets the partitions and replication factor of the topics created without them from the controller.
+// The CreateTopics versions able to leave them to the broker aren't supported by the client.
+func getBrokerDefaults(admin sarama.ClusterAdmin) (*sarama.TopicDetail, error) {
+	_, controllerID, err := admin.DescribeCluster()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := admin.DescribeConfig(sarama.ConfigResource{
+		Type:        sarama.BrokerResource,
+		Name:        strconv.Itoa(int(controllerID)),
+		ConfigNames: []string{numPartitionsConfig, defaultReplicationFactorConfig},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the default partitions and replication factor of the kafka brokers: %w", err)
+	}
+	defaults := &sarama.TopicDetail{}
+	for _, entry := range entries {
+		value, err := strconv.ParseInt(entry.Value, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s in the kafka brokers: %w", entry.Name, err)
+		}
+		switch entry.Name {
+		case numPartitionsConfig:
+			defaults.NumPartitions = int32(value)
+		case defaultReplicationFactorConfig:
+			defaults.ReplicationFactor = int16(value)
+		}
+	}
+	if defaults.NumPartitions == 0 || defaults.ReplicationFactor == 0 {
+		return nil, errors.New("default partitions and replication factor not reported by the kafka brokers")
+	}
+	return defaults, nil
+}
@@ -0,0 +1,171 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kafka
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+)
+
+// newMockBroker is a single node cluster holding the given topics and the internal consumer offsets topic
+func newMockBroker(t *testing.T, topics ...string) *sarama.MockBroker {
+	broker := sarama.NewMockBroker(t, 1)
+	t.Cleanup(broker.Close)
+	metadata := &sarama.MetadataResponse{Version: sarama.NewMetadataRequest(kafkaVersion, nil).Version, ControllerID: broker.BrokerID()}
+	metadata.AddBroker(broker.Addr(), broker.BrokerID())
+	for _, topic := range topics {
+		metadata.AddTopicPartition(topic, 0, broker.BrokerID(), nil, nil, nil, sarama.ErrNoError)
+	}
+	metadata.AddTopic("__consumer_offsets", sarama.ErrNoError).IsInternal = true
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest":     sarama.NewMockWrapper(metadata),
+		"CreateTopicsRequest": sarama.NewMockCreateTopicsResponse(t),
+		"DescribeConfigsRequest": sarama.NewMockWrapper(&sarama.DescribeConfigsResponse{
+			Version: 1,
+			Resources: []*sarama.ResourceResponse{{
+				Type: sarama.BrokerResource,
+				Name: "1",
+				Configs: []*sarama.ConfigEntry{
+					{Name: numPartitionsConfig, Value: "1"},
+					{Name: defaultReplicationFactorConfig, Value: "3"},
+				},
+			}},
+		}),
+	})
+	return broker
+}
+
+// getCreatedTopics gets the topics requested to the broker
+func getCreatedTopics(broker *sarama.MockBroker) map[string]*sarama.TopicDetail {
+	topics := map[string]*sarama.TopicDetail{}
+	for _, exchange := range broker.History() {
+		if request, isCreateTopics := exchange.Request.(*sarama.CreateTopicsRequest); isCreateTopics {
+			for name, detail := range request.TopicDetails {
+				topics[name] = detail
+			}
+		}
+	}
+	return topics
+}
+
+func TestAdminClient_CreateTopic(t *testing.T) {
+	broker := newMockBroker(t, "existing")
+	client, err := NewAdminClient(Config{BootstrapServers: []string{"127.0.0.1:1", broker.Addr()}})
+	assert.NoError(t, err)
+	defer client.Close()
+
+	topics, err := client.ListTopics()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"existing"}, topics)
+
+	partitions := int32(3)
+	created, err := client.CreateTopic(TopicConfig{Name: "orders", Partitions: &partitions, Config: map[string]string{"retention.ms": "1000"}})
+	assert.NoError(t, err)
+	assert.True(t, created)
+	orders := getCreatedTopics(broker)["orders"]
+	assert.Equal(t, int32(3), orders.NumPartitions)
+	// default of the broker
+	assert.Equal(t, int16(3), orders.ReplicationFactor)
+	assert.Equal(t, "1000", *orders.ConfigEntries["retention.ms"])
+
+	// the defaults of the broker are only read once
+	created, err = client.CreateTopic(TopicConfig{Name: "payments"})
+	assert.NoError(t, err)
+	assert.True(t, created)
+	payments := getCreatedTopics(broker)["payments"]
+	assert.Equal(t, int32(1), payments.NumPartitions)
+	assert.Equal(t, int16(3), payments.ReplicationFactor)
+	describeConfigs := 0
+	for _, exchange := range broker.History() {
+		if _, isDescribeConfigs := exchange.Request.(*sarama.DescribeConfigsRequest); isDescribeConfigs {
+			describeConfigs++
+		}
+	}
+	assert.Equal(t, 1, describeConfigs)
+}
+
+func TestAdminClient_CreateExistingTopic(t *testing.T) {
+	broker := newMockBroker(t, "existing")
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).SetBroker(broker.Addr(), broker.BrokerID()).SetController(broker.BrokerID()),
+		"CreateTopicsRequest": sarama.NewMockWrapper(&sarama.CreateTopicsResponse{
+			Version:     3,
+			TopicErrors: map[string]*sarama.TopicError{"existing": {Err: sarama.ErrTopicAlreadyExists}},
+		}),
+	})
+	client, err := NewAdminClient(Config{BootstrapServers: []string{broker.Addr()}})
+	assert.NoError(t, err)
+	defer client.Close()
+
+	partitions, replicationFactor := int32(1), int32(1)
+	created, err := client.CreateTopic(TopicConfig{Name: "existing", Partitions: &partitions, ReplicationFactor: &replicationFactor})
+	assert.NoError(t, err)
+	assert.False(t, created)
+}
+
+func TestAdminClient_SASLPlain(t *testing.T) {
+	broker := newMockBroker(t)
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest":         sarama.NewMockMetadataResponse(t).SetBroker(broker.Addr(), broker.BrokerID()).SetController(broker.BrokerID()),
+		"SaslHandshakeRequest":    sarama.NewMockSaslHandshakeResponse(t).SetEnabledMechanisms([]string{SASLMechanismPlain}),
+		"SaslAuthenticateRequest": sarama.NewMockSaslAuthenticateResponse(t),
+	})
+	config := Config{BootstrapServers: []string{broker.Addr()}, SASL: &SASLConfig{Mechanism: SASLMechanismPlain, Username: "kogito", Password: "secret"}}
+	client, err := NewAdminClient(config)
+	assert.NoError(t, err)
+	_, err = client.ListTopics()
+	assert.NoError(t, err)
+	assert.NoError(t, client.Close())
+
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"SaslHandshakeRequest":    sarama.NewMockSaslHandshakeResponse(t).SetEnabledMechanisms([]string{SASLMechanismPlain}),
+		"SaslAuthenticateRequest": sarama.NewMockSaslAuthenticateResponse(t).SetError(sarama.ErrSASLAuthenticationFailed),
+	})
+	_, err = NewAdminClient(config)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to reach the kafka cluster")
+
+	_, err = NewAdminClient(Config{BootstrapServers: []string{broker.Addr()}, SASL: &SASLConfig{Mechanism: "GSSAPI"}})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported SASL mechanism")
+}
+
+// TestAdminClient_LocalBroker runs against a real broker, e.g. a local single node started with the Kafka quickstart:
+// KAFKA_BOOTSTRAP_SERVERS=localhost:9092 go test ./core/client/kafka/
+func TestAdminClient_LocalBroker(t *testing.T) {
+	bootstrapServers := os.Getenv("KAFKA_BOOTSTRAP_SERVERS")
+	if len(bootstrapServers) == 0 {
+		t.Skip("KAFKA_BOOTSTRAP_SERVERS not set")
+	}
+	client, err := NewAdminClient(Config{BootstrapServers: strings.Split(bootstrapServers, ",")})
+	assert.NoError(t, err)
+	defer client.Close()
+	topic := strings.ToLower(t.Name())
+	_, err = client.CreateTopic(TopicConfig{Name: topic})
+	assert.NoError(t, err)
+	created, err := client.CreateTopic(TopicConfig{Name: topic})
+	assert.NoError(t, err)
+	assert.False(t, created)
+	topics, err := client.ListTopics()
+	assert.NoError(t, err)
+	assert.Contains(t, topics, topic)
+}
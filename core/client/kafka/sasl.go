@@ -0,0 +1,82 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kafka
+
+import (
+	"fmt"
+
+	"github.com/IBM/sarama"
+	"github.com/xdg-go/scram"
+)
+
+const (
+	// SASLMechanismPlain authenticates with the username and password in clear, it should only be used over TLS
+	SASLMechanismPlain = "PLAIN"
+	// SASLMechanismScramSha256 authenticates with a SCRAM exchange using SHA-256
+	SASLMechanismScramSha256 = "SCRAM-SHA-256"
+	// SASLMechanismScramSha512 authenticates with a SCRAM exchange using SHA-512
+	SASLMechanismScramSha512 = "SCRAM-SHA-512"
+)
+
+// setSASL authenticates the connections of the sarama client with the given SASL configuration
+func setSASL(config *sarama.Config, sasl *SASLConfig) error {
+	config.Net.SASL.Enable = true
+	config.Net.SASL.User = sasl.Username
+	config.Net.SASL.Password = sasl.Password
+	switch sasl.Mechanism {
+	case SASLMechanismPlain:
+		config.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+	case SASLMechanismScramSha256:
+		config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA256
+		config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient { return &scramClient{hashGenerator: scram.SHA256} }
+	case SASLMechanismScramSha512:
+		config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA512
+		config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient { return &scramClient{hashGenerator: scram.SHA512} }
+	default:
+		return fmt.Errorf("unsupported SASL mechanism %s", sasl.Mechanism)
+	}
+	return nil
+}
+
+// scramClient runs the SCRAM exchanges of sarama with github.com/xdg-go/scram, see https://datatracker.ietf.org/doc/html/rfc5802
+type scramClient struct {
+	hashGenerator scram.HashGeneratorFcn
+	// nonceGenerator replaces the random client nonce in tests
+	nonceGenerator scram.NonceGeneratorFcn
+	conversation   *scram.ClientConversation
+}
+
+func (s *scramClient) Begin(userName, password, authzID string) error {
+	client, err := s.hashGenerator.NewClient(userName, password, authzID)
+	if err != nil {
+		return err
+	}
+	if s.nonceGenerator != nil {
+		client = client.WithNonceGenerator(s.nonceGenerator)
+	}
+	s.conversation = client.NewConversation()
+	return nil
+}
+
+func (s *scramClient) Step(challenge string) (string, error) {
+	return s.conversation.Step(challenge)
+}
+
+func (s *scramClient) Done() bool {
+	return s.conversation.Done()
+}
@@ -0,0 +1,79 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kafka
+
+import (
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/xdg-go/scram"
+)
+
+func rfc7677Nonce() string {
+	return "rOprNGfwEbeRWgbNEkqO"
+}
+
+// Test_scramClient uses the example exchange of https://datatracker.ietf.org/doc/html/rfc7677#section-3
+func Test_scramClient(t *testing.T) {
+	client := &scramClient{hashGenerator: scram.SHA256, nonceGenerator: rfc7677Nonce}
+	assert.NoError(t, client.Begin("user", "pencil", ""))
+
+	message, err := client.Step("")
+	assert.NoError(t, err)
+	assert.False(t, client.Done())
+	assert.Equal(t, "n,,n=user,r=rOprNGfwEbeRWgbNEkqO", message)
+
+	message, err = client.Step("r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0,s=W22ZaJ0SNY7soEsUEjb6gQ==,i=4096")
+	assert.NoError(t, err)
+	assert.False(t, client.Done())
+	assert.Equal(t, "c=biws,r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0,p=dHzbZapWIk4jUhN+Ute9ytag9zjfMHgsqmmiz7AndVQ=", message)
+
+	_, err = client.Step("v=6rriTRBi23WpRR/wtup+mMhUZUn/dB5nLTJRsjl95G4=")
+	assert.NoError(t, err)
+	assert.True(t, client.Done())
+}
+
+func Test_scramClient_InvalidServerSignature(t *testing.T) {
+	client := &scramClient{hashGenerator: scram.SHA256, nonceGenerator: rfc7677Nonce}
+	assert.NoError(t, client.Begin("user", "pencil", ""))
+	_, err := client.Step("")
+	assert.NoError(t, err)
+	_, err = client.Step("r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0,s=W22ZaJ0SNY7soEsUEjb6gQ==,i=4096")
+	assert.NoError(t, err)
+	_, err = client.Step("v=AAAA")
+	assert.Error(t, err)
+
+	// the server nonce must extend the client one
+	client = &scramClient{hashGenerator: scram.SHA256, nonceGenerator: rfc7677Nonce}
+	assert.NoError(t, client.Begin("user", "pencil", ""))
+	_, err = client.Step("")
+	assert.NoError(t, err)
+	_, err = client.Step("r=other,s=AA==,i=1")
+	assert.Error(t, err)
+}
+
+func Test_setSASL(t *testing.T) {
+	config := sarama.NewConfig()
+	assert.NoError(t, setSASL(config, &SASLConfig{Mechanism: SASLMechanismScramSha512, Username: "kogito", Password: "secret"}))
+	assert.True(t, config.Net.SASL.Enable)
+	assert.Equal(t, sarama.SASLMechanism(sarama.SASLTypeSCRAMSHA512), config.Net.SASL.Mechanism)
+	assert.NotNil(t, config.Net.SASL.SCRAMClientGeneratorFunc())
+
+	assert.Error(t, setSASL(sarama.NewConfig(), &SASLConfig{Mechanism: "GSSAPI"}))
+}
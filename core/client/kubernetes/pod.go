@@ -19,10 +19,14 @@ package kubernetes
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"io/ioutil"
 
 	"github.com/kiegroup/kogito-operator/core/client"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
 )
 
 // PodInterface has functions that interacts with pod object in the Kubernetes cluster
@@ -31,6 +35,10 @@ type PodInterface interface {
 	GetLogs(namespace, podName, containerName string) (string, error)
 	// Wait until pod is terminated and then return pod log
 	GetLogsWithFollow(namespace, podName, containerName string) (string, error)
+	// StreamLogs copies the pod log to the given writer according to the given options until the log stream is closed
+	StreamLogs(namespace, podName string, options *corev1.PodLogOptions, out io.Writer) error
+	// Exec runs the given command in the pod container, copying stdin to the command input and its outputs to stdout and stderr
+	Exec(namespace, podName, containerName string, command []string, stdin io.Reader, stdout, stderr io.Writer) error
 }
 
 type pod struct {
@@ -68,3 +76,39 @@ func (pod *pod) getLogs(namespace, podName, containerName string, follow bool) (
 	}
 	return string(bytes), nil
 }
+
+func (pod *pod) StreamLogs(namespace, podName string, options *corev1.PodLogOptions, out io.Writer) error {
+	log.Debug("About to stream log of pod from cluster", "pod name", podName, "namespace", namespace, "follow", options.Follow, "previous", options.Previous)
+	req := pod.client.KubernetesExtensionCli.CoreV1().Pods(namespace).GetLogs(podName, options)
+	readCloser, err := req.Stream(context.TODO())
+	if err != nil {
+		return err
+	}
+	defer readCloser.Close()
+	_, err = io.Copy(out, readCloser)
+	return err
+}
+
+func (pod *pod) Exec(namespace, podName, containerName string, command []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	log.Debug("About to execute command in pod", "pod name", podName, "namespace", namespace, "container", containerName)
+	if pod.client.RestConfig == nil {
+		return fmt.Errorf("no connection configuration available to execute commands in pod %s", podName)
+	}
+	req := pod.client.KubernetesExtensionCli.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(podName).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: containerName,
+			Command:   command,
+			Stdin:     stdin != nil,
+			Stdout:    stdout != nil,
+			Stderr:    stderr != nil,
+		}, scheme.ParameterCodec)
+	executor, err := remotecommand.NewSPDYExecutor(pod.client.RestConfig, "POST", req.URL())
+	if err != nil {
+		return err
+	}
+	return executor.Stream(remotecommand.StreamOptions{Stdin: stdin, Stdout: stdout, Stderr: stderr})
+}
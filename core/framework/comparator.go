@@ -23,12 +23,20 @@ import (
 
 	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
 	kogitocli "github.com/kiegroup/kogito-operator/core/client"
+	istio "github.com/kiegroup/kogito-operator/core/infrastructure/istio/v1beta1"
+	kafka "github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
+	serving "github.com/kiegroup/kogito-operator/core/infrastructure/serving/v1"
 	appsv1 "github.com/openshift/api/apps/v1"
 	buildv1 "github.com/openshift/api/build/v1"
 	imgv1 "github.com/openshift/api/image/v1"
 	routev1 "github.com/openshift/api/route/v1"
 	monv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	apps "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 
 	v1 "k8s.io/api/core/v1"
 
@@ -159,10 +167,71 @@ func CreateDeploymentComparator() func(deployed client.Object, requested client.
 		ignoreInjectedVariables(
 			&deployed.(*apps.Deployment).Spec.Template,
 			&requested.(*apps.Deployment).Spec.Template)
+		ignoreDefaultedValues(
+			&deployed.(*apps.Deployment).Spec.Template.Spec,
+			&requested.(*apps.Deployment).Spec.Template.Spec)
 		return true
 	}
 }
 
+// ignoreDefaultedValues copies the values defaulted by the server from the deployed PodSpec to the requested one
+// when they're left empty, e.g. in the containers and volumes added through the pod template overlay of the service.
+func ignoreDefaultedValues(deployed *v1.PodSpec, requested *v1.PodSpec) {
+	ignoreDefaultedContainerValues(deployed.InitContainers, requested.InitContainers)
+	ignoreDefaultedContainerValues(deployed.Containers, requested.Containers)
+	deployedVolumes := make(map[string]v1.Volume)
+	for _, volume := range deployed.Volumes {
+		deployedVolumes[volume.Name] = volume
+	}
+	for i := range requested.Volumes {
+		requestedVolume := &requested.Volumes[i]
+		deployedVolume, exists := deployedVolumes[requestedVolume.Name]
+		if !exists {
+			continue
+		}
+		if requestedVolume.ConfigMap != nil && deployedVolume.ConfigMap != nil && requestedVolume.ConfigMap.DefaultMode == nil {
+			requestedVolume.ConfigMap.DefaultMode = deployedVolume.ConfigMap.DefaultMode
+		}
+		if requestedVolume.Secret != nil && deployedVolume.Secret != nil && requestedVolume.Secret.DefaultMode == nil {
+			requestedVolume.Secret.DefaultMode = deployedVolume.Secret.DefaultMode
+		}
+		if requestedVolume.Projected != nil && deployedVolume.Projected != nil && requestedVolume.Projected.DefaultMode == nil {
+			requestedVolume.Projected.DefaultMode = deployedVolume.Projected.DefaultMode
+		}
+		if requestedVolume.DownwardAPI != nil && deployedVolume.DownwardAPI != nil && requestedVolume.DownwardAPI.DefaultMode == nil {
+			requestedVolume.DownwardAPI.DefaultMode = deployedVolume.DownwardAPI.DefaultMode
+		}
+	}
+}
+
+func ignoreDefaultedContainerValues(deployed []v1.Container, requested []v1.Container) {
+	deployedContainers := make(map[string]v1.Container)
+	for _, container := range deployed {
+		deployedContainers[container.Name] = container
+	}
+	for i := range requested {
+		requestedContainer := &requested[i]
+		deployedContainer, exists := deployedContainers[requestedContainer.Name]
+		if !exists {
+			continue
+		}
+		if len(requestedContainer.ImagePullPolicy) == 0 {
+			requestedContainer.ImagePullPolicy = deployedContainer.ImagePullPolicy
+		}
+		if len(requestedContainer.TerminationMessagePath) == 0 {
+			requestedContainer.TerminationMessagePath = deployedContainer.TerminationMessagePath
+		}
+		if len(requestedContainer.TerminationMessagePolicy) == 0 {
+			requestedContainer.TerminationMessagePolicy = deployedContainer.TerminationMessagePolicy
+		}
+		for j := range requestedContainer.Ports {
+			if len(requestedContainer.Ports[j].Protocol) == 0 && j < len(deployedContainer.Ports) {
+				requestedContainer.Ports[j].Protocol = deployedContainer.Ports[j].Protocol
+			}
+		}
+	}
+}
+
 // sortVolumes sorts the volumes of a given PodSpec (can be used either by a Deployment or DeploymentConfig objects)
 // TODO: open a PR to operatorutils fixing this once we verify KOGITO-2797
 func sortVolumes(pod *v1.PodSpec) {
@@ -201,13 +270,199 @@ func CreateBuildConfigComparator() func(deployed client.Object, requested client
 	}
 }
 
-// CreateRouteComparator creates a new comparator for Route using Label
+// CreateRouteComparator creates a new comparator for Route using Label and backends
 func CreateRouteComparator() func(deployed client.Object, requested client.Object) bool {
 	return func(deployed client.Object, requested client.Object) bool {
 		rtDeployed := deployed.(*routev1.Route)
 		rtRequested := requested.(*routev1.Route).DeepCopy()
 
-		return containAllLabels(rtDeployed, rtRequested)
+		if !containAllLabels(rtDeployed, rtRequested) {
+			return false
+		}
+
+		if !equalRouteBackends(rtDeployed.Spec.To, rtRequested.Spec.To) ||
+			len(rtDeployed.Spec.AlternateBackends) != len(rtRequested.Spec.AlternateBackends) {
+			return false
+		}
+		for i := range rtRequested.Spec.AlternateBackends {
+			if !equalRouteBackends(rtDeployed.Spec.AlternateBackends[i], rtRequested.Spec.AlternateBackends[i]) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// equalRouteBackends compares the target and weight of two Route backends, the weight is ignored when not requested since the server sets a default one
+func equalRouteBackends(deployed routev1.RouteTargetReference, requested routev1.RouteTargetReference) bool {
+	if deployed.Name != requested.Name {
+		return false
+	}
+	if requested.Weight == nil {
+		return true
+	}
+	return deployed.Weight != nil && *deployed.Weight == *requested.Weight
+}
+
+// CreateHorizontalPodAutoscalerComparator creates a new comparator for HorizontalPodAutoscaler using Label, target, replicas and metrics.
+// The scaling behavior is ignored since it's defaulted by the server.
+func CreateHorizontalPodAutoscalerComparator() func(deployed client.Object, requested client.Object) bool {
+	return func(deployed client.Object, requested client.Object) bool {
+		hpaDeployed := deployed.(*autoscalingv2.HorizontalPodAutoscaler)
+		hpaRequested := requested.(*autoscalingv2.HorizontalPodAutoscaler).DeepCopy()
+
+		if !containAllLabels(hpaDeployed, hpaRequested) {
+			return false
+		}
+
+		var pairs [][2]interface{}
+		pairs = append(pairs, [2]interface{}{hpaDeployed.Spec.ScaleTargetRef, hpaRequested.Spec.ScaleTargetRef})
+		pairs = append(pairs, [2]interface{}{hpaDeployed.Spec.MinReplicas, hpaRequested.Spec.MinReplicas})
+		pairs = append(pairs, [2]interface{}{hpaDeployed.Spec.MaxReplicas, hpaRequested.Spec.MaxReplicas})
+		// metrics hold quantities, which need a semantic comparison
+		return compare.EqualPairs(pairs) && equality.Semantic.DeepEqual(hpaDeployed.Spec.Metrics, hpaRequested.Spec.Metrics)
+	}
+}
+
+// CreatePodDisruptionBudgetComparator creates a new comparator for PodDisruptionBudget using Label and Spec
+func CreatePodDisruptionBudgetComparator() func(deployed client.Object, requested client.Object) bool {
+	return func(deployed client.Object, requested client.Object) bool {
+		pdbDeployed := deployed.(*policyv1.PodDisruptionBudget)
+		pdbRequested := requested.(*policyv1.PodDisruptionBudget).DeepCopy()
+
+		if !containAllLabels(pdbDeployed, pdbRequested) {
+			return false
+		}
+
+		var pairs [][2]interface{}
+		pairs = append(pairs, [2]interface{}{pdbDeployed.Spec.Selector, pdbRequested.Spec.Selector})
+		pairs = append(pairs, [2]interface{}{pdbDeployed.Spec.MinAvailable, pdbRequested.Spec.MinAvailable})
+		pairs = append(pairs, [2]interface{}{pdbDeployed.Spec.MaxUnavailable, pdbRequested.Spec.MaxUnavailable})
+		return compare.EqualPairs(pairs)
+	}
+}
+
+// CreateServiceAccountComparator creates a new comparator for ServiceAccount using Label.
+// The secrets are ignored since they're managed by the server, e.g. the registry secrets added on OpenShift.
+func CreateServiceAccountComparator() func(deployed client.Object, requested client.Object) bool {
+	return func(deployed client.Object, requested client.Object) bool {
+		return containAllLabels(deployed, requested)
+	}
+}
+
+// CreateRoleBindingComparator creates a new comparator for RoleBinding using Label, Subjects and RoleRef
+func CreateRoleBindingComparator() func(deployed client.Object, requested client.Object) bool {
+	return func(deployed client.Object, requested client.Object) bool {
+		rbDeployed := deployed.(*rbacv1.RoleBinding)
+		rbRequested := requested.(*rbacv1.RoleBinding)
+
+		if !containAllLabels(rbDeployed, rbRequested) {
+			return false
+		}
+
+		var pairs [][2]interface{}
+		pairs = append(pairs, [2]interface{}{rbDeployed.Subjects, rbRequested.Subjects})
+		pairs = append(pairs, [2]interface{}{rbDeployed.RoleRef, rbRequested.RoleRef})
+		return compare.EqualPairs(pairs)
+	}
+}
+
+// CreateVirtualServiceComparator creates a new comparator for the Istio VirtualService using Label and Spec
+func CreateVirtualServiceComparator() func(deployed client.Object, requested client.Object) bool {
+	return func(deployed client.Object, requested client.Object) bool {
+		vsDeployed := deployed.(*istio.VirtualService)
+		vsRequested := requested.(*istio.VirtualService).DeepCopy()
+
+		if !containAllLabels(vsDeployed, vsRequested) {
+			return false
+		}
+
+		return reflect.DeepEqual(vsDeployed.Spec, vsRequested.Spec)
+	}
+}
+
+// CreateKafkaUserComparator creates a new comparator for the Strimzi KafkaUser using Label and Spec
+func CreateKafkaUserComparator() func(deployed client.Object, requested client.Object) bool {
+	return func(deployed client.Object, requested client.Object) bool {
+		userDeployed := deployed.(*kafka.KafkaUser)
+		userRequested := requested.(*kafka.KafkaUser).DeepCopy()
+
+		if !containAllLabels(userDeployed, userRequested) {
+			return false
+		}
+
+		return reflect.DeepEqual(userDeployed.Spec, userRequested.Spec)
+	}
+}
+
+// CreateKnativeServiceComparator creates a new comparator for the Knative Service using Label and the revision template fields set by the operator.
+// The other template fields, such as the probes, are defaulted by Knative and not compared.
+func CreateKnativeServiceComparator() func(deployed client.Object, requested client.Object) bool {
+	return func(deployed client.Object, requested client.Object) bool {
+		ksvcDeployed := deployed.(*serving.Service)
+		ksvcRequested := requested.(*serving.Service)
+
+		if !containAllLabels(ksvcDeployed, ksvcRequested) {
+			return false
+		}
+		templateDeployed := &v1.PodTemplateSpec{ObjectMeta: ksvcDeployed.Spec.Template.ObjectMeta, Spec: ksvcDeployed.Spec.Template.Spec.PodSpec}
+		templateRequested := &v1.PodTemplateSpec{ObjectMeta: ksvcRequested.Spec.Template.ObjectMeta, Spec: ksvcRequested.Spec.Template.Spec.PodSpec}
+		if !containAllMapEntries(templateDeployed.Labels, templateRequested.Labels) ||
+			!containAllMapEntries(templateDeployed.Annotations, templateRequested.Annotations) ||
+			len(templateDeployed.Spec.Containers) != len(templateRequested.Spec.Containers) {
+			return false
+		}
+		sortVolumes(&templateDeployed.Spec)
+		sortVolumes(&templateRequested.Spec)
+		ignoreInjectedVariables(templateDeployed, templateRequested)
+		ignoreDefaultedValues(&templateDeployed.Spec, &templateRequested.Spec)
+		// injected variables and defaulted values are kept on update
+		ksvcRequested.Spec.Template.Spec.PodSpec = templateRequested.Spec
+
+		var pairs [][2]interface{}
+		pairs = append(pairs, [2]interface{}{ksvcDeployed.Spec.Template.Spec.ContainerConcurrency, ksvcRequested.Spec.Template.Spec.ContainerConcurrency})
+		pairs = append(pairs, [2]interface{}{templateDeployed.Spec.Volumes, templateRequested.Spec.Volumes})
+		pairs = append(pairs, [2]interface{}{templateDeployed.Spec.NodeSelector, templateRequested.Spec.NodeSelector})
+		pairs = append(pairs, [2]interface{}{templateDeployed.Spec.Tolerations, templateRequested.Spec.Tolerations})
+		pairs = append(pairs, [2]interface{}{templateDeployed.Spec.Affinity, templateRequested.Spec.Affinity})
+		pairs = append(pairs, [2]interface{}{templateDeployed.Spec.TopologySpreadConstraints, templateRequested.Spec.TopologySpreadConstraints})
+		pairs = append(pairs, [2]interface{}{templateDeployed.Spec.PriorityClassName, templateRequested.Spec.PriorityClassName})
+		for i := range templateDeployed.Spec.Containers {
+			containerDeployed := templateDeployed.Spec.Containers[i]
+			containerRequested := templateRequested.Spec.Containers[i]
+			pairs = append(pairs, [2]interface{}{containerDeployed.Image, containerRequested.Image})
+			pairs = append(pairs, [2]interface{}{containerDeployed.Env, containerRequested.Env})
+			pairs = append(pairs, [2]interface{}{containerDeployed.EnvFrom, containerRequested.EnvFrom})
+			pairs = append(pairs, [2]interface{}{containerDeployed.VolumeMounts, containerRequested.VolumeMounts})
+			if !equality.Semantic.DeepEqual(containerDeployed.Resources, containerRequested.Resources) {
+				return false
+			}
+		}
+		return compare.EqualPairs(pairs)
+	}
+}
+
+// containAllMapEntries checks if all the requested entries are in the deployed map
+func containAllMapEntries(deployed map[string]string, requested map[string]string) bool {
+	for key, value := range requested {
+		if deployedValue, exists := deployed[key]; !exists || deployedValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+// CreateIngressComparator creates a new comparator for Ingress using Label and Spec
+func CreateIngressComparator() func(deployed client.Object, requested client.Object) bool {
+	return func(deployed client.Object, requested client.Object) bool {
+		ingDeployed := deployed.(*networkingv1.Ingress)
+		ingRequested := requested.(*networkingv1.Ingress).DeepCopy()
+
+		if !containAllLabels(ingDeployed, ingRequested) {
+			return false
+		}
+
+		return reflect.DeepEqual(ingDeployed.Spec, ingRequested.Spec)
 	}
 }
 
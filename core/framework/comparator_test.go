@@ -385,6 +385,7 @@ func Test_CreateDeploymentConfigComparator(t *testing.T) {
 }
 
 func Test_CreateDeploymentComparator(t *testing.T) {
+	defaultMode := int32(420)
 	type args struct {
 		deployed  client.Object
 		requested client.Object
@@ -712,6 +713,77 @@ func Test_CreateDeploymentComparator(t *testing.T) {
 			reflect.TypeOf(apps.Deployment{}),
 			false,
 		},
+		{
+			"Equals Defaulted Sidecar",
+			args{
+				deployed: &apps.Deployment{
+					Spec: apps.DeploymentSpec{
+						Template: v1.PodTemplateSpec{
+							Spec: v1.PodSpec{
+								Containers: []v1.Container{
+									{Name: "service"},
+									{
+										Name:                     "log-shipper",
+										Image:                    "fluent-bit:1.9",
+										ImagePullPolicy:          v1.PullIfNotPresent,
+										TerminationMessagePath:   v1.TerminationMessagePathDefault,
+										TerminationMessagePolicy: v1.TerminationMessageReadFile,
+										Ports:                    []v1.ContainerPort{{ContainerPort: 2020, Protocol: v1.ProtocolTCP}},
+									},
+								},
+								Volumes: []v1.Volume{{Name: "fluent-bit-config", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{DefaultMode: &defaultMode}}}},
+							},
+						},
+					},
+				},
+				requested: &apps.Deployment{
+					Spec: apps.DeploymentSpec{
+						Template: v1.PodTemplateSpec{
+							Spec: v1.PodSpec{
+								Containers: []v1.Container{
+									{Name: "service"},
+									{Name: "log-shipper", Image: "fluent-bit:1.9", Ports: []v1.ContainerPort{{ContainerPort: 2020}}},
+								},
+								Volumes: []v1.Volume{{Name: "fluent-bit-config", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{}}}},
+							},
+						},
+					},
+				},
+			},
+			reflect.TypeOf(apps.Deployment{}),
+			true,
+		},
+		{
+			"Different Sidecar Image",
+			args{
+				deployed: &apps.Deployment{
+					Spec: apps.DeploymentSpec{
+						Template: v1.PodTemplateSpec{
+							Spec: v1.PodSpec{
+								Containers: []v1.Container{
+									{Name: "service"},
+									{Name: "log-shipper", Image: "fluent-bit:1.8", ImagePullPolicy: v1.PullIfNotPresent},
+								},
+							},
+						},
+					},
+				},
+				requested: &apps.Deployment{
+					Spec: apps.DeploymentSpec{
+						Template: v1.PodTemplateSpec{
+							Spec: v1.PodSpec{
+								Containers: []v1.Container{
+									{Name: "service"},
+									{Name: "log-shipper", Image: "fluent-bit:1.9"},
+								},
+							},
+						},
+					},
+				},
+			},
+			reflect.TypeOf(apps.Deployment{}),
+			false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
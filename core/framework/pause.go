@@ -0,0 +1,59 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package framework
+
+import (
+	api "github.com/kiegroup/kogito-operator/apis"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConditionsHolder is the status of a Kogito resource holding its conditions
+type ConditionsHolder interface {
+	GetConditions() *[]metav1.Condition
+	SetConditions(conditions *[]metav1.Condition)
+}
+
+// SetPausedCondition adds the Paused condition to the given status if the reconciliation is paused, or removes it otherwise.
+// Returns true if the conditions have changed.
+func SetPausedCondition(status ConditionsHolder, paused bool) bool {
+	if status.GetConditions() == nil {
+		if !paused {
+			return false
+		}
+		status.SetConditions(&[]metav1.Condition{})
+	}
+	conditions := status.GetConditions()
+	if !paused {
+		if meta.FindStatusCondition(*conditions, api.PausedConditionType) == nil {
+			return false
+		}
+		meta.RemoveStatusCondition(conditions, api.PausedConditionType)
+		return true
+	}
+	if meta.IsStatusConditionTrue(*conditions, api.PausedConditionType) {
+		return false
+	}
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:    api.PausedConditionType,
+		Status:  metav1.ConditionTrue,
+		Reason:  api.ReconcilePausedReason,
+		Message: "Remove the " + api.ReconcileAnnotation + " annotation to resume the reconciliation",
+	})
+	return true
+}
@@ -0,0 +1,41 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package framework
+
+import (
+	"testing"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+func TestSetPausedCondition(t *testing.T) {
+	status := &v1beta1.KogitoInfraStatus{}
+	assert.False(t, SetPausedCondition(status, false))
+	assert.Nil(t, status.GetConditions())
+
+	assert.True(t, SetPausedCondition(status, true))
+	assert.True(t, meta.IsStatusConditionTrue(*status.GetConditions(), api.PausedConditionType))
+	// already paused
+	assert.False(t, SetPausedCondition(status, true))
+
+	assert.True(t, SetPausedCondition(status, false))
+	assert.Nil(t, meta.FindStatusCondition(*status.GetConditions(), api.PausedConditionType))
+}
@@ -52,4 +52,11 @@ func TestGenerateMD5Hash(t *testing.T) {
 	}
 
 	assert.NotEqual(t, hashToCompare, differentHash)
+
+	// the map order doesn't change the hash
+	map4 := map[string]string{"key1": "value1", "key2": "value2", "key3": "value3", "key4": "value4"}
+	hash := GenerateMD5Hash(map4)
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, hash, GenerateMD5Hash(map4))
+	}
 }
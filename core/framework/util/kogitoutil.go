@@ -22,6 +22,35 @@ import (
 	"strings"
 )
 
+// WatchNamespaceEnv is the environment variable holding the comma separated list of namespaces watched by the operator.
+// The operator watches the whole cluster when it's empty.
+const WatchNamespaceEnv = "WATCH_NAMESPACE"
+
+// GetWatchNamespaces returns the namespaces watched by the operator, empty if it watches the whole cluster
+func GetWatchNamespaces() []string {
+	var namespaces []string
+	for _, namespace := range strings.Split(GetOSEnv(WatchNamespaceEnv, ""), ",") {
+		if namespace = strings.TrimSpace(namespace); len(namespace) > 0 {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	return namespaces
+}
+
+// IsNamespaceWatched returns true if the operator watches the given namespace. An empty namespace is always watched.
+func IsNamespaceWatched(namespace string) bool {
+	namespaces := GetWatchNamespaces()
+	if len(namespaces) == 0 || len(namespace) == 0 {
+		return true
+	}
+	for _, watched := range namespaces {
+		if watched == namespace {
+			return true
+		}
+	}
+	return false
+}
+
 // IsProductMode returns true if application is running in product mode.
 func IsProductMode() bool {
 	var group = "GROUP"
@@ -0,0 +1,36 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetWatchNamespaces(t *testing.T) {
+	t.Setenv(WatchNamespaceEnv, "")
+	assert.Empty(t, GetWatchNamespaces())
+	assert.True(t, IsNamespaceWatched("kafka"))
+
+	t.Setenv(WatchNamespaceEnv, " kogito, kafka ,,")
+	assert.Equal(t, []string{"kogito", "kafka"}, GetWatchNamespaces())
+	assert.True(t, IsNamespaceWatched("kafka"))
+	assert.True(t, IsNamespaceWatched(""))
+	assert.False(t, IsNamespaceWatched("infinispan"))
+}
@@ -0,0 +1,133 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package infrastructure
+
+import (
+	"reflect"
+
+	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	defaultTargetCPUUtilizationPercentage = int32(80)
+	defaultMinReplicas                    = int32(1)
+)
+
+// HorizontalPodAutoscalerHandler ...
+type HorizontalPodAutoscalerHandler interface {
+	FetchHorizontalPodAutoscaler(key types.NamespacedName) (*autoscalingv2.HorizontalPodAutoscaler, error)
+	CreateHorizontalPodAutoscaler(instance api.KogitoService) *autoscalingv2.HorizontalPodAutoscaler
+	GetComparator() compare.MapComparator
+}
+
+type horizontalPodAutoscalerHandler struct {
+	operator.Context
+}
+
+// NewHorizontalPodAutoscalerHandler ...
+func NewHorizontalPodAutoscalerHandler(context operator.Context) HorizontalPodAutoscalerHandler {
+	return &horizontalPodAutoscalerHandler{
+		context,
+	}
+}
+
+func (h *horizontalPodAutoscalerHandler) FetchHorizontalPodAutoscaler(key types.NamespacedName) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	if exists, err := kubernetes.ResourceC(h.Client).FetchWithKey(key, hpa); err != nil {
+		return nil, err
+	} else if !exists {
+		h.Log.Debug("HorizontalPodAutoscaler not found.")
+		return nil, nil
+	}
+	return hpa, nil
+}
+
+// CreateHorizontalPodAutoscaler creates the HorizontalPodAutoscaler scaling the Deployment of the given service
+func (h *horizontalPodAutoscalerHandler) CreateHorizontalPodAutoscaler(instance api.KogitoService) *autoscalingv2.HorizontalPodAutoscaler {
+	autoscaling := instance.GetSpec().GetAutoscaling()
+	var metrics []autoscalingv2.MetricSpec
+	if target := autoscaling.GetTargetCPUUtilizationPercentage(); target != nil {
+		metrics = append(metrics, newResourceMetric(corev1.ResourceCPU, *target))
+	}
+	if target := autoscaling.GetTargetMemoryUtilizationPercentage(); target != nil {
+		metrics = append(metrics, newResourceMetric(corev1.ResourceMemory, *target))
+	}
+	for _, metric := range autoscaling.GetMetrics() {
+		targetAverageValue := metric.GetTargetAverageValue()
+		metrics = append(metrics, autoscalingv2.MetricSpec{
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{Name: metric.GetName()},
+				Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: &targetAverageValue},
+			},
+		})
+	}
+	// same defaults as the server, set here to not fight with it
+	if len(metrics) == 0 {
+		metrics = append(metrics, newResourceMetric(corev1.ResourceCPU, defaultTargetCPUUtilizationPercentage))
+	}
+	minReplicas := defaultMinReplicas
+	if autoscaling.GetMinReplicas() != nil {
+		minReplicas = *autoscaling.GetMinReplicas()
+	}
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      instance.GetName(),
+			Namespace: instance.GetNamespace(),
+			Labels:    map[string]string{framework.LabelAppKey: instance.GetName()},
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: KindDeployment.GroupVersion.String(),
+				Kind:       KindDeployment.Name,
+				Name:       instance.GetName(),
+			},
+			MinReplicas: &minReplicas,
+			MaxReplicas: autoscaling.GetMaxReplicas(),
+			Metrics:     metrics,
+		},
+	}
+}
+
+func newResourceMetric(name corev1.ResourceName, targetUtilization int32) autoscalingv2.MetricSpec {
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name:   name,
+			Target: autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: &targetUtilization},
+		},
+	}
+}
+
+func (h *horizontalPodAutoscalerHandler) GetComparator() compare.MapComparator {
+	resourceComparator := compare.DefaultComparator()
+	resourceComparator.SetComparator(
+		framework.NewComparatorBuilder().
+			WithType(reflect.TypeOf(autoscalingv2.HorizontalPodAutoscaler{})).
+			WithCustomComparator(framework.CreateHorizontalPodAutoscalerComparator()).
+			Build())
+	return compare.MapComparator{Comparator: resourceComparator}
+}
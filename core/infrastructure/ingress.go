@@ -0,0 +1,164 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package infrastructure
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	networkingv1 "k8s.io/api/networking/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	defaultIngressPath = "/"
+)
+
+// IngressHandler ...
+type IngressHandler interface {
+	FetchIngress(key types.NamespacedName) (*networkingv1.Ingress, error)
+	CreateIngress(instance api.KogitoService) *networkingv1.Ingress
+	GetComparator() compare.MapComparator
+	ValidateIngressStatus(ingressKey types.NamespacedName) (bool, error)
+	GetExternalURIFromIngress(ingressKey types.NamespacedName) (string, error)
+}
+
+type ingressHandler struct {
+	operator.Context
+}
+
+// NewIngressHandler ...
+func NewIngressHandler(context operator.Context) IngressHandler {
+	return &ingressHandler{
+		context,
+	}
+}
+
+func (i *ingressHandler) FetchIngress(key types.NamespacedName) (*networkingv1.Ingress, error) {
+	ingress := &networkingv1.Ingress{}
+	exists, err := kubernetes.ResourceC(i.Client).FetchWithKey(key, ingress)
+	if err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, nil
+	}
+	return ingress, nil
+}
+
+// CreateIngress creates a new Ingress resource routing the configured host and path to the Service of the given instance
+func (i *ingressHandler) CreateIngress(instance api.KogitoService) *networkingv1.Ingress {
+	spec := instance.GetSpec().GetIngress()
+	pathType := networkingv1.PathTypePrefix
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      instance.GetName(),
+			Namespace: instance.GetNamespace(),
+			Labels:    map[string]string{framework.LabelAppKey: instance.GetName()},
+		},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{
+				{
+					Host: spec.GetHost(),
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{
+								{
+									Path:     getIngressPath(spec),
+									PathType: &pathType,
+									Backend: networkingv1.IngressBackend{
+										Service: &networkingv1.IngressServiceBackend{
+											Name: instance.GetName(),
+											Port: networkingv1.ServiceBackendPort{Name: framework.DefaultPortName},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	if len(spec.GetIngressClassName()) > 0 {
+		ingressClassName := spec.GetIngressClassName()
+		ingress.Spec.IngressClassName = &ingressClassName
+	}
+	if len(spec.GetTLSSecret()) > 0 {
+		ingress.Spec.TLS = []networkingv1.IngressTLS{
+			{
+				Hosts:      []string{spec.GetHost()},
+				SecretName: spec.GetTLSSecret(),
+			},
+		}
+	}
+	return ingress
+}
+
+func (i *ingressHandler) GetComparator() compare.MapComparator {
+	resourceComparator := compare.DefaultComparator()
+	resourceComparator.SetComparator(
+		framework.NewComparatorBuilder().
+			WithType(reflect.TypeOf(networkingv1.Ingress{})).
+			WithCustomComparator(framework.CreateIngressComparator()).
+			Build())
+	return compare.MapComparator{Comparator: resourceComparator}
+}
+
+// ValidateIngressStatus returns true once the ingress controller has assigned an address to the Ingress
+func (i *ingressHandler) ValidateIngressStatus(ingressKey types.NamespacedName) (bool, error) {
+	ingress, err := i.FetchIngress(ingressKey)
+	if err != nil || ingress == nil {
+		return false, err
+	}
+	return len(ingress.Status.LoadBalancer.Ingress) > 0, nil
+}
+
+// GetExternalURIFromIngress gets the URI the service is exposed on by the Ingress, e.g. https://my-service.example.com/path
+func (i *ingressHandler) GetExternalURIFromIngress(ingressKey types.NamespacedName) (string, error) {
+	ingress, err := i.FetchIngress(ingressKey)
+	if err != nil || ingress == nil || len(ingress.Spec.Rules) == 0 {
+		return "", err
+	}
+	host := ingress.Spec.Rules[0].Host
+	if len(host) == 0 {
+		return "", nil
+	}
+	scheme := "http"
+	if len(ingress.Spec.TLS) > 0 {
+		scheme = "https"
+	}
+	path := ""
+	if ingress.Spec.Rules[0].HTTP != nil && len(ingress.Spec.Rules[0].HTTP.Paths) > 0 {
+		path = strings.TrimSuffix(ingress.Spec.Rules[0].HTTP.Paths[0].Path, "/")
+	}
+	return fmt.Sprintf("%s://%s%s", scheme, host, path), nil
+}
+
+func getIngressPath(spec api.IngressInterface) string {
+	if len(spec.GetPath()) == 0 {
+		return defaultIngressPath
+	}
+	return spec.GetPath()
+}
@@ -0,0 +1,93 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VirtualServiceSpec defines the desired state of VirtualService.
+// Only the fields required by the Kogito Operator are mapped here.
+type VirtualServiceSpec struct {
+	// The destination hosts to which traffic is being sent.
+	// +optional
+	Hosts []string `json:"hosts,omitempty"`
+
+	// An ordered list of route rules for HTTP traffic.
+	// +optional
+	HTTP []HTTPRoute `json:"http,omitempty"`
+}
+
+// HTTPRoute describes match conditions and actions for routing HTTP traffic
+type HTTPRoute struct {
+	// The name assigned to the route for debugging purposes.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// The destinations the traffic is sent to, along with their weights.
+	// +optional
+	Route []HTTPRouteDestination `json:"route,omitempty"`
+}
+
+// HTTPRouteDestination is a destination of an HTTPRoute with the share of the traffic it receives
+type HTTPRouteDestination struct {
+	// Destination uniquely identifies the instances of a service to which the request should be forwarded.
+	Destination Destination `json:"destination"`
+
+	// Weight specifies the relative proportion of traffic to be forwarded to the destination.
+	// +optional
+	Weight int32 `json:"weight,omitempty"`
+}
+
+// Destination indicates the network addressable service to which the request will be sent
+type Destination struct {
+	// The name of a service from the service registry.
+	Host string `json:"host"`
+
+	// Specifies the port on the host that is being addressed.
+	// +optional
+	Port *PortSelector `json:"port,omitempty"`
+}
+
+// PortSelector specifies the number of a port to be used for matching or selection for final routing
+type PortSelector struct {
+	// Valid port number
+	Number uint32 `json:"number,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualService is the Schema for the virtualservices API
+type VirtualService struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualServiceSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualServiceList contains a list of VirtualService
+type VirtualServiceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualService `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VirtualService{}, &VirtualServiceList{})
+}
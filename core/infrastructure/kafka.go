@@ -19,8 +19,13 @@ package infrastructure
 
 import (
 	"fmt"
+	"reflect"
 
+	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
+
+	"github.com/kiegroup/kogito-operator/apis"
 	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
 	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -51,8 +56,16 @@ type KafkaHandler interface {
 	IsStrimziAvailable() bool
 	FetchKafkaInstance(key types.NamespacedName) (*v1beta2.Kafka, error)
 	FetchKafkaTopic(key types.NamespacedName) (*v1beta2.KafkaTopic, error)
-	CreateKafkaTopic(topicName, kafkaName, kafkaNamespace string) (*v1beta2.KafkaTopic, error)
-	ResolveKafkaServerURI(kafka *v1beta2.Kafka) (string, error)
+	NewKafkaTopic(topicName, kafkaName, kafkaNamespace string, topicConfig api.KafkaTopicInterface) *v1beta2.KafkaTopic
+	CreateKafkaTopic(kafkaTopic *v1beta2.KafkaTopic) error
+	UpdateKafkaTopic(kafkaTopic *v1beta2.KafkaTopic) error
+	DeleteKafkaTopic(kafkaTopic *v1beta2.KafkaTopic) error
+	ResolveKafkaServerURI(kafka *v1beta2.Kafka, listenerName string) (string, error)
+	IsKafkaListenerTLS(kafka *v1beta2.Kafka, listenerName string) bool
+	FetchKafkaUser(key types.NamespacedName) (*v1beta2.KafkaUser, error)
+	NewKafkaUser(userName, kafkaName, kafkaNamespace, authentication string, acls []v1beta2.AclRule) *v1beta2.KafkaUser
+	DeleteKafkaUser(kafkaUser *v1beta2.KafkaUser) error
+	GetKafkaUserComparator() compare.MapComparator
 }
 
 type kafkaHandler struct {
@@ -100,15 +113,53 @@ func (k *kafkaHandler) FetchKafkaTopic(key types.NamespacedName) (*v1beta2.Kafka
 	return nil, nil
 }
 
-func (k *kafkaHandler) CreateKafkaTopic(topicName, kafkaName, kafkaNamespace string) (*v1beta2.KafkaTopic, error) {
-	k.Log.Debug("Going to create kafka topic", "topicName", topicName)
+// NewKafkaTopic returns a Kafka topic resource with the given configuration, topicConfig can be nil to use the defaults
+func (k *kafkaHandler) NewKafkaTopic(topicName, kafkaName, kafkaNamespace string, topicConfig api.KafkaTopicInterface) *v1beta2.KafkaTopic {
 	kafkaTopic := getKafkaTopic(topicName, kafkaNamespace, kafkaName)
+	if topicConfig == nil {
+		return kafkaTopic
+	}
+	if topicConfig.GetPartitions() != nil {
+		kafkaTopic.Spec.Partitions = *topicConfig.GetPartitions()
+	}
+	if topicConfig.GetReplicationFactor() != nil {
+		kafkaTopic.Spec.Replicas = *topicConfig.GetReplicationFactor()
+	}
+	if len(topicConfig.GetConfig()) > 0 {
+		kafkaTopic.Spec.Config = make(map[string]string, len(topicConfig.GetConfig()))
+		for key, value := range topicConfig.GetConfig() {
+			kafkaTopic.Spec.Config[key] = value
+		}
+	}
+	return kafkaTopic
+}
+
+func (k *kafkaHandler) CreateKafkaTopic(kafkaTopic *v1beta2.KafkaTopic) error {
+	k.Log.Debug("Going to create kafka topic", "topicName", kafkaTopic.Name)
 	if err := kubernetes.ResourceC(k.Client).Create(kafkaTopic); err != nil {
 		k.Log.Error(err, "Error occurs while creating kogito Kafka topic")
-		return nil, err
+		return err
+	}
+	k.Log.Debug("Kogito Kafka topic created successfully", "topicName", kafkaTopic.Name)
+	return nil
+}
+
+func (k *kafkaHandler) UpdateKafkaTopic(kafkaTopic *v1beta2.KafkaTopic) error {
+	k.Log.Debug("Going to update kafka topic", "topicName", kafkaTopic.Name)
+	if err := kubernetes.ResourceC(k.Client).Update(kafkaTopic); err != nil {
+		k.Log.Error(err, "Error occurs while updating kogito Kafka topic")
+		return err
+	}
+	return nil
+}
+
+func (k *kafkaHandler) DeleteKafkaTopic(kafkaTopic *v1beta2.KafkaTopic) error {
+	k.Log.Debug("Going to delete kafka topic", "topicName", kafkaTopic.Name)
+	if err := kubernetes.ResourceC(k.Client).Delete(kafkaTopic); err != nil {
+		k.Log.Error(err, "Error occurs while deleting kogito Kafka topic")
+		return err
 	}
-	k.Log.Debug("Kogito Kafka topic created successfully", "topicName", topicName)
-	return kafkaTopic, nil
+	return nil
 }
 
 // getKafkaTopic returns a Kafka topic resource with default configuration
@@ -131,10 +182,15 @@ func getKafkaTopic(name, namespace, kafkaBroker string) *v1beta2.KafkaTopic {
 	}
 }
 
-// ResolveKafkaServerURI returns the uri of the kafka instance
-func (k *kafkaHandler) ResolveKafkaServerURI(kafka *v1beta2.Kafka) (string, error) {
-	k.Log.Debug("Resolving kafka URI", "kafka instance", kafka.Name)
-	kafkaURI := ResolveKafkaServerURI(kafka)
+// ResolveKafkaServerURI returns the uri of the given listener of the kafka instance, or of its plain listener when no name is given
+func (k *kafkaHandler) ResolveKafkaServerURI(kafka *v1beta2.Kafka, listenerName string) (string, error) {
+	k.Log.Debug("Resolving kafka URI", "kafka instance", kafka.Name, "listener", listenerName)
+	var kafkaURI string
+	if len(listenerName) == 0 {
+		kafkaURI = ResolveKafkaServerURI(kafka)
+	} else {
+		kafkaURI = resolveKafkaListenerURI(kafka, listenerName)
+	}
 	if len(kafkaURI) > 0 {
 		k.Log.Debug("Success fetch Kafka URI", "kafka instance", kafka.Name, "kafka URI", kafkaURI)
 		return kafkaURI, nil
@@ -143,6 +199,97 @@ func (k *kafkaHandler) ResolveKafkaServerURI(kafka *v1beta2.Kafka) (string, erro
 	return "", fmt.Errorf("not able resolve URI for given kafka instance %s", kafka.Name)
 }
 
+// IsKafkaListenerTLS checks if the given listener of the kafka instance has TLS encryption enabled
+func (k *kafkaHandler) IsKafkaListenerTLS(kafka *v1beta2.Kafka, listenerName string) bool {
+	for _, listener := range kafka.Spec.Kafka.Listeners {
+		if len(listenerName) > 0 && listener.Name == listenerName {
+			return listener.TLS
+		}
+	}
+	return false
+}
+
+func (k *kafkaHandler) FetchKafkaUser(key types.NamespacedName) (*v1beta2.KafkaUser, error) {
+	k.Log.Debug("Going to load deployed kafka user", "userName", key.Name)
+	kafkaUser := &v1beta2.KafkaUser{}
+	if exists, err := kubernetes.ResourceC(k.Client).FetchWithKey(key, kafkaUser); err != nil {
+		k.Log.Error(err, "Error occurs while fetching kogito kafka user", "userName", key.Name)
+		return nil, err
+	} else if !exists {
+		k.Log.Debug("kafka user not exists", "userName", key.Name)
+		return nil, nil
+	}
+	return kafkaUser, nil
+}
+
+// NewKafkaUser returns a Kafka user with the given authentication, authorized with the given ACLs
+func (k *kafkaHandler) NewKafkaUser(userName, kafkaName, kafkaNamespace, authentication string, acls []v1beta2.AclRule) *v1beta2.KafkaUser {
+	return &v1beta2.KafkaUser{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      userName,
+			Namespace: kafkaNamespace,
+			Labels: map[string]string{
+				strimziBrokerLabel: kafkaName,
+			},
+		},
+		Spec: v1beta2.KafkaUserSpec{
+			Authentication: &v1beta2.KafkaUserAuthentication{Type: authentication},
+			Authorization: &v1beta2.KafkaUserAuthorization{
+				Type: v1beta2.KafkaUserSimpleAuthorization,
+				Acls: acls,
+			},
+		},
+	}
+}
+
+func (k *kafkaHandler) DeleteKafkaUser(kafkaUser *v1beta2.KafkaUser) error {
+	k.Log.Debug("Going to delete kafka user", "userName", kafkaUser.Name)
+	if err := kubernetes.ResourceC(k.Client).Delete(kafkaUser); err != nil {
+		k.Log.Error(err, "Error occurs while deleting kogito Kafka user")
+		return err
+	}
+	return nil
+}
+
+func (k *kafkaHandler) GetKafkaUserComparator() compare.MapComparator {
+	resourceComparator := compare.DefaultComparator()
+	resourceComparator.SetComparator(
+		framework.NewComparatorBuilder().
+			WithType(reflect.TypeOf(v1beta2.KafkaUser{})).
+			WithCustomComparator(framework.CreateKafkaUserComparator()).
+			Build())
+	return compare.MapComparator{Comparator: resourceComparator}
+}
+
+// resolveKafkaListenerURI returns the bootstrap servers of the listener with the given name
+func resolveKafkaListenerURI(kafka *v1beta2.Kafka, listenerName string) string {
+	for _, listenerStatus := range kafka.Status.Listeners {
+		// Strimzi versions before 0.25 report the name of the listener in its type
+		if listenerStatus.Name != listenerName && listenerStatus.Type != listenerName {
+			continue
+		}
+		if len(listenerStatus.BootstrapServers) > 0 {
+			return listenerStatus.BootstrapServers
+		}
+		for _, listenerAddress := range listenerStatus.Addresses {
+			if len(listenerAddress.Host) > 0 && listenerAddress.Port > 0 {
+				return fmt.Sprintf("%s:%d", listenerAddress.Host, listenerAddress.Port)
+			}
+		}
+	}
+	return ""
+}
+
+// GetKafkaListenerCertificates returns the CA certificates, in PEM format, of the listener with the given name
+func GetKafkaListenerCertificates(kafka *v1beta2.Kafka, listenerName string) []string {
+	for _, listenerStatus := range kafka.Status.Listeners {
+		if listenerStatus.Name == listenerName || listenerStatus.Type == listenerName {
+			return listenerStatus.Certificates
+		}
+	}
+	return nil
+}
+
 // ResolveKafkaServerURI returns the uri of the kafka instance
 func ResolveKafkaServerURI(kafka *v1beta2.Kafka) string {
 	if len(kafka.Status.Listeners) > 0 {
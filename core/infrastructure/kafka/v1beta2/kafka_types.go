@@ -116,8 +116,13 @@ const (
 
 // ListenerStatus defines a single listener
 type ListenerStatus struct {
-	Type      string            `json:"type,omitempty"`
-	Addresses []ListenerAddress `json:"addresses,omitempty"`
+	// Type is deprecated in favor of Name since Strimzi 0.25
+	Type             string            `json:"type,omitempty"`
+	Name             string            `json:"name,omitempty"`
+	Addresses        []ListenerAddress `json:"addresses,omitempty"`
+	BootstrapServers string            `json:"bootstrapServers,omitempty"`
+	// Certificates are the CA certificates, in PEM format, used to connect to the TLS listeners
+	Certificates []string `json:"certificates,omitempty"`
 }
 
 // ListenerAddress defines a single address of particular listener
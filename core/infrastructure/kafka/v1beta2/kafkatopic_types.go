@@ -23,9 +23,10 @@ import (
 
 // KafkaTopicSpec defines the desired state of KafkaTopic
 type KafkaTopicSpec struct {
-	Partitions int32  `json:"partitions,omitempty"`
-	Replicas   int32  `json:"replicas,omitempty"`
-	TopicName  string `json:"topicName,omitempty"`
+	Partitions int32             `json:"partitions,omitempty"`
+	Replicas   int32             `json:"replicas,omitempty"`
+	TopicName  string            `json:"topicName,omitempty"`
+	Config     map[string]string `json:"config,omitempty"`
 }
 
 // KafkaTopicStatus defines the observed state of KafkaTopic
@@ -0,0 +1,91 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// KafkaUserTLSAuthentication authenticates the user with a client certificate
+	KafkaUserTLSAuthentication = "tls"
+	// KafkaUserScramSha512Authentication authenticates the user with SCRAM-SHA-512 credentials
+	KafkaUserScramSha512Authentication = "scram-sha-512"
+	// KafkaUserSimpleAuthorization authorizes the user with the Kafka built-in ACLs
+	KafkaUserSimpleAuthorization = "simple"
+)
+
+// KafkaUserSpec defines the desired state of KafkaUser
+type KafkaUserSpec struct {
+	Authentication *KafkaUserAuthentication `json:"authentication,omitempty"`
+	Authorization  *KafkaUserAuthorization  `json:"authorization,omitempty"`
+}
+
+// KafkaUserAuthentication ...
+type KafkaUserAuthentication struct {
+	Type string `json:"type"`
+}
+
+// KafkaUserAuthorization ...
+type KafkaUserAuthorization struct {
+	Type string    `json:"type"`
+	Acls []AclRule `json:"acls,omitempty"`
+}
+
+// AclRule grants an operation on a Kafka resource
+type AclRule struct {
+	Resource  AclRuleResource `json:"resource"`
+	Operation string          `json:"operation"`
+	Host      string          `json:"host,omitempty"`
+}
+
+// AclRuleResource is the Kafka resource, e.g. a topic or a consumer group, an ACL rule applies to
+type AclRuleResource struct {
+	Type        string `json:"type"`
+	Name        string `json:"name,omitempty"`
+	PatternType string `json:"patternType,omitempty"`
+}
+
+// KafkaUserStatus defines the observed state of KafkaUser
+type KafkaUserStatus struct {
+	Username   string           `json:"username,omitempty"`
+	Secret     string           `json:"secret,omitempty"`
+	Conditions []KafkaCondition `json:"conditions,omitempty"`
+}
+
+// KafkaUser is the Schema for the kafkausers API
+// +kubebuilder:object:root=true
+type KafkaUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KafkaUserSpec   `json:"spec,omitempty"`
+	Status KafkaUserStatus `json:"status,omitempty"`
+}
+
+// KafkaUserList contains a list of KafkaUser
+// +kubebuilder:object:root=true
+type KafkaUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KafkaUser `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&KafkaUser{}, &KafkaUserList{})
+}
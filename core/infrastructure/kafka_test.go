@@ -162,7 +162,7 @@ func Test_resolveKafkaServerURI(t *testing.T) {
 	kafkaHandler := NewKafkaHandler(context)
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got, _ := kafkaHandler.ResolveKafkaServerURI(tt.args.kafka); got != tt.want {
+			if got, _ := kafkaHandler.ResolveKafkaServerURI(tt.args.kafka, ""); got != tt.want {
 				t.Errorf("ResolveKafkaServerURI() = %v, want %v", got, tt.want)
 			}
 		})
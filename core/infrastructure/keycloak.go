@@ -18,13 +18,30 @@
 package infrastructure
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
 	"github.com/kiegroup/kogito-operator/core/infrastructure/keycloak/v1alpha1"
 	"github.com/kiegroup/kogito-operator/core/operator"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 const (
 	// KeycloakKind refers to Keycloak Kind
 	KeycloakKind = "Keycloak"
+	// KeycloakRealmKind refers to KeycloakRealm Kind
+	KeycloakRealmKind = "KeycloakRealm"
+	// KeycloakClientKind refers to KeycloakClient Kind
+	KeycloakClientKind = "KeycloakClient"
+
+	// KeycloakClientSecretIDKey is the client ID key set in the client secret created by the Keycloak Operator
+	KeycloakClientSecretIDKey = "CLIENT_ID"
+	// KeycloakClientSecretKey is the client secret key set in the client secret created by the Keycloak Operator
+	KeycloakClientSecretKey = "CLIENT_SECRET"
+
+	keycloakClientSecretName = "keycloak-client-secret-%s"
+	keycloakRealmPath        = "auth/realms"
 )
 
 var (
@@ -37,6 +54,10 @@ var (
 // KeycloakHandler ...
 type KeycloakHandler interface {
 	IsKeycloakAvailable() bool
+	FetchKeycloakRealm(key types.NamespacedName) (*v1alpha1.KeycloakRealm, error)
+	FetchKeycloakClient(key types.NamespacedName) (*v1alpha1.KeycloakClient, error)
+	GetKeycloakClientSecretName(client *v1alpha1.KeycloakClient) string
+	GetKeycloakRealmURL(keycloak *v1alpha1.Keycloak, realm *v1alpha1.KeycloakRealm) string
 }
 
 type keycloakHandler struct {
@@ -54,3 +75,45 @@ func NewKeycloakHandler(context operator.Context) KeycloakHandler {
 func (k *keycloakHandler) IsKeycloakAvailable() bool {
 	return k.Client.HasServerGroup(keycloakServerGroup)
 }
+
+// FetchKeycloakRealm fetches the KeycloakRealm with the given key, nil if not found
+func (k *keycloakHandler) FetchKeycloakRealm(key types.NamespacedName) (*v1alpha1.KeycloakRealm, error) {
+	k.Log.Debug("fetching deployed KeycloakRealm instance", "name", key.Name)
+	realm := &v1alpha1.KeycloakRealm{}
+	if exists, err := kubernetes.ResourceC(k.Client).FetchWithKey(key, realm); err != nil {
+		k.Log.Error(err, "Error occurs while fetching KeycloakRealm instance")
+		return nil, err
+	} else if !exists {
+		k.Log.Debug("KeycloakRealm instance is not exists")
+		return nil, nil
+	}
+	return realm, nil
+}
+
+// FetchKeycloakClient fetches the KeycloakClient with the given key, nil if not found
+func (k *keycloakHandler) FetchKeycloakClient(key types.NamespacedName) (*v1alpha1.KeycloakClient, error) {
+	k.Log.Debug("fetching deployed KeycloakClient instance", "name", key.Name)
+	client := &v1alpha1.KeycloakClient{}
+	if exists, err := kubernetes.ResourceC(k.Client).FetchWithKey(key, client); err != nil {
+		k.Log.Error(err, "Error occurs while fetching KeycloakClient instance")
+		return nil, err
+	} else if !exists {
+		k.Log.Debug("KeycloakClient instance is not exists")
+		return nil, nil
+	}
+	return client, nil
+}
+
+// GetKeycloakClientSecretName gets the name of the secret created by the Keycloak Operator holding the client credentials, e.g. keycloak-client-secret-my-client
+func (k *keycloakHandler) GetKeycloakClientSecretName(client *v1alpha1.KeycloakClient) string {
+	return fmt.Sprintf(keycloakClientSecretName, client.Spec.Client.ClientID)
+}
+
+// GetKeycloakRealmURL gets the in-cluster URL of the given realm, e.g. https://keycloak.my-namespace.svc:8443/auth/realms/my-realm
+func (k *keycloakHandler) GetKeycloakRealmURL(keycloak *v1alpha1.Keycloak, realm *v1alpha1.KeycloakRealm) string {
+	baseURL := keycloak.Status.InternalURL
+	if keycloak.Spec.External.Enabled && len(keycloak.Spec.External.URL) > 0 {
+		baseURL = keycloak.Spec.External.URL
+	}
+	return strings.Join([]string{strings.TrimSuffix(baseURL, "/"), keycloakRealmPath, realm.Spec.Realm.Realm}, "/")
+}
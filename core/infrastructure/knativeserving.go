@@ -0,0 +1,89 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package infrastructure
+
+import (
+	"reflect"
+
+	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	serving "github.com/kiegroup/kogito-operator/core/infrastructure/serving/v1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// KnativeServingServiceKind is the Kind description for Knative Serving Services
+	KnativeServingServiceKind = "Service"
+)
+
+// KnativeServingHandler ...
+type KnativeServingHandler interface {
+	IsKnativeServingAvailable() bool
+	FetchKnativeService(key types.NamespacedName) (*serving.Service, error)
+	IsKnativeServiceReady(key types.NamespacedName) (bool, error)
+	GetComparator() compare.MapComparator
+}
+
+type knativeServingHandler struct {
+	operator.Context
+}
+
+// NewKnativeServingHandler ...
+func NewKnativeServingHandler(context operator.Context) KnativeServingHandler {
+	return &knativeServingHandler{
+		context,
+	}
+}
+
+// IsKnativeServingAvailable checks if Knative Serving CRDs are available in the cluster
+func (k *knativeServingHandler) IsKnativeServingAvailable() bool {
+	return k.Client.HasServerGroup(serving.GroupVersion.Group)
+}
+
+func (k *knativeServingHandler) FetchKnativeService(key types.NamespacedName) (*serving.Service, error) {
+	service := &serving.Service{}
+	if exists, err := kubernetes.ResourceC(k.Client).FetchWithKey(key, service); err != nil {
+		return nil, err
+	} else if !exists {
+		k.Log.Debug("Knative Service not found.")
+		return nil, nil
+	}
+	return service, nil
+}
+
+// IsKnativeServiceReady verifies if the Knative Service has a ready revision serving the traffic, even if it's scaled to zero
+func (k *knativeServingHandler) IsKnativeServiceReady(key types.NamespacedName) (bool, error) {
+	service, err := k.FetchKnativeService(key)
+	if err != nil || service == nil {
+		return false, err
+	}
+	return service.Status.GetCondition(apis.ConditionReady).IsTrue(), nil
+}
+
+func (k *knativeServingHandler) GetComparator() compare.MapComparator {
+	resourceComparator := compare.DefaultComparator()
+	resourceComparator.SetComparator(
+		framework.NewComparatorBuilder().
+			WithType(reflect.TypeOf(serving.Service{})).
+			WithCustomComparator(framework.CreateKnativeServiceComparator()).
+			Build())
+	return compare.MapComparator{Comparator: resourceComparator}
+}
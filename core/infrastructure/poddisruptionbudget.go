@@ -0,0 +1,87 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package infrastructure
+
+import (
+	"reflect"
+
+	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	policyv1 "k8s.io/api/policy/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// PodDisruptionBudgetHandler ...
+type PodDisruptionBudgetHandler interface {
+	FetchPodDisruptionBudget(key types.NamespacedName) (*policyv1.PodDisruptionBudget, error)
+	CreatePodDisruptionBudget(instance api.KogitoService) *policyv1.PodDisruptionBudget
+	GetComparator() compare.MapComparator
+}
+
+type podDisruptionBudgetHandler struct {
+	operator.Context
+}
+
+// NewPodDisruptionBudgetHandler ...
+func NewPodDisruptionBudgetHandler(context operator.Context) PodDisruptionBudgetHandler {
+	return &podDisruptionBudgetHandler{
+		context,
+	}
+}
+
+func (p *podDisruptionBudgetHandler) FetchPodDisruptionBudget(key types.NamespacedName) (*policyv1.PodDisruptionBudget, error) {
+	pdb := &policyv1.PodDisruptionBudget{}
+	if exists, err := kubernetes.ResourceC(p.Client).FetchWithKey(key, pdb); err != nil {
+		return nil, err
+	} else if !exists {
+		p.Log.Debug("PodDisruptionBudget not found.")
+		return nil, nil
+	}
+	return pdb, nil
+}
+
+// CreatePodDisruptionBudget creates the PodDisruptionBudget protecting the pods of the given service
+func (p *podDisruptionBudgetHandler) CreatePodDisruptionBudget(instance api.KogitoService) *policyv1.PodDisruptionBudget {
+	podDisruptionBudget := instance.GetSpec().GetPodDisruptionBudget()
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      instance.GetName(),
+			Namespace: instance.GetNamespace(),
+			Labels:    map[string]string{framework.LabelAppKey: instance.GetName()},
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector:       &v1.LabelSelector{MatchLabels: map[string]string{framework.LabelAppKey: instance.GetName()}},
+			MinAvailable:   podDisruptionBudget.GetMinAvailable(),
+			MaxUnavailable: podDisruptionBudget.GetMaxUnavailable(),
+		},
+	}
+}
+
+func (p *podDisruptionBudgetHandler) GetComparator() compare.MapComparator {
+	resourceComparator := compare.DefaultComparator()
+	resourceComparator.SetComparator(
+		framework.NewComparatorBuilder().
+			WithType(reflect.TypeOf(policyv1.PodDisruptionBudget{})).
+			WithCustomComparator(framework.CreatePodDisruptionBudgetComparator()).
+			Build())
+	return compare.MapComparator{Comparator: resourceComparator}
+}
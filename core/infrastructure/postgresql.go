@@ -0,0 +1,120 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package infrastructure
+
+import (
+	"fmt"
+
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	postgresql "github.com/kiegroup/kogito-operator/core/infrastructure/postgresql/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// PostgresClusterKind refers to the PostgresCluster Kind of the Crunchy Data PostgreSQL Operator
+	PostgresClusterKind = "PostgresCluster"
+	// PostgreSQLServiceKind refers to a plain Kubernetes Service exposing a PostgreSQL server
+	PostgreSQLServiceKind = "Service"
+	// PostgreSQLServiceAPIVersion refers to the APIVersion of a plain Kubernetes Service exposing a PostgreSQL server
+	PostgreSQLServiceAPIVersion = "v1"
+
+	// DefaultPostgreSQLPort is the default port of a PostgreSQL server
+	DefaultPostgreSQLPort = 5432
+
+	// PostgreSQLSecretHostKey is the host key set in the user secrets created by the Crunchy Data PostgreSQL Operator
+	PostgreSQLSecretHostKey = "host"
+	// PostgreSQLSecretPortKey is the port key set in the user secrets created by the Crunchy Data PostgreSQL Operator
+	PostgreSQLSecretPortKey = "port"
+	// PostgreSQLSecretDatabaseKey is the database key set in the user secrets created by the Crunchy Data PostgreSQL Operator
+	PostgreSQLSecretDatabaseKey = "dbname"
+	// PostgreSQLSecretUserKey is the user key set in the user secrets created by the Crunchy Data PostgreSQL Operator
+	PostgreSQLSecretUserKey = "user"
+	// PostgreSQLSecretPasswordKey is the password key set in the user secrets created by the Crunchy Data PostgreSQL Operator
+	PostgreSQLSecretPasswordKey = "password"
+
+	postgresClusterUserSecretName  = "%s-pguser-%s"
+	postgresClusterPrimaryHostName = "%s-primary.%s.svc"
+)
+
+var (
+	// PostgresClusterAPIVersion refers to the PostgresCluster APIVersion of the Crunchy Data PostgreSQL Operator
+	PostgresClusterAPIVersion = postgresql.GroupVersion.String()
+
+	postgresClusterServerGroup = postgresql.GroupVersion.Group
+)
+
+// PostgreSQLHandler ...
+type PostgreSQLHandler interface {
+	IsPostgresClusterAvailable() bool
+	FetchPostgresClusterInstance(key types.NamespacedName) (*postgresql.PostgresCluster, error)
+	IsPostgresClusterReady(instance *postgresql.PostgresCluster) bool
+	GetPostgresClusterUserSecretName(instance *postgresql.PostgresCluster, user string) string
+	GetPostgresClusterPrimaryHost(instance *postgresql.PostgresCluster) string
+}
+
+type postgreSQLHandler struct {
+	operator.Context
+}
+
+// NewPostgreSQLHandler ...
+func NewPostgreSQLHandler(context operator.Context) PostgreSQLHandler {
+	return &postgreSQLHandler{
+		context,
+	}
+}
+
+// IsPostgresClusterAvailable checks if the PostgresCluster CRD is available in the cluster
+func (p *postgreSQLHandler) IsPostgresClusterAvailable() bool {
+	return p.Client.HasServerGroup(postgresClusterServerGroup)
+}
+
+func (p *postgreSQLHandler) FetchPostgresClusterInstance(key types.NamespacedName) (*postgresql.PostgresCluster, error) {
+	p.Log.Debug("fetching deployed PostgresCluster instance")
+	instance := &postgresql.PostgresCluster{}
+	if exists, err := kubernetes.ResourceC(p.Client).FetchWithKey(key, instance); err != nil {
+		p.Log.Error(err, "Error occurs while fetching PostgresCluster instance")
+		return nil, err
+	} else if !exists {
+		p.Log.Debug("PostgresCluster instance is not exists")
+		return nil, nil
+	} else {
+		p.Log.Debug("PostgresCluster instance found", "instance", instance.Name)
+		return instance, nil
+	}
+}
+
+// IsPostgresClusterReady checks if at least one PostgreSQL instance of the given cluster is ready to accept connections
+func (p *postgreSQLHandler) IsPostgresClusterReady(instance *postgresql.PostgresCluster) bool {
+	for _, instanceSet := range instance.Status.InstanceSets {
+		if instanceSet.ReadyReplicas > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// GetPostgresClusterUserSecretName gets the name of the secret holding the credentials of the given user, e.g. hippo-pguser-hippo
+func (p *postgreSQLHandler) GetPostgresClusterUserSecretName(instance *postgresql.PostgresCluster, user string) string {
+	return fmt.Sprintf(postgresClusterUserSecretName, instance.Name, user)
+}
+
+// GetPostgresClusterPrimaryHost gets the host of the primary PostgreSQL instance of the given cluster
+func (p *postgreSQLHandler) GetPostgresClusterPrimaryHost(instance *postgresql.PostgresCluster) string {
+	return fmt.Sprintf(postgresClusterPrimaryHostName, instance.Name, instance.Namespace)
+}
@@ -0,0 +1,102 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PostgresClusterSpec defines the desired state of PostgresCluster.
+// Only the fields required by the Kogito Operator are mapped here.
+type PostgresClusterSpec struct {
+	// The major version of PostgreSQL installed in the PostgreSQL image
+	// +kubebuilder:validation:Required
+	PostgresVersion int `json:"postgresVersion"`
+
+	// The port on which PostgreSQL should listen.
+	// +optional
+	// +kubebuilder:default=5432
+	Port *int32 `json:"port,omitempty"`
+
+	// Users to create inside PostgreSQL and the databases they should access.
+	// The default creates one user that can access one database matching the
+	// PostgresCluster name.
+	// +optional
+	Users []PostgresUserSpec `json:"users,omitempty"`
+}
+
+// PostgresUserSpec defines a PostgreSQL user managed by the PostgresCluster
+type PostgresUserSpec struct {
+	// The name of this PostgreSQL user. The credentials are stored in a Secret named
+	// "<cluster>-pguser-<name>".
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Databases to which this user can connect and create objects.
+	// +optional
+	Databases []string `json:"databases,omitempty"`
+}
+
+// PostgresInstanceSetStatus defines the observed state of a set of PostgreSQL instances
+type PostgresInstanceSetStatus struct {
+	Name string `json:"name"`
+
+	// Total number of ready pods.
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+
+	// Total number of non-terminated pods.
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+}
+
+// PostgresClusterStatus defines the observed state of PostgresCluster
+type PostgresClusterStatus struct {
+	// Current state of PostgreSQL instances.
+	// +optional
+	InstanceSets []PostgresInstanceSetStatus `json:"instances,omitempty"`
+
+	// conditions represent the observations of postgrescluster's current state.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// PostgresCluster is the Schema for the postgresclusters API
+type PostgresCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PostgresClusterSpec   `json:"spec,omitempty"`
+	Status PostgresClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PostgresClusterList contains a list of PostgresCluster
+type PostgresClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PostgresCluster `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PostgresCluster{}, &PostgresClusterList{})
+}
@@ -99,16 +99,22 @@ func getServiceViewerRole(namespace string) client.Object {
 		},
 	}
 }
+
 func getServiceViewerRoleBinding(namespace string) client.Object {
+	return NewServiceViewerRoleBinding(roleBindingName, namespace, RuntimeServiceAccountName)
+}
+
+// NewServiceViewerRoleBinding creates a RoleBinding granting the service viewer Role to the given ServiceAccount
+func NewServiceViewerRoleBinding(name, namespace, serviceAccountName string) *rbac.RoleBinding {
 	return &rbac.RoleBinding{
 		ObjectMeta: v12.ObjectMeta{
-			Name:      roleBindingName,
+			Name:      name,
 			Namespace: namespace,
 		},
 		Subjects: []rbac.Subject{
 			{
 				Kind: "ServiceAccount",
-				Name: RuntimeServiceAccountName,
+				Name: serviceAccountName,
 			},
 		},
 		RoleRef: rbac.RoleRef{
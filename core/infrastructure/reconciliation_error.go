@@ -22,7 +22,9 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/kiegroup/kogito-operator/core/framework/util"
 	"github.com/kiegroup/kogito-operator/core/operator"
+	"k8s.io/apimachinery/pkg/util/wait"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
@@ -68,6 +70,18 @@ const (
 	RouteProcessed ConditionReason = "RouteProcessed"
 	// RouteCreationFailureReason - Unable to properly create Route
 	RouteCreationFailureReason ConditionReason = "RouteCreationFailure"
+	// AutoscalingNotSupportedReason - The service can't have more than one replica, so it can't be autoscaled
+	AutoscalingNotSupportedReason ConditionReason = "AutoscalingNotSupported"
+	// KafkaUserNotReadyReason - The credentials of the Kafka user haven't been issued by Strimzi yet
+	KafkaUserNotReadyReason ConditionReason = "KafkaUserNotReady"
+	// KnativeServingNotAvailableReason - The service is deployed with the KnativeServing mode but Knative Serving isn't installed
+	KnativeServingNotAvailableReason ConditionReason = "KnativeServingNotAvailable"
+	// IntrospectionInProgressReason - The metadata exposed by the service are being fetched
+	IntrospectionInProgressReason ConditionReason = "IntrospectionInProgress"
+	// APINotAvailableReason - The service requires an API not served by the cluster
+	APINotAvailableReason ConditionReason = "APINotAvailable"
+	// NamespaceNotWatchedReason - A resource used by the service is in a namespace not watched by the operator
+	NamespaceNotWatchedReason ConditionReason = "NamespaceNotWatched"
 )
 
 const (
@@ -81,8 +95,31 @@ const (
 	ReconciliationAfterFive = time.Second * 5
 	// ReconciliationAfterOneMinuteDuration ...
 	ReconciliationAfterOneMinuteDuration = time.Minute
+	// DefaultRequeueJitterFactor is the default maximum fraction of the requeue interval randomly added to it
+	DefaultRequeueJitterFactor = 0.1
 )
 
+// requeueJitterFactor spreads the requeued reconciliations over time, so resources failing together aren't reconciled in bursts
+var requeueJitterFactor = DefaultRequeueJitterFactor
+
+// SetRequeueJitterFactor sets the maximum fraction of the requeue interval randomly added to it, zero disables the jitter
+func SetRequeueJitterFactor(factor float64) {
+	requeueJitterFactor = factor
+}
+
+// GetRequeueJitterFactor gets the maximum fraction of the requeue interval randomly added to it
+func GetRequeueJitterFactor() float64 {
+	return requeueJitterFactor
+}
+
+// RequeueAfter returns the given requeue interval with the configured jitter applied
+func RequeueAfter(interval time.Duration) time.Duration {
+	if requeueJitterFactor <= 0 {
+		return interval
+	}
+	return wait.Jitter(interval, requeueJitterFactor)
+}
+
 // ReconciliationError ...
 type ReconciliationError struct {
 	reason                 ConditionReason
@@ -199,6 +236,60 @@ func ErrorForRouteCreation(err error) ReconciliationError {
 	}
 }
 
+// ErrorForAutoscalingNotSupported ...
+func ErrorForAutoscalingNotSupported(serviceName string) ReconciliationError {
+	return ReconciliationError{
+		reason:     AutoscalingNotSupportedReason,
+		innerError: fmt.Errorf("KogitoService '%s' can't be autoscaled, only one replica is allowed; remove spec.autoscaling", serviceName),
+	}
+}
+
+// ErrorForKafkaUserNotReady ...
+func ErrorForKafkaUserNotReady(serviceName, userSecret string) ReconciliationError {
+	return ReconciliationError{
+		reconciliationInterval: ReconciliationAfterTen,
+		reason:                 KafkaUserNotReadyReason,
+		innerError:             fmt.Errorf("KogitoService '%s' is waiting for the Kafka user credentials; secret %s not found", serviceName, userSecret),
+	}
+}
+
+// ErrorForKnativeServingNotAvailable ...
+func ErrorForKnativeServingNotAvailable(serviceName string) ReconciliationError {
+	return ReconciliationError{
+		reconciliationInterval: ReconciliationAfterOneMinuteDuration,
+		reason:                 KnativeServingNotAvailableReason,
+		innerError:             fmt.Errorf("KogitoService '%s' is deployed with the KnativeServing mode but Knative Serving is not available in the cluster", serviceName),
+	}
+}
+
+// ErrorForIntrospectionInProgress ...
+func ErrorForIntrospectionInProgress(serviceName string) ReconciliationError {
+	return ReconciliationError{
+		reconciliationInterval: ReconciliationAfterFive,
+		reason:                 IntrospectionInProgressReason,
+		innerError:             fmt.Errorf("KogitoService '%s' is being introspected; waiting for its messaging and monitoring metadata", serviceName),
+	}
+}
+
+// ErrorForAPINotAvailable ...
+func ErrorForAPINotAvailable(serviceName, groupVersion string) ReconciliationError {
+	return ReconciliationError{
+		reconciliationInterval: ReconciliationAfterOneMinuteDuration,
+		reason:                 APINotAvailableReason,
+		innerError:             fmt.Errorf("KogitoService '%s' requires the %s API, which is not available in the cluster", serviceName, groupVersion),
+	}
+}
+
+// ErrorForNamespaceNotWatched ...
+func ErrorForNamespaceNotWatched(serviceName, kind, name, namespace string) ReconciliationError {
+	return ReconciliationError{
+		reconciliationInterval: ReconciliationAfterOneMinuteDuration,
+		reason:                 NamespaceNotWatchedReason,
+		innerError: fmt.Errorf("KogitoService '%s' uses %s %s in namespace %s, which is not watched by the operator. Add it to the %s environment variable of the operator: %v",
+			serviceName, kind, name, namespace, util.WatchNamespaceEnv, util.GetWatchNamespaces()),
+	}
+}
+
 // ReconciliationErrorHandler ...
 type ReconciliationErrorHandler interface {
 	IsReconciliationError(err error) bool
@@ -231,8 +322,8 @@ func (r *reconciliationErrorHandler) GetReconcileResultFor(err error) (ctrl.Resu
 	// reconciliation always happens if we return an error
 	if r.IsReconciliationError(err) {
 		reconcileError := err.(ReconciliationError)
-		r.Log.Info("Waiting for all resources to be created, re-scheduling.", "reason", reconcileError.reason, "requeueAfter", reconcileError.reconciliationInterval)
-		reconcileResult.RequeueAfter = reconcileError.reconciliationInterval
+		reconcileResult.RequeueAfter = RequeueAfter(reconcileError.reconciliationInterval)
+		r.Log.Info("Waiting for all resources to be created, re-scheduling.", "reason", reconcileError.reason, "requeueAfter", reconcileResult.RequeueAfter)
 		return reconcileResult, nil
 	}
 	return reconcileResult, err
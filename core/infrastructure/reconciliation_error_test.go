@@ -0,0 +1,46 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package infrastructure
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_reconciliationErrorHandler_GetReconcileResultForWithJitter(t *testing.T) {
+	defer SetRequeueJitterFactor(DefaultRequeueJitterFactor)
+	context := operator.Context{Log: test.TestLogger}
+	errorHandler := NewReconciliationErrorHandler(context)
+
+	SetRequeueJitterFactor(0.5)
+	for i := 0; i < 10; i++ {
+		result, err := errorHandler.GetReconcileResultFor(ErrorForImageNotFound())
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, result.RequeueAfter, ReconciliationAfterTen)
+		assert.LessOrEqual(t, result.RequeueAfter, ReconciliationAfterTen+5*time.Second)
+	}
+
+	SetRequeueJitterFactor(0)
+	result, err := errorHandler.GetReconcileResultFor(ErrorForImageNotFound())
+	assert.NoError(t, err)
+	assert.Equal(t, ReconciliationAfterTen, result.RequeueAfter)
+}
@@ -0,0 +1,106 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package infrastructure
+
+import (
+	"reflect"
+
+	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	istio "github.com/kiegroup/kogito-operator/core/infrastructure/istio/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// VirtualServiceHandler ...
+type VirtualServiceHandler interface {
+	IsVirtualServiceAvailable() bool
+	FetchVirtualService(key types.NamespacedName) (*istio.VirtualService, error)
+	CreateWeightedVirtualService(instance api.KogitoService, candidateService string, candidateWeight int32) *istio.VirtualService
+	GetComparator() compare.MapComparator
+}
+
+type virtualServiceHandler struct {
+	operator.Context
+}
+
+// NewVirtualServiceHandler ...
+func NewVirtualServiceHandler(context operator.Context) VirtualServiceHandler {
+	return &virtualServiceHandler{
+		context,
+	}
+}
+
+// IsVirtualServiceAvailable checks if the Istio VirtualService CRD is available in the cluster
+func (v *virtualServiceHandler) IsVirtualServiceAvailable() bool {
+	return v.Client.HasServerGroup(istio.GroupVersion.Group)
+}
+
+func (v *virtualServiceHandler) FetchVirtualService(key types.NamespacedName) (*istio.VirtualService, error) {
+	virtualService := &istio.VirtualService{}
+	if exists, err := kubernetes.ResourceC(v.Client).FetchWithKey(key, virtualService); err != nil {
+		return nil, err
+	} else if !exists {
+		v.Log.Debug("VirtualService not found.")
+		return nil, nil
+	}
+	return virtualService, nil
+}
+
+// CreateWeightedVirtualService creates a VirtualService splitting the traffic of the given service between its Service and the candidate one
+func (v *virtualServiceHandler) CreateWeightedVirtualService(instance api.KogitoService, candidateService string, candidateWeight int32) *istio.VirtualService {
+	port := &istio.PortSelector{Number: defaultHTTPPort}
+	return &istio.VirtualService{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      instance.GetName(),
+			Namespace: instance.GetNamespace(),
+			Labels:    map[string]string{framework.LabelAppKey: instance.GetName()},
+		},
+		Spec: istio.VirtualServiceSpec{
+			Hosts: []string{instance.GetName()},
+			HTTP: []istio.HTTPRoute{
+				{
+					Name: instance.GetName(),
+					Route: []istio.HTTPRouteDestination{
+						{
+							Destination: istio.Destination{Host: instance.GetName(), Port: port},
+							Weight:      100 - candidateWeight,
+						},
+						{
+							Destination: istio.Destination{Host: candidateService, Port: port},
+							Weight:      candidateWeight,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (v *virtualServiceHandler) GetComparator() compare.MapComparator {
+	resourceComparator := compare.DefaultComparator()
+	resourceComparator.SetComparator(
+		framework.NewComparatorBuilder().
+			WithType(reflect.TypeOf(istio.VirtualService{})).
+			WithCustomComparator(framework.CreateVirtualServiceComparator()).
+			Build())
+	return compare.MapComparator{Comparator: resourceComparator}
+}
@@ -18,15 +18,21 @@
 package kogitobuild
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
 	"github.com/kiegroup/kogito-operator/core/infrastructure"
 	"github.com/kiegroup/kogito-operator/core/manager"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	"io"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"path"
 	"strings"
 	"time"
 
@@ -105,6 +111,10 @@ func (b *buildHandler) TriggerBuild(bc *buildv1.BuildConfig, triggeredBy string)
 // TriggerBuildFromFile will be called by kogito-cli when a build from file is performed.
 // When called a new build will be triggered with the request kogito resource or a tgz file.
 func (b *buildHandler) TriggerBuildFromFile(namespace string, bodyPost io.Reader, options *buildv1.BinaryBuildRequestOptions, binaryBuild bool, scheme *runtime.Scheme) (*buildv1.Build, error) {
+	if !b.Client.IsOpenshift() {
+		return b.triggerJobBuildFromFile(namespace, bodyPost, options)
+	}
+
 	result := &buildv1.Build{}
 
 	buildName := options.Name
@@ -139,6 +149,76 @@ func (b *buildHandler) TriggerBuildFromFile(namespace string, bodyPost io.Reader
 	return result, errPost
 }
 
+// triggerJobBuildFromFile uploads the file to the cluster and starts a new build Job, used on Kubernetes where BuildConfigs are not available
+func (b *buildHandler) triggerJobBuildFromFile(namespace string, bodyPost io.Reader, options *buildv1.BinaryBuildRequestOptions) (*buildv1.Build, error) {
+	var build api.KogitoBuildInterface
+	// before upload the file, make sure that the build exist
+	err := b.waitForBuildConfig(b.checkBcRetries, b.checkBcRetriesInterval, func() (err error) {
+		build, err = b.buildHandler.FetchKogitoBuildInstance(types.NamespacedName{Name: options.Name, Namespace: namespace})
+		if err == nil && build == nil {
+			b.Log.Debug("KogitoBuild not found.", "name", options.Name, "namespace", namespace)
+			return fmt.Errorf("KogitoBuild %s not found in namespace %s", options.Name, namespace)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	jobBuildManager := &kubernetesBuildManager{buildManager{build: build, buildHandler: b.buildHandler, Context: b.Context}}
+	// ConfigMaps are limited to 1MiB, only small files are kept in the cluster, bigger ones are streamed to the build pod
+	content := &bytes.Buffer{}
+	if _, err := io.CopyN(content, bodyPost, maxConfigMapUploadSize+1); err != nil && err != io.EOF {
+		return nil, err
+	}
+	var job *batchv1.Job
+	if content.Len() <= maxConfigMapUploadSize {
+		if err := jobBuildManager.uploadFile(options.AsFile, content.Bytes()); err != nil {
+			return nil, err
+		}
+		job, err = jobBuildManager.startNewBuild(false)
+	} else {
+		job, err = b.streamJobBuildFile(jobBuildManager, options.AsFile, io.MultiReader(content, bodyPost))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &buildv1.Build{ObjectMeta: metav1.ObjectMeta{Name: job.Name, Namespace: job.Namespace}}, nil
+}
+
+// streamJobBuildFile starts a new build Job waiting for the given file and copies it to the build pod once it's running
+func (b *buildHandler) streamJobBuildFile(jobBuildManager *kubernetesBuildManager, fileName string, content io.Reader) (*batchv1.Job, error) {
+	if err := jobBuildManager.removeUploadedFiles(); err != nil {
+		return nil, err
+	}
+	job, err := jobBuildManager.startNewBuild(true)
+	if err != nil {
+		return nil, err
+	}
+	var pod *corev1.Pod
+	err = b.waitForBuildConfig(b.checkBcRetries, b.checkBcRetriesInterval, func() (err error) {
+		pod, err = jobBuildManager.getUploadReceiverPod(job)
+		if err == nil && pod == nil {
+			b.Log.Debug("Build pod not ready to receive the files yet.", "build name", job.Name, "namespace", job.Namespace)
+			return fmt.Errorf("build pod for %s not ready to receive the files", job.Name)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(fileName) == 0 {
+		fileName = defaultUploadFileName
+	}
+	b.Log.Info("Streaming file to the build pod", "pod name", pod.Name, "file", fileName)
+	stderr := &bytes.Buffer{}
+	command := []string{"/bin/bash", "-c", uploadReceiveCommand, path.Base(fileName)}
+	if err := kubernetes.PodC(b.Client).Exec(pod.Namespace, pod.Name, uploadReceiverContainerName, command, content, nil, stderr); err != nil {
+		return nil, fmt.Errorf("failed to upload %s to the build pod %s: %v %s", fileName, pod.Name, err, stderr.String())
+	}
+	return job, nil
+}
+
 // GetBuildsStatusByLabel checks the status of the builds for all builds with the given label
 func (b *buildHandler) GetBuildsStatusByLabel(namespace, labelSelector string) (api.BuildsInterface, error) {
 	list, err := b.Client.BuildCli.Builds(namespace).List(context.TODO(), metav1.ListOptions{
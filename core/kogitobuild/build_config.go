@@ -71,7 +71,7 @@ func (b *buildConfigHandler) newBuildConfig(build api.KogitoBuildInterface, deco
 		},
 		Spec: buildv1.BuildConfigSpec{
 			RunPolicy:  buildv1.BuildRunPolicySerial,
-			CommonSpec: buildv1.CommonSpec{Resources: build.GetSpec().GetResources()},
+			CommonSpec: buildv1.CommonSpec{Resources: build.GetSpec().GetResources(), ServiceAccount: build.GetSpec().GetServiceAccountName()},
 		},
 	}
 	for _, decorate := range decorators {
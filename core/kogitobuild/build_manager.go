@@ -28,6 +28,7 @@ import (
 	"github.com/kiegroup/kogito-operator/core/operator"
 	buildv1 "github.com/openshift/api/build/v1"
 	imgv1 "github.com/openshift/api/image/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"reflect"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -50,9 +51,10 @@ type deltaProcessor struct {
 
 // NewDeltaProcessor creates a new DeltaProcessor instance for the given KogitoBuild
 func NewDeltaProcessor(context operator.Context, build api.KogitoBuildInterface, buildHandler manager.KogitoBuildHandler) (DeltaProcessor, error) {
-	setDefaults(build)
-	if err := sanityCheck(build); err != nil {
-		return nil, err
+	// defaults and validation are applied by the admission webhooks, applied again here in case they are disabled
+	SetDefaults(build)
+	if errs := Validate(build); len(errs) > 0 {
+		return nil, fmt.Errorf("%s: %s", errorPrefix, errs.ToAggregate().Error())
 	}
 	return &deltaProcessor{
 		Context:      context,
@@ -61,35 +63,30 @@ func NewDeltaProcessor(context operator.Context, build api.KogitoBuildInterface,
 	}, nil
 }
 
-// setDefaults sets the default values for the given KogitoBuild
-func setDefaults(build api.KogitoBuildInterface) {
-	if len(build.GetSpec().GetRuntime()) == 0 {
-		build.GetSpec().SetRuntime(api.QuarkusRuntimeType)
-	}
-}
-
-// sanityCheck verifies the spec attributes for the given KogitoBuild instance
-func sanityCheck(build api.KogitoBuildInterface) error {
-	if len(build.GetSpec().GetType()) == 0 {
-		return fmt.Errorf("%s: %s", errorPrefix, "build Type is required")
-	}
-	if build.GetSpec().GetType() == api.RemoteSourceBuildType &&
-		len(build.GetSpec().GetGitSource().GetURI()) == 0 {
-		return fmt.Errorf("%s: %s %s", errorPrefix, "Git URL is required when build type is", api.RemoteSourceBuildType)
-	}
-	return nil
-}
-
 type buildManager struct {
-	build api.KogitoBuildInterface
+	build        api.KogitoBuildInterface
+	buildHandler manager.KogitoBuildHandler
 	operator.Context
 }
 
-// BuildManager ...
+// BuildManager is the common interface for the platform specific build implementations.
+// On OpenShift builds are handled by BuildConfigs and ImageStreams, on Kubernetes each build runs as a Job.
 type BuildManager interface {
 	GetRequestedResources() (map[reflect.Type][]client.Object, error)
 	GetDeployedResources() (map[reflect.Type][]client.Object, error)
 	GetComparator() compare.MapComparator
+	// OnResourceChange triggers hooks after the requested resources of the given type were created or updated in the cluster
+	OnResourceChange(resourceType reflect.Type, created []client.Object, updated []client.Object) error
+	// getBuilds lists every build executed for the managed KogitoBuild
+	getBuilds() ([]buildRun, error)
+}
+
+// buildRun is the platform agnostic representation of a single build execution
+type buildRun struct {
+	name              string
+	phase             buildv1.BuildPhase
+	message           string
+	creationTimestamp metav1.Time
 }
 
 func (d *deltaProcessor) ProcessDelta() (resultErr error) {
@@ -126,8 +123,8 @@ func (d *deltaProcessor) ProcessDelta() (resultErr error) {
 			return
 		}
 
-		if len(delta.Updated) > 0 {
-			if resultErr = d.onResourceChange(d.build, resourceType, delta.Updated); resultErr != nil {
+		if len(delta.Added) > 0 || len(delta.Updated) > 0 {
+			if resultErr = m.OnResourceChange(resourceType, delta.Added, delta.Updated); resultErr != nil {
 				return
 			}
 		}
@@ -136,12 +133,22 @@ func (d *deltaProcessor) ProcessDelta() (resultErr error) {
 }
 
 func (d *deltaProcessor) getBuildManager() BuildManager {
+	return newBuildManager(d.Context, d.build, d.buildHandler)
+}
+
+// newBuildManager creates the BuildManager for the given KogitoBuild based on the cluster platform and the build type
+func newBuildManager(context operator.Context, build api.KogitoBuildInterface, buildHandler manager.KogitoBuildHandler) BuildManager {
 	buildManager := buildManager{
-		Context: d.Context,
-		build:   d.build,
+		Context:      context,
+		build:        build,
+		buildHandler: buildHandler,
 	}
-	if api.LocalSourceBuildType == d.build.GetSpec().GetType() ||
-		api.RemoteSourceBuildType == d.build.GetSpec().GetType() {
+	if !context.Client.IsOpenshift() {
+		buildManager.Log = buildManager.Log.WithValues("build_platform", "kubernetes")
+		return &kubernetesBuildManager{buildManager}
+	}
+	if api.LocalSourceBuildType == build.GetSpec().GetType() ||
+		api.RemoteSourceBuildType == build.GetSpec().GetType() {
 		buildManager.Log = buildManager.Log.WithValues("build_type", "source")
 		return &sourceBuildManager{buildManager}
 	}
@@ -180,26 +187,26 @@ func (m *buildManager) GetComparator() compare.MapComparator {
 	return compare.MapComparator{Comparator: resourceComparator}
 }
 
-// onResourceChange triggers hooks when a resource is changed
-func (d *deltaProcessor) onResourceChange(instance api.KogitoBuildInterface, resourceType reflect.Type, resources []client.Object) error {
+// OnResourceChange starts a new build whenever the BuildConfig changes
+func (m *buildManager) OnResourceChange(resourceType reflect.Type, created []client.Object, updated []client.Object) error {
 	// add other resources if need
 	switch resourceType {
 	case reflect.TypeOf(buildv1.BuildConfig{}):
-		return d.onBuildConfigChange(instance, resources)
+		return m.onBuildConfigChange(updated)
 	}
 	return nil
 }
 
 // onBuildConfigChange triggers when a build config changes
-func (d *deltaProcessor) onBuildConfigChange(instance api.KogitoBuildInterface, buildConfigs []client.Object) error {
+func (m *buildManager) onBuildConfigChange(buildConfigs []client.Object) error {
 	// triggers only on source builds
-	if instance.GetSpec().GetType() == api.RemoteSourceBuildType ||
-		instance.GetSpec().GetType() == api.LocalSourceBuildType {
+	if m.build.GetSpec().GetType() == api.RemoteSourceBuildType ||
+		m.build.GetSpec().GetType() == api.LocalSourceBuildType {
 		for _, bc := range buildConfigs {
 			// building from source
-			if bc.GetName() == GetBuildBuilderName(instance) {
-				d.Log.Info("Changes detected for build config, starting again", "Build Config", bc.GetName())
-				triggerHandler := NewTriggerHandler(d.Context, d.buildHandler)
+			if bc.GetName() == GetBuildBuilderName(m.build) {
+				m.Log.Info("Changes detected for build config, starting again", "Build Config", bc.GetName())
+				triggerHandler := NewTriggerHandler(m.Context, m.buildHandler)
 				if err := triggerHandler.StartNewBuild(bc.(*buildv1.BuildConfig)); err != nil {
 					return err
 				}
@@ -209,6 +216,24 @@ func (d *deltaProcessor) onBuildConfigChange(instance api.KogitoBuildInterface,
 	return nil
 }
 
+// getBuilds lists the OpenShift Builds created by the BuildConfigs of the managed KogitoBuild
+func (m *buildManager) getBuilds() ([]buildRun, error) {
+	builds := &buildv1.BuildList{}
+	if err := kubernetes.ResourceC(m.Client).ListWithNamespaceAndLabel(m.build.GetNamespace(), builds, getBuildLabels(m.build)); err != nil {
+		return nil, err
+	}
+	var runs []buildRun
+	for _, build := range builds.Items {
+		runs = append(runs, buildRun{
+			name:              build.Name,
+			phase:             build.Status.Phase,
+			message:           build.Status.Message,
+			creationTimestamp: build.CreationTimestamp,
+		})
+	}
+	return runs, nil
+}
+
 // AddSharedImageStreamToResources adds the shared ImageStream in the given resource map.
 // Normally used during reconciliation phase to bring a not yet owned ImageStream to the deployed list.
 func (m *buildManager) addSharedImageStreamToResources(resources map[reflect.Type][]client.Object, name, ns string) error {
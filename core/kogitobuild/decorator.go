@@ -55,6 +55,7 @@ type DecoratorHandler interface {
 	decoratorForSourceRuntimeBuilder() decorator
 	decoratorForRuntimeBuilder() decorator
 	decoratorForCustomLabels() decorator
+	getSourceBuilderEnvs(build api.KogitoBuildInterface) []corev1.EnvVar
 }
 
 type decoratorHandler struct {
@@ -141,27 +142,13 @@ func (b *decoratorHandler) decoratorForSourceBuilder() decorator {
 			{Type: buildv1.ImageChangeBuildTriggerType, ImageChange: &buildv1.ImageChangeTrigger{From: &baseImage}},
 		}
 		// apply the necessary environment variables
-		envs := build.GetSpec().GetEnv()
-		if build.GetSpec().GetRuntime() == api.QuarkusRuntimeType {
-			envs = framework.EnvOverride(envs, corev1.EnvVar{Name: nativeBuildEnvVarKey, Value: strconv.FormatBool(build.GetSpec().IsNative())})
-		}
-		limitCPU, limitMemory := getBuilderLimitsAsIntString(bc)
-		envs = framework.EnvOverride(envs, corev1.EnvVar{Name: builderLimitCPUEnvVarKey, Value: limitCPU})
-		envs = framework.EnvOverride(envs, corev1.EnvVar{Name: builderLimitMemoryEnvVarKey, Value: limitMemory})
-		if len(build.GetSpec().GetMavenMirrorURL()) > 0 {
-			b.Log.Info("Setting maven mirror", "Maven Mirror Url", build.GetSpec().GetMavenMirrorURL())
-			envs = framework.EnvOverride(envs, corev1.EnvVar{Name: mavenMirrorURLEnvVar, Value: build.GetSpec().GetMavenMirrorURL()})
-		}
-		if build.GetSpec().IsEnableMavenDownloadOutput() {
-			b.Log.Debug("Enable logging for transfer progress of downloading/uploading maven dependencies")
-			envs = framework.EnvOverride(envs,
-				corev1.EnvVar{Name: mavenDownloadOutputEnvVar, Value: strconv.FormatBool(build.GetSpec().IsEnableMavenDownloadOutput())})
-		}
+		envs := b.getSourceBuilderEnvs(build)
 		incremental := !build.GetSpec().IsDisableIncremental()
 		bc.Spec.Strategy = buildv1.BuildStrategy{
 			Type: buildv1.SourceBuildStrategyType,
 			SourceStrategy: &buildv1.SourceBuildStrategy{
 				From:        baseImage,
+				PullSecret:  getBaseImagePullSecret(build),
 				Env:         envs,
 				Incremental: &incremental,
 			},
@@ -169,6 +156,27 @@ func (b *decoratorHandler) decoratorForSourceBuilder() decorator {
 	}
 }
 
+// getSourceBuilderEnvs gets the environment variables required by the builder image to build the Kogito Service from source
+func (b *decoratorHandler) getSourceBuilderEnvs(build api.KogitoBuildInterface) []corev1.EnvVar {
+	envs := build.GetSpec().GetEnv()
+	if build.GetSpec().GetRuntime() == api.QuarkusRuntimeType {
+		envs = framework.EnvOverride(envs, corev1.EnvVar{Name: nativeBuildEnvVarKey, Value: strconv.FormatBool(build.GetSpec().IsNative())})
+	}
+	limitCPU, limitMemory := getBuilderLimitsAsIntString(build.GetSpec().GetResources())
+	envs = framework.EnvOverride(envs, corev1.EnvVar{Name: builderLimitCPUEnvVarKey, Value: limitCPU})
+	envs = framework.EnvOverride(envs, corev1.EnvVar{Name: builderLimitMemoryEnvVarKey, Value: limitMemory})
+	if len(build.GetSpec().GetMavenMirrorURL()) > 0 {
+		b.Log.Info("Setting maven mirror", "Maven Mirror Url", build.GetSpec().GetMavenMirrorURL())
+		envs = framework.EnvOverride(envs, corev1.EnvVar{Name: mavenMirrorURLEnvVar, Value: build.GetSpec().GetMavenMirrorURL()})
+	}
+	if build.GetSpec().IsEnableMavenDownloadOutput() {
+		b.Log.Debug("Enable logging for transfer progress of downloading/uploading maven dependencies")
+		envs = framework.EnvOverride(envs,
+			corev1.EnvVar{Name: mavenDownloadOutputEnvVar, Value: strconv.FormatBool(build.GetSpec().IsEnableMavenDownloadOutput())})
+	}
+	return envs
+}
+
 // decoratorForBinaryRuntimeBuilder decorates the original BuildConfig to give support for Binary build type
 func (b *decoratorHandler) decoratorForBinaryRuntimeBuilder() decorator {
 	return func(build api.KogitoBuildInterface, bc *buildv1.BuildConfig) {
@@ -228,8 +236,9 @@ func (b *decoratorHandler) decoratorForRuntimeBuilder() decorator {
 		bc.Spec.Strategy = buildv1.BuildStrategy{
 			Type: buildv1.SourceBuildStrategyType,
 			SourceStrategy: &buildv1.SourceBuildStrategy{
-				From: baseImage,
-				Env:  build.GetSpec().GetEnv(),
+				From:       baseImage,
+				PullSecret: getBaseImagePullSecret(build),
+				Env:        build.GetSpec().GetEnv(),
 			},
 		}
 	}
@@ -243,6 +252,14 @@ func getBuilderImageStreamOutputTo(build api.KogitoBuildInterface) *corev1.Objec
 	}
 }
 
+// getBaseImagePullSecret gets the secret used to pull the base image of the build, OpenShift builds accept a single one
+func getBaseImagePullSecret(build api.KogitoBuildInterface) *corev1.LocalObjectReference {
+	if pullSecrets := build.GetSpec().GetImagePullSecrets(); len(pullSecrets) > 0 {
+		return &pullSecrets[0]
+	}
+	return nil
+}
+
 func (b *decoratorHandler) decoratorForCustomLabels() decorator {
 	return func(build api.KogitoBuildInterface, bc *buildv1.BuildConfig) {
 		util.AppendToStringMap(b.Labels, bc.Labels)
@@ -0,0 +1,671 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitobuild
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/framework/util"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	buildv1 "github.com/openshift/api/build/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	kogitoImageBuilderEnvVar = "IMAGE_BUILDER"
+	// defaultImageBuilder in-cluster image builder used to build and push the final image on Kubernetes
+	defaultImageBuilder = "gcr.io/kaniko-project/executor:v1.9.1"
+
+	buildDefinitionSuffix = "-build"
+	buildUploadSuffix     = "-upload"
+	registryAuthSuffix    = "-registry-auth"
+	// defaultUploadFileName name of the uploaded file when the CLI sends a compressed directory
+	defaultUploadFileName = "source.tgz"
+	dockerfileKey         = "Dockerfile"
+	// maxConfigMapUploadSize biggest file kept in the upload ConfigMap, limited to 1MiB by the API server including its metadata.
+	// Bigger files are streamed to the build pod instead.
+	maxConfigMapUploadSize = 900 * 1024
+	// uploadDoneMarker file created once the streamed upload is complete, ignored by the builder since it's hidden
+	uploadDoneMarker = ".kogito-upload-done"
+	// uploadReceiverTimeoutSeconds is how long the build pod waits for a streamed upload before failing
+	uploadReceiverTimeoutSeconds = "600"
+
+	builderContainerName        = "builder"
+	imageBuilderContainerName   = "image-builder"
+	uploadReceiverContainerName = "upload-receiver"
+	workspaceVolumeName         = "workspace"
+	definitionVolumeName        = "build-definition"
+	uploadVolumeName            = "build-upload"
+	pushSecretVolumeName        = "push-secret"
+	workspacePath               = "/workspace"
+	definitionPath              = "/kogito/build"
+	uploadPath                  = "/kogito/upload"
+	pushSecretPath              = "/kaniko/.docker"
+
+	buildSpecHashAnnotation  = "kogito.kie.org/build-spec-hash"
+	buildNumberAnnotation    = "kogito.kie.org/build-number"
+	buildCancelledAnnotation = "kogito.kie.org/build-cancelled"
+	// streamedUploadAnnotation marks the builds waiting for the files streamed by the CLI instead of reading the upload ConfigMap
+	streamedUploadAnnotation = "kogito.kie.org/build-streamed-upload"
+	// jobNameLabel is added by the Job controller to the pods it creates
+	jobNameLabel = "job-name"
+
+	// gitSourceScript clones the Git repository and builds the application with the s2i scripts provided by the builder image
+	gitSourceScript = `set -e
+git clone "${GIT_URI}" /tmp/kogito-source
+if [ -n "${GIT_REFERENCE}" ]; then git -C /tmp/kogito-source checkout "${GIT_REFERENCE}"; fi
+mkdir -p /tmp/src ` + workspacePath + `/bin
+cp -R "/tmp/kogito-source/${GIT_CONTEXT_DIR}/." /tmp/src/
+/usr/local/s2i/assemble
+cp -R ` + runnerSourcePath + `/. ` + workspacePath + `/bin/
+`
+	// uploadSourceScript extracts the files uploaded by the CLI and builds the application with the s2i scripts provided by the image
+	uploadSourceScript = `set -e
+mkdir -p /tmp/src ` + workspacePath + `/bin
+for file in ` + uploadPath + `/*; do
+  case "${file}" in
+    *.tgz|*.tar.gz) tar -xzf "${file}" -C /tmp/src ;;
+    *) cp "${file}" /tmp/src/ ;;
+  esac
+done
+/usr/local/s2i/assemble
+cp -R ` + runnerSourcePath + `/. ` + workspacePath + `/bin/
+`
+	// uploadReceiverScript waits until the CLI has streamed the files to build to the shared upload volume
+	uploadReceiverScript = `for i in $(seq ` + uploadReceiverTimeoutSeconds + `); do
+  if [ -f ` + uploadPath + `/` + uploadDoneMarker + ` ]; then exit 0; fi
+  sleep 1
+done
+echo "Timed out waiting for the files to build" >&2
+exit 1
+`
+	// uploadReceiveCommand writes the streamed file, whose name is given as first argument, and marks the upload as complete
+	uploadReceiveCommand = `cat > "` + uploadPath + `/${0}" && touch ` + uploadPath + `/` + uploadDoneMarker
+	gitURIEnvVar         = "GIT_URI"
+	gitReferenceEnvVar   = "GIT_REFERENCE"
+	gitContextDirEnvVar  = "GIT_CONTEXT_DIR"
+)
+
+// kubernetesBuildManager handles builds on vanilla Kubernetes clusters where BuildConfigs are not available.
+// Each build is executed as a Job: an init container builds the application with the Kogito builder image
+// and an in-cluster image builder packs the result on top of the Kogito runtime image and pushes it to the configured registry.
+// The build definition is kept in a ConfigMap, any change on it starts a new build.
+type kubernetesBuildManager struct {
+	buildManager
+}
+
+func (m *kubernetesBuildManager) GetRequestedResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	if len(m.build.GetSpec().GetRegistry().GetURL()) == 0 {
+		return resources, fmt.Errorf("%s: %s", errorPrefix, "registry URL is required to build Kogito services on Kubernetes")
+	}
+	definition, err := m.newBuildDefinition()
+	if err != nil {
+		return resources, err
+	}
+	if err := framework.SetOwner(m.build, m.Scheme, definition); err != nil {
+		return resources, err
+	}
+	resources[reflect.TypeOf(corev1.ConfigMap{})] = []client.Object{definition}
+	registryAuth, err := m.newRegistryAuth()
+	if err != nil {
+		return resources, err
+	}
+	if registryAuth != nil {
+		if err := framework.SetOwner(m.build, m.Scheme, registryAuth); err != nil {
+			return resources, err
+		}
+		resources[reflect.TypeOf(corev1.Secret{})] = []client.Object{registryAuth}
+	}
+	return resources, nil
+}
+
+func (m *kubernetesBuildManager) GetDeployedResources() (map[reflect.Type][]client.Object, error) {
+	resources, err := kubernetes.ResourceC(m.Client).ListAll([]client.ObjectList{&corev1.ConfigMapList{}, &corev1.SecretList{}}, m.build.GetNamespace(), m.build)
+	if err != nil {
+		return nil, err
+	}
+	// uploaded files are also owned by the build, but they're not part of the build definition
+	var definitions []client.Object
+	for _, cm := range resources[reflect.TypeOf(corev1.ConfigMap{})] {
+		if cm.GetName() == getBuildDefinitionName(m.build) {
+			definitions = append(definitions, cm)
+		}
+	}
+	resources[reflect.TypeOf(corev1.ConfigMap{})] = definitions
+	var registryAuths []client.Object
+	for _, secret := range resources[reflect.TypeOf(corev1.Secret{})] {
+		if secret.GetName() == getRegistryAuthName(m.build) {
+			registryAuths = append(registryAuths, secret)
+		}
+	}
+	resources[reflect.TypeOf(corev1.Secret{})] = registryAuths
+	return resources, nil
+}
+
+func (m *kubernetesBuildManager) GetComparator() compare.MapComparator {
+	resourceComparator := compare.DefaultComparator()
+	resourceComparator.SetComparator(
+		framework.NewComparatorBuilder().
+			WithType(reflect.TypeOf(corev1.ConfigMap{})).
+			UseDefaultComparator().
+			WithCustomComparator(createBuildDefinitionComparator()).
+			Build())
+	resourceComparator.SetComparator(
+		framework.NewComparatorBuilder().
+			WithType(reflect.TypeOf(corev1.Secret{})).
+			UseDefaultComparator().
+			Build())
+	return compare.MapComparator{Comparator: resourceComparator}
+}
+
+// OnResourceChange starts a new build whenever the build definition is created or changed.
+// Changes on the registry credentials don't start a new build, they're read by the next one.
+// Builds from uploaded files only start once the CLI has uploaded them.
+func (m *kubernetesBuildManager) OnResourceChange(resourceType reflect.Type, created []client.Object, updated []client.Object) error {
+	if resourceType != reflect.TypeOf(corev1.ConfigMap{}) {
+		return nil
+	}
+	if m.build.GetSpec().GetType() != api.RemoteSourceBuildType {
+		uploaded, err := m.hasUploadedFiles()
+		if err != nil || !uploaded {
+			return err
+		}
+	}
+	m.Log.Info("Changes detected for build definition, starting again", "Build", m.build.GetName())
+	_, err := m.startNewBuild(false)
+	return err
+}
+
+// getBuilds lists the Jobs created for the managed KogitoBuild
+func (m *kubernetesBuildManager) getBuilds() ([]buildRun, error) {
+	jobs := &batchv1.JobList{}
+	if err := kubernetes.ResourceC(m.Client).ListWithNamespaceAndLabel(m.build.GetNamespace(), jobs, getBuildLabels(m.build)); err != nil {
+		return nil, err
+	}
+	var runs []buildRun
+	for _, job := range jobs.Items {
+		phase, message := getJobBuildPhase(&job)
+		runs = append(runs, buildRun{
+			name:              job.Name,
+			phase:             phase,
+			message:           message,
+			creationTimestamp: job.CreationTimestamp,
+		})
+	}
+	return runs, nil
+}
+
+// startNewBuild cancels any running build for the managed KogitoBuild and creates a new Job to build it again.
+// With streamedUpload, the Job waits for the files streamed by the CLI instead of reading the upload ConfigMap.
+func (m *kubernetesBuildManager) startNewBuild(streamedUpload bool) (*batchv1.Job, error) {
+	jobs := &batchv1.JobList{}
+	if err := kubernetes.ResourceC(m.Client).ListWithNamespaceAndLabel(m.build.GetNamespace(), jobs, map[string]string{BuildConfigLabelSelector: m.build.GetName()}); err != nil {
+		return nil, err
+	}
+	lastBuildNumber := 0
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		if number, err := strconv.Atoi(job.Annotations[buildNumberAnnotation]); err == nil && number > lastBuildNumber {
+			lastBuildNumber = number
+		}
+		if err := m.cancelBuild(job); err != nil {
+			return nil, err
+		}
+	}
+	job, err := m.newBuildJob(lastBuildNumber+1, streamedUpload)
+	if err != nil {
+		return nil, err
+	}
+	if err := kubernetes.ResourceC(m.Client).Create(job); err != nil {
+		return nil, err
+	}
+	m.Log.Info("Build triggered", "build name", job.Name)
+	return job, nil
+}
+
+// cancelBuild suspends the given Job if it's still running, marking it as cancelled
+func (m *kubernetesBuildManager) cancelBuild(job *batchv1.Job) error {
+	if phase, _ := getJobBuildPhase(job); phase != buildv1.BuildPhaseNew &&
+		phase != buildv1.BuildPhasePending &&
+		phase != buildv1.BuildPhaseRunning {
+		return nil
+	}
+	suspend := true
+	job.Spec.Suspend = &suspend
+	if job.Annotations == nil {
+		job.Annotations = map[string]string{}
+	}
+	job.Annotations[buildCancelledAnnotation] = "true"
+	if err := kubernetes.ResourceC(m.Client).Update(job); err != nil {
+		m.Log.Error(err, "Failed to cancel", "Build", job.Name)
+		return err
+	}
+	m.Log.Info("Successfully cancelled", "Build", job.Name, "Namespace", job.Namespace)
+	return nil
+}
+
+// uploadFile stores the given file content in the upload ConfigMap read by the builds from local sources or binaries
+func (m *kubernetesBuildManager) uploadFile(fileName string, content []byte) error {
+	if len(fileName) == 0 {
+		fileName = defaultUploadFileName
+	}
+	upload := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getBuildUploadName(m.build),
+			Namespace: m.build.GetNamespace(),
+			Labels:    getBuildLabels(m.build),
+		},
+	}
+	exists, err := kubernetes.ResourceC(m.Client).Fetch(upload)
+	if err != nil {
+		return err
+	}
+	// only the last uploaded file is kept
+	upload.Data = nil
+	upload.BinaryData = map[string][]byte{fileName: content}
+	if exists {
+		return kubernetes.ResourceC(m.Client).Update(upload)
+	}
+	return kubernetes.ResourceC(m.Client).CreateForOwner(upload, m.build, m.Scheme)
+}
+
+func (m *kubernetesBuildManager) hasUploadedFiles() (bool, error) {
+	upload := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: getBuildUploadName(m.build), Namespace: m.build.GetNamespace()}}
+	return kubernetes.ResourceC(m.Client).Fetch(upload)
+}
+
+// removeUploadedFiles deletes the upload ConfigMap, so changes on the build definition don't build again files older than the streamed ones
+func (m *kubernetesBuildManager) removeUploadedFiles() error {
+	upload := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: getBuildUploadName(m.build), Namespace: m.build.GetNamespace()}}
+	if exists, err := kubernetes.ResourceC(m.Client).Fetch(upload); err != nil || !exists {
+		return err
+	}
+	return kubernetes.ResourceC(m.Client).Delete(upload)
+}
+
+// getUploadReceiverPod gets the pod of the given build Job once it's ready to receive the streamed files, nil if it's not ready yet
+func (m *kubernetesBuildManager) getUploadReceiverPod(job *batchv1.Job) (*corev1.Pod, error) {
+	pods := &corev1.PodList{}
+	if err := kubernetes.ResourceC(m.Client).ListWithNamespaceAndLabel(job.Namespace, pods, map[string]string{jobNameLabel: job.Name}); err != nil {
+		return nil, err
+	}
+	for i := range pods.Items {
+		for _, status := range pods.Items[i].Status.InitContainerStatuses {
+			if status.Name == uploadReceiverContainerName && status.State.Running != nil {
+				return &pods.Items[i], nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// newBuildDefinition creates the ConfigMap holding the Dockerfile used to build the final image and the hash of the build pod spec
+func (m *kubernetesBuildManager) newBuildDefinition() (*corev1.ConfigMap, error) {
+	specHash, err := getPodSpecHash(m.newBuildPodSpec())
+	if err != nil {
+		return nil, err
+	}
+	definition := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getBuildDefinitionName(m.build),
+			Namespace: m.build.GetNamespace(),
+			Labels:    getBuildLabels(m.build),
+			Annotations: map[string]string{
+				framework.KogitoOperatorVersionAnnotation: m.Context.Version,
+				buildSpecHashAnnotation:                   specHash,
+			},
+		},
+		Data: map[string]string{
+			dockerfileKey: m.newDockerfile(),
+		},
+	}
+	util.AppendToStringMap(m.Labels, definition.Labels)
+	return definition, nil
+}
+
+// newDockerfile creates the Dockerfile that copies the built application on top of the Kogito runtime image
+func (m *kubernetesBuildManager) newDockerfile() string {
+	return fmt.Sprintf("FROM %s\nCOPY --chown=1001:0 bin/ %s/\n", m.resolveImage(false), runnerSourcePath)
+}
+
+// newBuildJob creates a new Job to run the build with the given number
+func (m *kubernetesBuildManager) newBuildJob(number int, streamedUpload bool) (*batchv1.Job, error) {
+	podSpec := m.newBuildPodSpec()
+	specHash, err := getPodSpecHash(podSpec)
+	if err != nil {
+		return nil, err
+	}
+	// the spec hash is taken before, the way the files are uploaded doesn't change the build definition
+	if streamedUpload {
+		setStreamedUpload(&podSpec)
+	}
+	labels := getBuildLabels(m.build)
+	labels[BuildConfigLabelSelector] = m.build.GetName()
+	util.AppendToStringMap(m.Labels, labels)
+	backoffLimit := int32(0)
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      strings.Join([]string{m.build.GetName(), strconv.Itoa(number)}, "-"),
+			Namespace: m.build.GetNamespace(),
+			Labels:    labels,
+			Annotations: map[string]string{
+				framework.KogitoOperatorVersionAnnotation: m.Context.Version,
+				buildNumberAnnotation:                     strconv.Itoa(number),
+				buildSpecHashAnnotation:                   specHash,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec:       podSpec,
+			},
+		},
+	}
+	if streamedUpload {
+		job.Annotations[streamedUploadAnnotation] = "true"
+	}
+	if err := framework.SetOwner(m.build, m.Scheme, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// setStreamedUpload replaces the upload ConfigMap of the given build pod with a volume filled by the CLI,
+// adding an init container that waits until all the files are there
+func setStreamedUpload(podSpec *corev1.PodSpec) {
+	for i := range podSpec.Volumes {
+		if podSpec.Volumes[i].Name == uploadVolumeName {
+			podSpec.Volumes[i].VolumeSource = corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}
+		}
+	}
+	receiver := corev1.Container{
+		Name:         uploadReceiverContainerName,
+		Image:        podSpec.InitContainers[0].Image,
+		Command:      []string{"/bin/bash", "-c"},
+		Args:         []string{uploadReceiverScript},
+		VolumeMounts: []corev1.VolumeMount{{Name: uploadVolumeName, MountPath: uploadPath}},
+	}
+	podSpec.InitContainers = append([]corev1.Container{receiver}, podSpec.InitContainers...)
+}
+
+// newBuildPodSpec creates the pod that builds the application and then the final image for the managed KogitoBuild
+func (m *kubernetesBuildManager) newBuildPodSpec() corev1.PodSpec {
+	workspaceMount := corev1.VolumeMount{Name: workspaceVolumeName, MountPath: workspacePath}
+	builder := corev1.Container{
+		Name:         builderContainerName,
+		Image:        m.resolveImage(true),
+		Command:      []string{"/bin/bash", "-c"},
+		Resources:    m.build.GetSpec().GetResources(),
+		VolumeMounts: []corev1.VolumeMount{workspaceMount},
+	}
+	volumes := []corev1.Volume{
+		{Name: workspaceVolumeName, VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+		{
+			Name: definitionVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: getBuildDefinitionName(m.build)},
+				},
+			},
+		},
+	}
+
+	decoratorHandler := NewDecoratorHandler(m.Context)
+	switch m.build.GetSpec().GetType() {
+	case api.RemoteSourceBuildType:
+		builder.Args = []string{gitSourceScript}
+		builder.Env = framework.EnvOverride(decoratorHandler.getSourceBuilderEnvs(m.build),
+			corev1.EnvVar{Name: gitURIEnvVar, Value: m.build.GetSpec().GetGitSource().GetURI()},
+			corev1.EnvVar{Name: gitReferenceEnvVar, Value: m.build.GetSpec().GetGitSource().GetReference()},
+			corev1.EnvVar{Name: gitContextDirEnvVar, Value: strings.TrimSuffix(m.build.GetSpec().GetGitSource().GetContextDir(), "/")})
+	case api.LocalSourceBuildType:
+		builder.Args = []string{uploadSourceScript}
+		builder.Env = decoratorHandler.getSourceBuilderEnvs(m.build)
+	default:
+		builder.Args = []string{uploadSourceScript}
+		builder.Env = framework.EnvOverride(m.build.GetSpec().GetEnv(), corev1.EnvVar{Name: binaryBuildEnvVar, Value: "true"})
+	}
+	if m.build.GetSpec().GetType() != api.RemoteSourceBuildType {
+		builder.VolumeMounts = append(builder.VolumeMounts, corev1.VolumeMount{Name: uploadVolumeName, MountPath: uploadPath, ReadOnly: true})
+		volumes = append(volumes, corev1.Volume{
+			Name: uploadVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: getBuildUploadName(m.build)},
+				},
+			},
+		})
+	}
+
+	registry := m.build.GetSpec().GetRegistry()
+	imageBuilder := corev1.Container{
+		Name:  imageBuilderContainerName,
+		Image: getImageBuilder(),
+		Args: []string{
+			"--dockerfile=" + definitionPath + "/" + dockerfileKey,
+			"--context=dir://" + workspacePath,
+			"--destination=" + getOutputImage(m.build),
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			workspaceMount,
+			{Name: definitionVolumeName, MountPath: definitionPath, ReadOnly: true},
+		},
+	}
+	if registry.IsInsecure() {
+		imageBuilder.Args = append(imageBuilder.Args, "--insecure", "--skip-tls-verify")
+	}
+	// the image builder reads a single registry configuration, merged from the push secret and the base image pull secrets
+	if len(registry.GetSecret()) > 0 || len(m.build.GetSpec().GetImagePullSecrets()) > 0 {
+		imageBuilder.VolumeMounts = append(imageBuilder.VolumeMounts, corev1.VolumeMount{Name: pushSecretVolumeName, MountPath: pushSecretPath, ReadOnly: true})
+		volumes = append(volumes, corev1.Volume{
+			Name: pushSecretVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: getRegistryAuthName(m.build),
+					Items:      []corev1.KeyToPath{{Key: corev1.DockerConfigJsonKey, Path: "config.json"}},
+				},
+			},
+		})
+	}
+
+	return corev1.PodSpec{
+		RestartPolicy:      corev1.RestartPolicyNever,
+		ServiceAccountName: m.build.GetSpec().GetServiceAccountName(),
+		ImagePullSecrets:   m.build.GetSpec().GetImagePullSecrets(),
+		InitContainers:     []corev1.Container{builder},
+		Containers:         []corev1.Container{imageBuilder},
+		Volumes:            volumes,
+	}
+}
+
+// newRegistryAuth creates the Secret with the registry configuration read by the image builder.
+// The auths of the base image pull secrets are merged with the ones of the push secret, which wins for the same registry.
+func (m *kubernetesBuildManager) newRegistryAuth() (*corev1.Secret, error) {
+	var secretNames []string
+	for _, pullSecret := range m.build.GetSpec().GetImagePullSecrets() {
+		secretNames = append(secretNames, pullSecret.Name)
+	}
+	if pushSecret := m.build.GetSpec().GetRegistry().GetSecret(); len(pushSecret) > 0 {
+		secretNames = append(secretNames, pushSecret)
+	}
+	if len(secretNames) == 0 {
+		return nil, nil
+	}
+	secretHandler := infrastructure.NewSecretHandler(m.Context)
+	auths := make(map[string]json.RawMessage)
+	for _, secretName := range secretNames {
+		secret, err := secretHandler.FetchSecret(types.NamespacedName{Name: secretName, Namespace: m.build.GetNamespace()})
+		if err != nil {
+			return nil, err
+		}
+		if secret == nil {
+			m.Log.Info("Registry secret not found, the image builder won't use it", "Secret", secretName)
+			continue
+		}
+		secretAuths, err := getRegistryAuths(secret)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid registry secret %s: %v", errorPrefix, secretName, err)
+		}
+		for registryURL, auth := range secretAuths {
+			auths[registryURL] = auth
+		}
+	}
+	config, err := json.Marshal(map[string]map[string]json.RawMessage{"auths": auths})
+	if err != nil {
+		return nil, err
+	}
+	registryAuth := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getRegistryAuthName(m.build),
+			Namespace: m.build.GetNamespace(),
+			Labels:    getBuildLabels(m.build),
+		},
+		Type: corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{corev1.DockerConfigJsonKey: config},
+	}
+	util.AppendToStringMap(m.Labels, registryAuth.Labels)
+	return registryAuth, nil
+}
+
+// resolveImage resolves the full name of the image used to build the application, the runtime image for binary builds
+func (m *kubernetesBuildManager) resolveImage(isBuilder bool) string {
+	if isBuilder && m.build.GetSpec().GetType() == api.BinaryBuildType {
+		isBuilder = false
+	}
+	imageStreamHandler := NewImageSteamHandler(m.Context)
+	return strings.Join([]string{
+		resolveKogitoImageRegistryNamespace(m.build, isBuilder),
+		imageStreamHandler.ResolveKogitoImageNameTag(m.build, isBuilder),
+	}, "/")
+}
+
+// getJobBuildPhase translates the Job state to the build phases shared with OpenShift builds
+func getJobBuildPhase(job *batchv1.Job) (phase buildv1.BuildPhase, message string) {
+	if job.Annotations[buildCancelledAnnotation] == "true" {
+		return buildv1.BuildPhaseCancelled, "Build cancelled by a newer build"
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return buildv1.BuildPhaseComplete, condition.Message
+		case batchv1.JobFailed:
+			return buildv1.BuildPhaseFailed, condition.Message
+		}
+	}
+	if job.Status.Active > 0 {
+		return buildv1.BuildPhaseRunning, ""
+	}
+	if job.Status.StartTime != nil {
+		return buildv1.BuildPhasePending, ""
+	}
+	return buildv1.BuildPhaseNew, ""
+}
+
+// createBuildDefinitionComparator compares the build definition ConfigMaps, including the hash of the build pod spec
+func createBuildDefinitionComparator() func(deployed client.Object, requested client.Object) bool {
+	configMapComparator := framework.CreateConfigMapComparator()
+	return func(deployed client.Object, requested client.Object) bool {
+		if deployed.GetAnnotations()[buildSpecHashAnnotation] != requested.GetAnnotations()[buildSpecHashAnnotation] {
+			return false
+		}
+		return configMapComparator(deployed, requested)
+	}
+}
+
+// getRegistryAuths reads the auths of a docker registry Secret, either in the config.json or in the legacy .dockercfg format
+func getRegistryAuths(secret *corev1.Secret) (map[string]json.RawMessage, error) {
+	if config, ok := secret.Data[corev1.DockerConfigJsonKey]; ok {
+		dockerConfig := struct {
+			Auths map[string]json.RawMessage `json:"auths"`
+		}{}
+		if err := json.Unmarshal(config, &dockerConfig); err != nil {
+			return nil, err
+		}
+		return dockerConfig.Auths, nil
+	}
+	auths := make(map[string]json.RawMessage)
+	if config, ok := secret.Data[corev1.DockerConfigKey]; ok {
+		if err := json.Unmarshal(config, &auths); err != nil {
+			return nil, err
+		}
+	}
+	return auths, nil
+}
+
+func getPodSpecHash(podSpec corev1.PodSpec) (string, error) {
+	// the env order is significant for the pod, but not the order of the volumes
+	sort.SliceStable(podSpec.Volumes, func(i, j int) bool {
+		return podSpec.Volumes[i].Name < podSpec.Volumes[j].Name
+	})
+	content, err := json.Marshal(podSpec)
+	if err != nil {
+		return "", err
+	}
+	return util.GenerateMD5Hash(map[string]string{"podSpec": string(content)}), nil
+}
+
+// getOutputImage gets the final image name pushed by the Kubernetes builds, e.g. quay.io/mynamespace/my-service:latest
+func getOutputImage(build api.KogitoBuildInterface) string {
+	return strings.Join([]string{
+		strings.TrimSuffix(build.GetSpec().GetRegistry().GetURL(), "/"),
+		strings.Join([]string{GetApplicationName(build), tagLatest}, ":"),
+	}, "/")
+}
+
+// getImageBuilder gets the image of the in-cluster image builder
+func getImageBuilder() string {
+	imageBuilder := os.Getenv(kogitoImageBuilderEnvVar)
+	if len(imageBuilder) == 0 {
+		imageBuilder = defaultImageBuilder
+	}
+	return imageBuilder
+}
+
+// getBuildDefinitionName gets the name of the ConfigMap holding the build definition on Kubernetes
+func getBuildDefinitionName(build api.KogitoBuildInterface) string {
+	return strings.Join([]string{build.GetName(), buildDefinitionSuffix}, "")
+}
+
+// getRegistryAuthName gets the name of the Secret holding the registry configuration read by the image builder on Kubernetes
+func getRegistryAuthName(build api.KogitoBuildInterface) string {
+	return strings.Join([]string{build.GetName(), registryAuthSuffix}, "")
+}
+
+// getBuildUploadName gets the name of the ConfigMap holding the files uploaded by the CLI on Kubernetes
+func getBuildUploadName(build api.KogitoBuildInterface) string {
+	return strings.Join([]string{build.GetName(), buildUploadSuffix}, "")
+}
@@ -0,0 +1,312 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitobuild
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	app2 "github.com/kiegroup/kogito-operator/internal/app"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/kiegroup/kogito-operator/version/app"
+	buildv1 "github.com/openshift/api/build/v1"
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestKubernetesBuildManager_RequiresRegistry(t *testing.T) {
+	build := &v1beta1.KogitoBuild{
+		ObjectMeta: metav1.ObjectMeta{Name: "quarkus-example", Namespace: t.Name()},
+		Spec: v1beta1.KogitoBuildSpec{
+			Runtime: api.QuarkusRuntimeType,
+			Type:    api.BinaryBuildType,
+		},
+	}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(build).Build()
+	context := operator.Context{
+		Client:  cli,
+		Log:     test.TestLogger,
+		Scheme:  meta.GetRegisteredSchema(),
+		Version: app.Version,
+	}
+	manager := newBuildManager(context, build, app2.NewKogitoBuildHandler(context))
+	_, err := manager.GetRequestedResources()
+	assert.Error(t, err)
+}
+
+func TestKubernetesBuildManager_WhenBuildingFromRemoteSource(t *testing.T) {
+	build := &v1beta1.KogitoBuild{
+		ObjectMeta: metav1.ObjectMeta{Name: "quarkus-example", Namespace: t.Name()},
+		Spec: v1beta1.KogitoBuildSpec{
+			Runtime: api.QuarkusRuntimeType,
+			Type:    api.RemoteSourceBuildType,
+			GitSource: v1beta1.GitSource{
+				URI:       "http://myrepo.com/namespace/project",
+				Reference: "main",
+			},
+			Registry: v1beta1.ImageRegistry{
+				URL:      "registry.local:5000/kogito",
+				Secret:   "push-secret",
+				Insecure: true,
+			},
+			ServiceAccountName: "builder",
+			ImagePullSecrets:   []corev1.LocalObjectReference{{Name: "pull-secret"}},
+		},
+	}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(build).Build()
+	context := operator.Context{
+		Client:  cli,
+		Log:     test.TestLogger,
+		Scheme:  meta.GetRegisteredSchema(),
+		Version: app.Version,
+	}
+	manager := newBuildManager(context, build, app2.NewKogitoBuildHandler(context))
+	assert.IsType(t, &kubernetesBuildManager{}, manager)
+
+	resources, err := manager.GetRequestedResources()
+	assert.NoError(t, err)
+	assert.Len(t, resources[reflect.TypeOf(corev1.ConfigMap{})], 1)
+	definition := resources[reflect.TypeOf(corev1.ConfigMap{})][0].(*corev1.ConfigMap)
+	assert.Equal(t, "quarkus-example-build", definition.Name)
+	assert.Contains(t, definition.Data[dockerfileKey], "FROM "+infrastructure.GetDefaultImageRegistry()+"/"+GetDefaultRuntimeJVMImage())
+	assert.NotEmpty(t, definition.Annotations[buildSpecHashAnnotation])
+
+	// the definition has just been created, a new build should start
+	assert.NoError(t, manager.OnResourceChange(reflect.TypeOf(corev1.ConfigMap{}), []client.Object{definition}, nil))
+	jobs := &batchv1.JobList{}
+	assert.NoError(t, kubernetes.ResourceC(cli).ListWithNamespace(t.Name(), jobs))
+	assert.Len(t, jobs.Items, 1)
+	job := jobs.Items[0]
+	assert.Equal(t, "quarkus-example-1", job.Name)
+	assert.Equal(t, definition.Annotations[buildSpecHashAnnotation], job.Annotations[buildSpecHashAnnotation])
+	podSpec := job.Spec.Template.Spec
+	assert.Len(t, podSpec.InitContainers, 1)
+	assert.Contains(t, podSpec.InitContainers[0].Image, GetDefaultBuilderImage())
+	assert.Contains(t, podSpec.InitContainers[0].Env, corev1.EnvVar{Name: gitURIEnvVar, Value: "http://myrepo.com/namespace/project"})
+	assert.Contains(t, podSpec.InitContainers[0].Env, corev1.EnvVar{Name: gitReferenceEnvVar, Value: "main"})
+	assert.Len(t, podSpec.Containers, 1)
+	assert.Contains(t, podSpec.Containers[0].Args, "--destination=registry.local:5000/kogito/quarkus-example:latest")
+	assert.Contains(t, podSpec.Containers[0].Args, "--insecure")
+	assert.Len(t, podSpec.Volumes, 3)
+	assert.Equal(t, "builder", podSpec.ServiceAccountName)
+	assert.Equal(t, []corev1.LocalObjectReference{{Name: "pull-secret"}}, podSpec.ImagePullSecrets)
+
+	builds, err := manager.getBuilds()
+	assert.NoError(t, err)
+	assert.Len(t, builds, 1)
+	assert.Equal(t, buildv1.BuildPhaseNew, builds[0].phase)
+}
+
+func TestKubernetesBuildManager_MergesRegistryCredentials(t *testing.T) {
+	build := &v1beta1.KogitoBuild{
+		ObjectMeta: metav1.ObjectMeta{Name: "quarkus-example", Namespace: t.Name()},
+		Spec: v1beta1.KogitoBuildSpec{
+			Runtime: api.QuarkusRuntimeType,
+			Type:    api.BinaryBuildType,
+			Registry: v1beta1.ImageRegistry{
+				URL:    "registry.local:5000/kogito",
+				Secret: "push-secret",
+			},
+			ImagePullSecrets: []corev1.LocalObjectReference{{Name: "pull-secret"}, {Name: "legacy-pull-secret"}, {Name: "missing-secret"}},
+		},
+	}
+	pushSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "push-secret", Namespace: t.Name()},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			corev1.DockerConfigJsonKey: []byte(`{"auths":{"registry.local:5000":{"auth":"cHVzaDpwdXNo"}}}`),
+		},
+	}
+	pullSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "pull-secret", Namespace: t.Name()},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			corev1.DockerConfigJsonKey: []byte(`{"auths":{"quay.io":{"auth":"cHVsbDpwdWxs"},"registry.local:5000":{"auth":"b2xkOm9sZA=="}}}`),
+		},
+	}
+	legacyPullSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "legacy-pull-secret", Namespace: t.Name()},
+		Type:       corev1.SecretTypeDockercfg,
+		Data: map[string][]byte{
+			corev1.DockerConfigKey: []byte(`{"registry.redhat.io":{"auth":"cmg6cmg="}}`),
+		},
+	}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(build, pushSecret, pullSecret, legacyPullSecret).Build()
+	context := operator.Context{
+		Client:  cli,
+		Log:     test.TestLogger,
+		Scheme:  meta.GetRegisteredSchema(),
+		Version: app.Version,
+	}
+	manager := newBuildManager(context, build, app2.NewKogitoBuildHandler(context)).(*kubernetesBuildManager)
+
+	resources, err := manager.GetRequestedResources()
+	assert.NoError(t, err)
+	assert.Len(t, resources[reflect.TypeOf(corev1.Secret{})], 1)
+	registryAuth := resources[reflect.TypeOf(corev1.Secret{})][0].(*corev1.Secret)
+	assert.Equal(t, "quarkus-example-registry-auth", registryAuth.Name)
+	assert.Equal(t, corev1.SecretTypeDockerConfigJson, registryAuth.Type)
+	// the push secret wins for the registry found in both secrets
+	assert.JSONEq(t,
+		`{"auths":{"quay.io":{"auth":"cHVsbDpwdWxs"},"registry.local:5000":{"auth":"cHVzaDpwdXNo"},"registry.redhat.io":{"auth":"cmg6cmg="}}}`,
+		string(registryAuth.Data[corev1.DockerConfigJsonKey]))
+
+	podSpec := manager.newBuildPodSpec()
+	assert.Contains(t, podSpec.Volumes, corev1.Volume{
+		Name: pushSecretVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: "quarkus-example-registry-auth",
+				Items:      []corev1.KeyToPath{{Key: corev1.DockerConfigJsonKey, Path: "config.json"}},
+			},
+		},
+	})
+}
+
+func TestKubernetesBuildManager_NewBuildCancelsRunningOnes(t *testing.T) {
+	build := &v1beta1.KogitoBuild{
+		ObjectMeta: metav1.ObjectMeta{Name: "quarkus-example", Namespace: t.Name()},
+		Spec: v1beta1.KogitoBuildSpec{
+			Runtime:  api.QuarkusRuntimeType,
+			Type:     api.BinaryBuildType,
+			Registry: v1beta1.ImageRegistry{URL: "quay.io/kogito"},
+		},
+	}
+	running := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "quarkus-example-1",
+			Namespace:   t.Name(),
+			Labels:      map[string]string{BuildConfigLabelSelector: build.Name},
+			Annotations: map[string]string{buildNumberAnnotation: "1"},
+		},
+		Status: batchv1.JobStatus{Active: 1},
+	}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(build, running).Build()
+	context := operator.Context{
+		Client:  cli,
+		Log:     test.TestLogger,
+		Scheme:  meta.GetRegisteredSchema(),
+		Version: app.Version,
+	}
+	buildHandler := newBuildHandlerWithBCRetries(context, 1, 0, app2.NewKogitoBuildHandler(context))
+	newBuild, err := buildHandler.TriggerBuildFromFile(t.Name(), bytes.NewReader([]byte("binaries")), &buildv1.BinaryBuildRequestOptions{ObjectMeta: metav1.ObjectMeta{Name: build.Name}, AsFile: "app.jar"}, true, meta.GetRegisteredSchema())
+	assert.NoError(t, err)
+	assert.Equal(t, "quarkus-example-2", newBuild.Name)
+
+	upload := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "quarkus-example-upload", Namespace: t.Name()}}
+	test.AssertFetchMustExist(t, cli, upload)
+	assert.Equal(t, []byte("binaries"), upload.BinaryData["app.jar"])
+
+	test.AssertFetchMustExist(t, cli, running)
+	phase, _ := getJobBuildPhase(running)
+	assert.Equal(t, buildv1.BuildPhaseCancelled, phase)
+	assert.True(t, *running.Spec.Suspend)
+}
+
+func TestKubernetesBuildManager_StreamsBigUploads(t *testing.T) {
+	build := &v1beta1.KogitoBuild{
+		ObjectMeta: metav1.ObjectMeta{Name: "quarkus-example", Namespace: t.Name()},
+		Spec: v1beta1.KogitoBuildSpec{
+			Runtime:  api.QuarkusRuntimeType,
+			Type:     api.BinaryBuildType,
+			Registry: v1beta1.ImageRegistry{URL: "quay.io/kogito"},
+		},
+	}
+	staleUpload := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "quarkus-example-upload", Namespace: t.Name()},
+		BinaryData: map[string][]byte{"app.jar": []byte("old binaries")},
+	}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(build, staleUpload).Build()
+	context := operator.Context{
+		Client:  cli,
+		Log:     test.TestLogger,
+		Scheme:  meta.GetRegisteredSchema(),
+		Version: app.Version,
+	}
+	buildHandler := newBuildHandlerWithBCRetries(context, 1, 0, app2.NewKogitoBuildHandler(context))
+	content := bytes.NewReader(make([]byte, maxConfigMapUploadSize+1))
+	// no pod is started by the fake client, the build waits for the files forever
+	_, err := buildHandler.TriggerBuildFromFile(t.Name(), content, &buildv1.BinaryBuildRequestOptions{ObjectMeta: metav1.ObjectMeta{Name: build.Name}, AsFile: "app.jar"}, true, meta.GetRegisteredSchema())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not ready to receive the files")
+
+	exists, err := kubernetes.ResourceC(cli).Fetch(staleUpload)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "quarkus-example-1", Namespace: t.Name()}}
+	test.AssertFetchMustExist(t, cli, job)
+	assert.Equal(t, "true", job.Annotations[streamedUploadAnnotation])
+	podSpec := job.Spec.Template.Spec
+	assert.Len(t, podSpec.InitContainers, 2)
+	assert.Equal(t, uploadReceiverContainerName, podSpec.InitContainers[0].Name)
+	assert.Equal(t, builderContainerName, podSpec.InitContainers[1].Name)
+	for _, volume := range podSpec.Volumes {
+		if volume.Name == uploadVolumeName {
+			assert.NotNil(t, volume.EmptyDir)
+			assert.Nil(t, volume.ConfigMap)
+		}
+	}
+
+	manager := &kubernetesBuildManager{buildManager{build: build, Context: context}}
+	pod, err := manager.getUploadReceiverPod(job)
+	assert.NoError(t, err)
+	assert.Nil(t, pod)
+	receiverPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "quarkus-example-1-abcde", Namespace: t.Name(), Labels: map[string]string{jobNameLabel: job.Name}},
+		Status: corev1.PodStatus{InitContainerStatuses: []corev1.ContainerStatus{
+			{Name: uploadReceiverContainerName, State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+		}},
+	}
+	assert.NoError(t, kubernetes.ResourceC(cli).Create(receiverPod))
+	pod, err = manager.getUploadReceiverPod(job)
+	assert.NoError(t, err)
+	assert.Equal(t, receiverPod.Name, pod.Name)
+}
+
+func Test_getJobBuildPhase(t *testing.T) {
+	now := metav1.Now()
+	tests := []struct {
+		name string
+		job  *batchv1.Job
+		want buildv1.BuildPhase
+	}{
+		{"New", &batchv1.Job{}, buildv1.BuildPhaseNew},
+		{"Pending", &batchv1.Job{Status: batchv1.JobStatus{StartTime: &now}}, buildv1.BuildPhasePending},
+		{"Running", &batchv1.Job{Status: batchv1.JobStatus{StartTime: &now, Active: 1}}, buildv1.BuildPhaseRunning},
+		{"Complete", &batchv1.Job{Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}}}, buildv1.BuildPhaseComplete},
+		{"Failed", &batchv1.Job{Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}}}}, buildv1.BuildPhaseFailed},
+		{"Cancelled", &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{buildCancelledAnnotation: "true"}}, Status: batchv1.JobStatus{Active: 1}}, buildv1.BuildPhaseCancelled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			phase, _ := getJobBuildPhase(tt.job)
+			assert.Equal(t, tt.want, phase)
+		})
+	}
+}
@@ -20,14 +20,12 @@ package kogitobuild
 import (
 	"github.com/kiegroup/kogito-operator/apis"
 	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
-	"github.com/kiegroup/kogito-operator/core/framework"
 	"github.com/kiegroup/kogito-operator/core/manager"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	buildv1 "github.com/openshift/api/build/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sort"
-	"strings"
 )
 
 var (
@@ -123,25 +121,17 @@ func (s *statusHandler) setFailed(conditions *[]metav1.Condition, reason api.Kog
 }
 
 func (s *statusHandler) handleConditionTransition(instance api.KogitoBuildInterface) error {
-	err := s.updateBuildsStatus(instance)
+	builds, err := newBuildManager(s.Context, instance, s.buildHandler).getBuilds()
 	if err != nil {
 		return err
 	}
-	builds := &buildv1.BuildList{}
-	err = kubernetes.ResourceC(s.Client).ListWithNamespaceAndLabel(
-		instance.GetNamespace(), builds,
-		map[string]string{
-			framework.LabelAppKey: GetApplicationName(instance),
-			LabelKeyBuildType:     string(instance.GetSpec().GetType())})
-	if err != nil {
-		return err
-	}
-	if len(builds.Items) > 0 {
-		sort.SliceStable(builds.Items, func(i, j int) bool {
-			return builds.Items[i].CreationTimestamp.After(builds.Items[j].CreationTimestamp.Time)
+	instance.GetStatus().SetBuilds(s.newBuildsStatus(builds))
+	if len(builds) > 0 {
+		sort.SliceStable(builds, func(i, j int) bool {
+			return builds[i].creationTimestamp.After(builds[j].creationTimestamp.Time)
 		})
-		latestBuild := builds.Items[0]
-		instance.GetStatus().SetLatestBuild(latestBuild.Name)
+		latestBuild := builds[0]
+		instance.GetStatus().SetLatestBuild(latestBuild.name)
 		s.addCondition(latestBuild, instance.GetStatus().GetConditions())
 		return nil
 	}
@@ -149,26 +139,38 @@ func (s *statusHandler) handleConditionTransition(instance api.KogitoBuildInterf
 	return nil
 }
 
-func (s *statusHandler) updateBuildsStatus(instance api.KogitoBuildInterface) (err error) {
-	buildConfig := NewBuildHandler(s.Context, s.buildHandler)
-	buildsStatus, err := buildConfig.GetBuildsStatusByLabel(
-		instance.GetNamespace(),
-		strings.Join([]string{
-			strings.Join([]string{framework.LabelAppKey, GetApplicationName(instance)}, "="),
-			strings.Join([]string{LabelKeyBuildType, string(instance.GetSpec().GetType())}, "="),
-		}, ","))
-	if err != nil {
-		return err
+// newBuildsStatus groups the given builds by their phase
+func (s *statusHandler) newBuildsStatus(builds []buildRun) api.BuildsInterface {
+	status := s.buildHandler.CreateBuild()
+	for _, build := range builds {
+		s.Log.Debug("Build status", "build name", build.name, "phase", build.phase)
+		switch build.phase {
+		case buildv1.BuildPhaseNew:
+			status.SetNew(append(status.GetNew(), build.name))
+		case buildv1.BuildPhasePending:
+			status.SetPending(append(status.GetPending(), build.name))
+		case buildv1.BuildPhaseRunning:
+			status.SetRunning(append(status.GetRunning(), build.name))
+		case buildv1.BuildPhaseComplete:
+			status.SetComplete(append(status.GetComplete(), build.name))
+		case buildv1.BuildPhaseFailed:
+			status.SetFailed(append(status.GetFailed(), build.name))
+		case buildv1.BuildPhaseError:
+			status.SetError(append(status.GetError(), build.name))
+		case buildv1.BuildPhaseCancelled:
+			status.SetCancelled(append(status.GetCancelled(), build.name))
+		default:
+			status.SetNew(append(status.GetNew(), build.name))
+		}
 	}
-	instance.GetStatus().SetBuilds(buildsStatus)
-	return nil
+	return status
 }
 
-func (s *statusHandler) addCondition(build buildv1.Build, conditions *[]metav1.Condition) {
-	conditionReason := buildConditionReason[build.Status.Phase]
-	switch build.Status.Phase {
+func (s *statusHandler) addCondition(build buildRun, conditions *[]metav1.Condition) {
+	conditionReason := buildConditionReason[build.phase]
+	switch build.phase {
 	case buildv1.BuildPhaseFailed, buildv1.BuildPhaseCancelled:
-		s.setFailedConditions(conditions, conditionReason, build.Status.Message)
+		s.setFailedConditions(conditions, conditionReason, build.message)
 	case buildv1.BuildPhaseNew, buildv1.BuildPhasePending, buildv1.BuildPhaseRunning:
 		s.setRunningConditions(conditions, conditionReason)
 	case buildv1.BuildPhaseComplete:
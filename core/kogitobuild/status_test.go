@@ -156,7 +156,7 @@ func TestStatusChangeWhenBuildsAreRunning(t *testing.T) {
 	k8sObjs = append(k8sObjs, instance)
 
 	// recreating the Client with our objects to make sure that the BCs will be there
-	cli = test.NewFakeClientBuilder().AddK8sObjects(k8sObjs...).AddBuildObjects(buildObjs...).Build()
+	cli = test.NewFakeClientBuilder().AddK8sObjects(k8sObjs...).AddBuildObjects(buildObjs...).OnOpenShift().Build()
 	err = nil
 	context1 := operator.Context{
 		Client: cli,
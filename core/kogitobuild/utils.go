@@ -22,7 +22,8 @@ import (
 	"strings"
 
 	api "github.com/kiegroup/kogito-operator/apis"
-	buildv1 "github.com/openshift/api/build/v1"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	corev1 "k8s.io/api/core/v1"
 )
 
 const (
@@ -43,18 +44,26 @@ func GetBuildBuilderName(build api.KogitoBuildInterface) string {
 	return strings.Join([]string{build.GetName(), builderSuffix}, "")
 }
 
-// getBuilderLimitsAsIntString gets the string representation for the resource limits defined in the given bc
-func getBuilderLimitsAsIntString(bc *buildv1.BuildConfig) (limitCPU, limitMemory string) {
-	if bc.Spec.Resources.Limits == nil {
+// getBuildLabels gets the labels shared by all the resources and builds created for the given KogitoBuild
+func getBuildLabels(build api.KogitoBuildInterface) map[string]string {
+	return map[string]string{
+		framework.LabelAppKey: GetApplicationName(build),
+		LabelKeyBuildType:     string(build.GetSpec().GetType()),
+	}
+}
+
+// getBuilderLimitsAsIntString gets the string representation for the resource limits defined for the builder
+func getBuilderLimitsAsIntString(resources corev1.ResourceRequirements) (limitCPU, limitMemory string) {
+	if resources.Limits == nil {
 		return "", ""
 	}
-	limitMemoryInt, possible := bc.Spec.Resources.Limits.Memory().AsInt64()
+	limitMemoryInt, possible := resources.Limits.Memory().AsInt64()
 	if !possible {
-		limitMemoryInt = bc.Spec.Resources.Limits.Memory().ToDec().AsDec().UnscaledBig().Int64()
+		limitMemoryInt = resources.Limits.Memory().ToDec().AsDec().UnscaledBig().Int64()
 	}
 	if limitMemoryInt > 0 {
 		limitMemory = strconv.FormatInt(limitMemoryInt, 10)
 	}
-	limitCPU = bc.Spec.Resources.Limits.Cpu().String()
+	limitCPU = resources.Limits.Cpu().String()
 	return limitCPU, limitMemory
 }
@@ -0,0 +1,64 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitobuild
+
+import (
+	"github.com/kiegroup/kogito-operator/apis"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// SetDefaults sets the default values for the given KogitoBuild
+func SetDefaults(build api.KogitoBuildInterface) {
+	if len(build.GetSpec().GetRuntime()) == 0 {
+		build.GetSpec().SetRuntime(api.QuarkusRuntimeType)
+	}
+}
+
+// Validate verifies the spec attributes for the given KogitoBuild instance
+func Validate(build api.KogitoBuildInterface) field.ErrorList {
+	var errs field.ErrorList
+	specPath := field.NewPath("spec")
+	switch build.GetSpec().GetType() {
+	case "":
+		errs = append(errs, field.Required(specPath.Child("type"), "build Type is required"))
+	case api.RemoteSourceBuildType:
+		if len(build.GetSpec().GetGitSource().GetURI()) == 0 {
+			errs = append(errs, field.Required(specPath.Child("gitSource", "uri"), "Git URL is required when build type is "+string(api.RemoteSourceBuildType)))
+		}
+	case api.LocalSourceBuildType, api.BinaryBuildType:
+	default:
+		errs = append(errs, field.NotSupported(specPath.Child("type"), build.GetSpec().GetType(),
+			[]string{string(api.BinaryBuildType), string(api.LocalSourceBuildType), string(api.RemoteSourceBuildType)}))
+	}
+	switch build.GetSpec().GetRuntime() {
+	case "", api.QuarkusRuntimeType:
+	case api.SpringBootRuntimeType:
+		if build.GetSpec().IsNative() {
+			errs = append(errs, field.Invalid(specPath.Child("native"), true, "native builds are only supported by the "+string(api.QuarkusRuntimeType)+" runtime"))
+		}
+	default:
+		errs = append(errs, field.NotSupported(specPath.Child("runtime"), build.GetSpec().GetRuntime(),
+			[]string{string(api.QuarkusRuntimeType), string(api.SpringBootRuntimeType)}))
+	}
+	for i, pullSecret := range build.GetSpec().GetImagePullSecrets() {
+		if len(pullSecret.Name) == 0 {
+			errs = append(errs, field.Required(specPath.Child("imagePullSecrets").Index(i).Child("name"), ""))
+		}
+	}
+	return errs
+}
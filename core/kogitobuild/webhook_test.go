@@ -0,0 +1,58 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitobuild
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSetDefaults(t *testing.T) {
+	build := &v1beta1.KogitoBuild{ObjectMeta: v1.ObjectMeta{Name: "quarkus-example", Namespace: t.Name()}}
+	SetDefaults(build)
+	assert.Equal(t, api.QuarkusRuntimeType, build.GetSpec().GetRuntime())
+
+	build.Spec.Runtime = api.SpringBootRuntimeType
+	SetDefaults(build)
+	assert.Equal(t, api.SpringBootRuntimeType, build.GetSpec().GetRuntime())
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		spec   v1beta1.KogitoBuildSpec
+		errors int
+	}{
+		{"MissingType", v1beta1.KogitoBuildSpec{}, 1},
+		{"UnsupportedType", v1beta1.KogitoBuildSpec{Type: "Unknown"}, 1},
+		{"RemoteSourceWithoutURI", v1beta1.KogitoBuildSpec{Type: api.RemoteSourceBuildType}, 1},
+		{"RemoteSource", v1beta1.KogitoBuildSpec{Type: api.RemoteSourceBuildType, GitSource: v1beta1.GitSource{URI: "https://github.com/kiegroup/kogito-examples"}}, 0},
+		{"Binary", v1beta1.KogitoBuildSpec{Type: api.BinaryBuildType}, 0},
+		{"NativeSpringBoot", v1beta1.KogitoBuildSpec{Type: api.BinaryBuildType, Runtime: api.SpringBootRuntimeType, Native: true}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			build := &v1beta1.KogitoBuild{ObjectMeta: v1.ObjectMeta{Name: "example", Namespace: t.Name()}, Spec: tt.spec}
+			assert.Len(t, Validate(build), tt.errors)
+		})
+	}
+}
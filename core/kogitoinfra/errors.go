@@ -20,6 +20,7 @@ package kogitoinfra
 import (
 	"fmt"
 	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework/util"
 )
 
 // reconciliationError type for KogitoInfra reconciliation cycle cases.
@@ -76,6 +77,14 @@ func errorForResourceConfigError(instance api.KogitoInfraInterface, errorMsg str
 	}
 }
 
+func errorForNamespaceNotWatched(instance api.KogitoInfraInterface, namespace string) reconciliationError {
+	return reconciliationError{
+		Reason: api.ResourceNamespaceNotWatched,
+		innerError: fmt.Errorf("%s resource(%s) is in namespace %s, which is not watched by the operator. Add it to the %s environment variable of the operator or deploy the resource in one of the watched namespaces: %v",
+			instance.GetSpec().GetResource().GetKind(), instance.GetSpec().GetResource().GetName(), namespace, util.WatchNamespaceEnv, util.GetWatchNamespaces()),
+	}
+}
+
 func errorForResourceNotReadyError(err error) reconciliationError {
 	return reconciliationError{
 		Reason:     api.ResourceNotReady,
@@ -20,9 +20,16 @@ package kogitoinfra
 import (
 	"fmt"
 	"github.com/kiegroup/kogito-operator/apis"
+	kogitocli "github.com/kiegroup/kogito-operator/core/client"
+	kafkaclient "github.com/kiegroup/kogito-operator/core/client/kafka"
 	"github.com/kiegroup/kogito-operator/core/infrastructure"
 	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
-	"sigs.k8s.io/controller-runtime/pkg/builder"
+	v12 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 	"sort"
 	"strings"
 	"time"
@@ -30,9 +37,72 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 )
 
-// AppendKafkaWatchedObjects ...
-func AppendKafkaWatchedObjects(b *builder.Builder) *builder.Builder {
-	return b
+const (
+	// KafkaListenerInfraPropertyKey is the name of the Strimzi listener the services connect to, the plain listener is used when not given
+	KafkaListenerInfraPropertyKey = "listener"
+	// KafkaAuthenticationInfraPropertyKey is the authentication of the services, either "tls" or "scram-sha-512"
+	KafkaAuthenticationInfraPropertyKey = "authentication"
+	// KafkaUserInfraPropertyKey references an existing KafkaUser shared by the services, instead of creating one for each service
+	KafkaUserInfraPropertyKey = "kafka-user"
+
+	kafkaSslSecurityProtocol           = "SSL"
+	kafkaSaslSslSecurityProtocol       = "SASL_SSL"
+	kafkaSaslPlaintextSecurityProtocol = "SASL_PLAINTEXT"
+	kafkaPlaintextSecurityProtocol     = "PLAINTEXT"
+
+	// appPropKafkaSecurityProtocol application property for setting the kafka security protocol
+	appPropKafkaSecurityProtocol int = iota
+	// appPropKafkaSaslMechanism application property for setting the kafka SASL mechanism
+	appPropKafkaSaslMechanism
+	// appPropKafkaSaslJaasConfig application property for setting the kafka SASL credentials
+	appPropKafkaSaslJaasConfig
+	appPropKafkaTrustStoreLocation
+	appPropKafkaTrustStoreType
+	appPropKafkaKeyStoreLocation
+	appPropKafkaKeyStoreType
+	appPropKafkaKeyStorePassword
+	appPropKafkaGroupID
+)
+
+var (
+	//Kafka security variables for the KogitoInfra deployed infrastructure.
+	//For Quarkus: https://quarkus.io/guides/kafka#kafka-configuration
+	//For Spring: https://docs.spring.io/spring-boot/docs/current/reference/html/application-properties.html#appendix.application-properties.integration
+
+	propertiesKafka = map[api.RuntimeType]map[int]string{
+		api.QuarkusRuntimeType: {
+			appPropKafkaSecurityProtocol:   "kafka.security.protocol",
+			appPropKafkaSaslMechanism:      "kafka.sasl.mechanism",
+			appPropKafkaSaslJaasConfig:     "kafka.sasl.jaas.config",
+			appPropKafkaTrustStoreLocation: "kafka.ssl.truststore.location",
+			appPropKafkaTrustStoreType:     "kafka.ssl.truststore.type",
+			appPropKafkaKeyStoreLocation:   "kafka.ssl.keystore.location",
+			appPropKafkaKeyStoreType:       "kafka.ssl.keystore.type",
+			appPropKafkaKeyStorePassword:   "kafka.ssl.keystore.password",
+			appPropKafkaGroupID:            "kafka.group.id",
+		},
+		api.SpringBootRuntimeType: {
+			appPropKafkaSecurityProtocol:   "spring.kafka.security.protocol",
+			appPropKafkaSaslMechanism:      "spring.kafka.properties.sasl.mechanism",
+			appPropKafkaSaslJaasConfig:     "spring.kafka.properties.sasl.jaas.config",
+			appPropKafkaTrustStoreLocation: "spring.kafka.ssl.trust-store-location",
+			appPropKafkaTrustStoreType:     "spring.kafka.ssl.trust-store-type",
+			appPropKafkaKeyStoreLocation:   "spring.kafka.ssl.key-store-location",
+			appPropKafkaKeyStoreType:       "spring.kafka.ssl.key-store-type",
+			appPropKafkaKeyStorePassword:   "spring.kafka.ssl.key-store-password",
+			appPropKafkaGroupID:            "spring.kafka.consumer.group-id",
+		},
+	}
+)
+
+// AppendKafkaWatchedObjects watches the Strimzi Kafka instances referenced by the KogitoInfras of the given type, so their changes trigger a reconciliation.
+// Strimzi might not be installed, so the watch is added to the built controller once the Kafka API is available.
+func AppendKafkaWatchedObjects(c controller.Controller, cli *kogitocli.Client, scheme *runtime.Scheme, infraType client.Object) error {
+	mapper, err := newReferencedResourceMapper(cli, scheme, infraType, infrastructure.KafkaKind)
+	if err != nil {
+		return err
+	}
+	return c.Watch(&source.Kind{Type: &v1beta2.Kafka{}}, handler.EnqueueRequestsFromMapFunc(mapper))
 }
 
 func initKafkaInfraReconciler(context infraContext) Reconciler {
@@ -51,6 +121,11 @@ type kafkaInfraReconciler struct {
 func (k *kafkaInfraReconciler) Reconcile() (resultErr error) {
 	var kafkaInstance *v1beta2.Kafka
 
+	if IsExternalKafka(k.instance) {
+		k.Log.Debug("Kafka bootstrap servers provided, skipping Strimzi")
+		return newExternalKafkaReconciler(k.infraContext).Reconcile()
+	}
+
 	// Verify kafka
 	kafkaHandler := infrastructure.NewKafkaHandler(k.Context)
 	if !kafkaHandler.IsStrimziAvailable() {
@@ -78,10 +153,27 @@ func (k *kafkaInfraReconciler) Reconcile() (resultErr error) {
 		return errorForResourceNotReadyError(fmt.Errorf("kafka instance %s not ready yet. Waiting for Condition status Ready", kafkaInstance.Name))
 	}
 
-	if resultErr = k.updateKafkaRuntimePropsInStatus(kafkaInstance, api.QuarkusRuntimeType); resultErr != nil {
+	listener := k.instance.GetSpec().GetInfraProperties()[KafkaListenerInfraPropertyKey]
+	tlsListener := kafkaHandler.IsKafkaListenerTLS(kafkaInstance, listener)
+	if tlsListener {
+		certificates, err := getKafkaCACertificates(infrastructure.NewSecretHandler(k.Context), kafkaInstance, listener)
+		if err != nil {
+			return err
+		}
+		if resultErr = newKafkaTrustStoreReconciler(k.infraContext, certificates).Reconcile(); resultErr != nil {
+			return resultErr
+		}
+	}
+
+	kafkaURI, resultErr := kafkaHandler.ResolveKafkaServerURI(kafkaInstance, listener)
+	if resultErr != nil {
+		return resultErr
+	}
+	securityProtocol, saslMechanism := getStrimziKafkaSecurity(tlsListener, GetKafkaAuthentication(k.instance))
+	if resultErr = k.updateKafkaRuntimePropsInStatus(kafkaURI, securityProtocol, saslMechanism, tlsListener, api.QuarkusRuntimeType); resultErr != nil {
 		return resultErr
 	}
-	return k.updateKafkaRuntimePropsInStatus(kafkaInstance, api.SpringBootRuntimeType)
+	return k.updateKafkaRuntimePropsInStatus(kafkaURI, securityProtocol, saslMechanism, tlsListener, api.SpringBootRuntimeType)
 }
 
 func (k *kafkaInfraReconciler) getLatestKafkaCondition(kafka *v1beta2.Kafka) *v1beta2.KafkaCondition {
@@ -119,8 +211,76 @@ func (k *kafkaInfraReconciler) mustParseKafkaTransition(transitionTime string) (
 	return &parsedTime, true
 }
 
-func (k *kafkaInfraReconciler) updateKafkaRuntimePropsInStatus(kafkaInstance *v1beta2.Kafka, runtime api.RuntimeType) error {
+func (k *kafkaInfraReconciler) updateKafkaRuntimePropsInStatus(kafkaURI, securityProtocol, saslMechanism string, trustStore bool, runtime api.RuntimeType) error {
 	k.Log.Debug("going to Update Kafka runtime properties in kogito infra instance status", "runtime", runtime)
-	kafkaConfigReconciler := newKafkaConfigReconciler(k.infraContext, kafkaInstance, runtime)
+	appProps := getKafkaAppProps(runtime, kafkaURI, getKafkaSecurityAppProps(runtime, securityProtocol, saslMechanism, trustStore))
+	kafkaConfigReconciler := newKafkaConfigReconciler(k.infraContext, runtime, appProps)
 	return kafkaConfigReconciler.Reconcile()
 }
+
+// GetKafkaAuthentication gets the authentication of the services bound to the given Kafka KogitoInfra, empty when they are not authenticated
+func GetKafkaAuthentication(instance api.KogitoInfraInterface) string {
+	return instance.GetSpec().GetInfraProperties()[KafkaAuthenticationInfraPropertyKey]
+}
+
+// getStrimziKafkaSecurity gets the security protocol and SASL mechanism of the given Strimzi listener and authentication
+func getStrimziKafkaSecurity(tlsListener bool, authentication string) (securityProtocol, saslMechanism string) {
+	encrypted := tlsListener || authentication == v1beta2.KafkaUserTLSAuthentication
+	scram := authentication == v1beta2.KafkaUserScramSha512Authentication
+	if scram {
+		saslMechanism = kafkaclient.SASLMechanismScramSha512
+	}
+	switch {
+	case encrypted && scram:
+		return kafkaSaslSslSecurityProtocol, saslMechanism
+	case encrypted:
+		return kafkaSslSecurityProtocol, saslMechanism
+	case scram:
+		return kafkaSaslPlaintextSecurityProtocol, saslMechanism
+	}
+	return "", ""
+}
+
+// getKafkaSecurityAppProps gets the properties for the security protocol of the services, shared by every service bound to the KogitoInfra
+func getKafkaSecurityAppProps(runtime api.RuntimeType, securityProtocol, saslMechanism string, trustStore bool) map[string]string {
+	appProps := map[string]string{}
+	// PLAINTEXT is the default of the clients
+	if len(securityProtocol) > 0 && securityProtocol != kafkaPlaintextSecurityProtocol {
+		appProps[propertiesKafka[runtime][appPropKafkaSecurityProtocol]] = securityProtocol
+	}
+	if len(saslMechanism) > 0 {
+		appProps[propertiesKafka[runtime][appPropKafkaSaslMechanism]] = saslMechanism
+	}
+	if trustStore {
+		appProps[propertiesKafka[runtime][appPropKafkaTrustStoreLocation]] = getKafkaFileLocation(runtime, kafkaTrustStoreMountPath)
+		appProps[propertiesKafka[runtime][appPropKafkaTrustStoreType]] = pemCertType
+	}
+	return appProps
+}
+
+// GetKafkaUserAppProps gets the properties holding the credentials of the given Strimzi KafkaUser secret.
+// The keystore of the TLS authentication is read from keyStoreMountPath.
+// The consumer group id is set when given, e.g. when the ACLs of the user only allow it.
+func GetKafkaUserAppProps(runtime api.RuntimeType, authentication string, userSecret *v12.Secret, keyStoreMountPath, groupID string) map[string][]byte {
+	appProps := map[string][]byte{}
+	if len(groupID) > 0 {
+		appProps[propertiesKafka[runtime][appPropKafkaGroupID]] = []byte(groupID)
+	}
+	switch authentication {
+	case v1beta2.KafkaUserScramSha512Authentication:
+		appProps[propertiesKafka[runtime][appPropKafkaSaslJaasConfig]] = userSecret.Data[KafkaUserSaslJaasConfigKey]
+	case v1beta2.KafkaUserTLSAuthentication:
+		appProps[propertiesKafka[runtime][appPropKafkaKeyStoreLocation]] = []byte(getKafkaFileLocation(runtime, keyStoreMountPath+"/"+KafkaUserKeyStoreKey))
+		appProps[propertiesKafka[runtime][appPropKafkaKeyStoreType]] = []byte(pkcs12CertType)
+		appProps[propertiesKafka[runtime][appPropKafkaKeyStorePassword]] = userSecret.Data[KafkaUserKeyStorePasswordKey]
+	}
+	return appProps
+}
+
+// getKafkaFileLocation Spring expects resource locations instead of file paths
+func getKafkaFileLocation(runtime api.RuntimeType, path string) string {
+	if runtime == api.SpringBootRuntimeType {
+		return "file:" + path
+	}
+	return path
+}
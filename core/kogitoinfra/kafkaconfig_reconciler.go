@@ -24,7 +24,6 @@ import (
 	api "github.com/kiegroup/kogito-operator/apis"
 	"github.com/kiegroup/kogito-operator/core/framework"
 	"github.com/kiegroup/kogito-operator/core/infrastructure"
-	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
 	v12 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -42,19 +41,17 @@ const (
 
 type kafkaConfigReconciler struct {
 	infraContext
-	kafkaInstance    *v1beta2.Kafka
 	runtime          api.RuntimeType
+	appProps         map[string]string
 	configMapHandler infrastructure.ConfigMapHandler
-	kafkaHandler     infrastructure.KafkaHandler
 }
 
-func newKafkaConfigReconciler(ctx infraContext, kafkaInstance *v1beta2.Kafka, runtime api.RuntimeType) Reconciler {
+func newKafkaConfigReconciler(ctx infraContext, runtime api.RuntimeType, appProps map[string]string) Reconciler {
 	return &kafkaConfigReconciler{
 		infraContext:     ctx,
-		kafkaInstance:    kafkaInstance,
 		runtime:          runtime,
+		appProps:         appProps,
 		configMapHandler: infrastructure.NewConfigMapHandler(ctx.Context),
-		kafkaHandler:     infrastructure.NewKafkaHandler(ctx.Context),
 	}
 }
 
@@ -83,11 +80,7 @@ func (k *kafkaConfigReconciler) Reconcile() (err error) {
 
 func (k *kafkaConfigReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
 	resources := make(map[reflect.Type][]client.Object)
-	appProps, err := k.getKafkaAppProps()
-	if err != nil {
-		return nil, err
-	}
-	configMap := k.createKafkaConfigMap(appProps)
+	configMap := k.createKafkaConfigMap(k.appProps)
 	if err := framework.SetOwner(k.instance, k.Scheme, configMap); err != nil {
 		return resources, err
 	}
@@ -115,23 +108,23 @@ func (k *kafkaConfigReconciler) processDelta(requestedResources map[reflect.Type
 	return err
 }
 
-func (k *kafkaConfigReconciler) getKafkaAppProps() (map[string]string, error) {
+// getKafkaAppProps gets the properties of the runtime connecting to the given bootstrap servers
+func getKafkaAppProps(runtime api.RuntimeType, kafkaURI string, securityAppProps map[string]string) map[string]string {
 	appProps := map[string]string{}
-	kafkaURI, err := k.kafkaHandler.ResolveKafkaServerURI(k.kafkaInstance)
-	if err != nil {
-		return nil, err
-	}
 	if len(kafkaURI) > 0 {
 		appProps[enableEventsEnvKey] = "true"
-		if k.runtime == api.QuarkusRuntimeType {
+		if runtime == api.QuarkusRuntimeType {
 			appProps[QuarkusKafkaBootstrapAppProp] = kafkaURI
-		} else if k.runtime == api.SpringBootRuntimeType {
+		} else if runtime == api.SpringBootRuntimeType {
 			appProps[springKafkaBootstrapAppProp] = kafkaURI
 		}
+		for key, value := range securityAppProps {
+			appProps[key] = value
+		}
 	} else {
 		appProps[enableEventsEnvKey] = "false"
 	}
-	return appProps, nil
+	return appProps
 }
 
 func (k *kafkaConfigReconciler) createKafkaConfigMap(appProps map[string]string) *v12.ConfigMap {
@@ -20,6 +20,8 @@ package kogitoinfra
 import (
 	"github.com/kiegroup/kogito-operator/apis"
 	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	"github.com/kiegroup/kogito-operator/core/test"
 	"github.com/kiegroup/kogito-operator/meta"
@@ -43,7 +45,7 @@ func TestKafkaConfigReconciler(t *testing.T) {
 		instance: kogitoKafkaInstance,
 	}
 
-	kafkaConfigReconciler := newKafkaConfigReconciler(infraContext, kafkaInstance, api.QuarkusRuntimeType)
+	kafkaConfigReconciler := newKafkaConfigReconciler(infraContext, api.QuarkusRuntimeType, getKafkaAppProps(api.QuarkusRuntimeType, infrastructure.ResolveKafkaServerURI(kafkaInstance), nil))
 	err := kafkaConfigReconciler.Reconcile()
 	assert.NoError(t, err)
 	assert.Equal(t, 1, len(kogitoKafkaInstance.GetStatus().GetConfigMapEnvFromReferences()))
@@ -61,3 +63,43 @@ func TestKafkaConfigReconciler(t *testing.T) {
 	assert.Equal(t, "true", kafkaConfigMap.Data[enableEventsEnvKey])
 	assert.True(t, len(kafkaConfigMap.Data["kafka.bootstrap.servers"]) > 0)
 }
+
+func TestKafkaConfigReconciler_TLSListenerWithScramAuthentication(t *testing.T) {
+	ns := t.Name()
+	kogitoKafkaInstance := test.CreateFakeKogitoKafka(ns)
+	kogitoKafkaInstance.GetSpec().AddInfraProperties(map[string]string{
+		KafkaListenerInfraPropertyKey:       "tls",
+		KafkaAuthenticationInfraPropertyKey: v1beta2.KafkaUserScramSha512Authentication,
+	})
+	kafkaInstance := test.CreateFakeKafka(ns)
+	kafkaInstance.Status.Listeners = append(kafkaInstance.Status.Listeners, v1beta2.ListenerStatus{Name: "tls", BootstrapServers: "kafka-host:9093"})
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kafkaInstance).Build()
+	infraContext := infraContext{
+		Context: operator.Context{
+			Client: cli,
+			Log:    test.TestLogger,
+			Scheme: meta.GetRegisteredSchema(),
+		},
+		instance: kogitoKafkaInstance,
+	}
+
+	kafkaInfraReconciler := &kafkaInfraReconciler{infraContext: infraContext}
+	securityProtocol, saslMechanism := getStrimziKafkaSecurity(true, v1beta2.KafkaUserScramSha512Authentication)
+	kafkaURI, err := infrastructure.NewKafkaHandler(infraContext.Context).ResolveKafkaServerURI(kafkaInstance, "tls")
+	assert.NoError(t, err)
+	assert.NoError(t, kafkaInfraReconciler.updateKafkaRuntimePropsInStatus(kafkaURI, securityProtocol, saslMechanism, true, api.SpringBootRuntimeType))
+	kafkaConfigMap := &v12.ConfigMap{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      GetKafkaConfigMapName(api.SpringBootRuntimeType),
+			Namespace: ns,
+		},
+	}
+	exist, err := kubernetes.ResourceC(cli).Fetch(kafkaConfigMap)
+	assert.True(t, exist)
+	assert.NoError(t, err)
+	assert.Equal(t, "kafka-host:9093", kafkaConfigMap.Data[springKafkaBootstrapAppProp])
+	assert.Equal(t, "SASL_SSL", kafkaConfigMap.Data["spring.kafka.security.protocol"])
+	assert.Equal(t, "SCRAM-SHA-512", kafkaConfigMap.Data["spring.kafka.properties.sasl.mechanism"])
+	assert.Equal(t, "file:"+kafkaTrustStoreMountPath, kafkaConfigMap.Data["spring.kafka.ssl.trust-store-location"])
+	assert.Equal(t, pemCertType, kafkaConfigMap.Data["spring.kafka.ssl.trust-store-type"])
+}
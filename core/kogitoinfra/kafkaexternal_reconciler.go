@@ -0,0 +1,237 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoinfra
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	kafkaclient "github.com/kiegroup/kogito-operator/core/client/kafka"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	v12 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// KafkaBootstrapServersInfraPropertyKey are the comma separated bootstrap servers of a Kafka cluster not managed by Strimzi
+	KafkaBootstrapServersInfraPropertyKey = "bootstrap-servers"
+	// KafkaSecurityProtocolInfraPropertyKey is the security protocol of the Kafka cluster not managed by Strimzi,
+	// SASL_SSL by default when credentials are given, PLAINTEXT otherwise
+	KafkaSecurityProtocolInfraPropertyKey = "security-protocol"
+	// KafkaSaslMechanismInfraPropertyKey is the SASL mechanism of the Kafka cluster not managed by Strimzi, SCRAM-SHA-512 by default
+	KafkaSaslMechanismInfraPropertyKey = "sasl-mechanism"
+
+	// kafkaCredentialsUsernameKey and kafkaCredentialsPasswordKey are the keys of the credentials secret referenced in the infra properties,
+	// it can also hold the CA certificate of the cluster with key ca.crt
+	kafkaCredentialsUsernameKey = "username"
+	kafkaCredentialsPasswordKey = "password"
+
+	kafkaCredentialsSecretName = "kogito-kafka-%s-credential"
+	kafkaJaasConfig            = "%s required username=\"%s\" password=\"%s\";"
+)
+
+var (
+	kafkaSecurityProtocols = []string{kafkaPlaintextSecurityProtocol, kafkaSslSecurityProtocol, kafkaSaslPlaintextSecurityProtocol, kafkaSaslSslSecurityProtocol}
+	kafkaSaslMechanisms    = []string{kafkaclient.SASLMechanismPlain, kafkaclient.SASLMechanismScramSha256, kafkaclient.SASLMechanismScramSha512}
+	kafkaLoginModules      = map[string]string{
+		kafkaclient.SASLMechanismPlain:       "org.apache.kafka.common.security.plain.PlainLoginModule",
+		kafkaclient.SASLMechanismScramSha256: "org.apache.kafka.common.security.scram.ScramLoginModule",
+		kafkaclient.SASLMechanismScramSha512: "org.apache.kafka.common.security.scram.ScramLoginModule",
+	}
+)
+
+// IsExternalKafka checks if the given Kafka KogitoInfra references a cluster by its bootstrap servers, instead of a Strimzi Kafka
+func IsExternalKafka(instance api.KogitoInfraInterface) bool {
+	return len(instance.GetSpec().GetInfraProperties()[KafkaBootstrapServersInfraPropertyKey]) > 0
+}
+
+// externalKafkaReconciler publishes the configuration of a Kafka cluster not managed by Strimzi, the topics are created by the services through the Admin API
+type externalKafkaReconciler struct {
+	infraContext
+	secretHandler infrastructure.SecretHandler
+}
+
+func newExternalKafkaReconciler(context infraContext) Reconciler {
+	return &externalKafkaReconciler{
+		infraContext:  context,
+		secretHandler: infrastructure.NewSecretHandler(context.Context),
+	}
+}
+
+func (k *externalKafkaReconciler) Reconcile() error {
+	credentials, err := fetchExternalKafkaCredentials(k.Context, k.instance)
+	if err != nil {
+		return err
+	}
+	securityProtocol, saslMechanism := getExternalKafkaSecurity(k.instance, credentials != nil)
+	tlsEnabled := securityProtocol == kafkaSslSecurityProtocol || securityProtocol == kafkaSaslSslSecurityProtocol
+	sasl := securityProtocol == kafkaSaslPlaintextSecurityProtocol || securityProtocol == kafkaSaslSslSecurityProtocol
+
+	trustStore := false
+	if credentials != nil && tlsEnabled {
+		if certificates := credentials.Data[kafkaTrustStoreSecretKey]; len(certificates) > 0 {
+			trustStore = true
+			if err = newKafkaTrustStoreReconciler(k.infraContext, string(certificates)).Reconcile(); err != nil {
+				return err
+			}
+		}
+	}
+	if sasl {
+		if credentials == nil {
+			return errorForMissingResourceConfig(k.instance, infraPropertiesCredentialsSecretKey)
+		}
+		if err = k.reconcileCredentials(credentials, saslMechanism); err != nil {
+			return err
+		}
+	}
+
+	kafkaURI := k.instance.GetSpec().GetInfraProperties()[KafkaBootstrapServersInfraPropertyKey]
+	for _, runtime := range []api.RuntimeType{api.QuarkusRuntimeType, api.SpringBootRuntimeType} {
+		appProps := getKafkaAppProps(runtime, kafkaURI, getKafkaSecurityAppProps(runtime, securityProtocol, saslMechanism, trustStore))
+		if err = newKafkaConfigReconciler(k.infraContext, runtime, appProps).Reconcile(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reconcileCredentials publishes the SASL credentials of every runtime to the services
+func (k *externalKafkaReconciler) reconcileCredentials(credentials *v12.Secret, saslMechanism string) error {
+	jaasConfig := getKafkaJaasConfig(saslMechanism, string(credentials.Data[kafkaCredentialsUsernameKey]), string(credentials.Data[kafkaCredentialsPasswordKey]))
+	secret := &v12.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      k.getCredentialsSecretName(),
+			Namespace: k.instance.GetNamespace(),
+			Labels: map[string]string{
+				framework.LabelAppKey: k.instance.GetName(),
+			},
+		},
+		Type: v12.SecretTypeOpaque,
+		Data: map[string][]byte{
+			propertiesKafka[api.QuarkusRuntimeType][appPropKafkaSaslJaasConfig]:    []byte(jaasConfig),
+			propertiesKafka[api.SpringBootRuntimeType][appPropKafkaSaslJaasConfig]: []byte(jaasConfig),
+		},
+	}
+	if err := framework.SetOwner(k.instance, k.Scheme, secret); err != nil {
+		return err
+	}
+	requestedResources := map[reflect.Type][]client.Object{reflect.TypeOf(v12.Secret{}): {secret}}
+
+	deployedResources := make(map[reflect.Type][]client.Object)
+	deployedSecret, err := k.secretHandler.FetchSecret(types.NamespacedName{Name: k.getCredentialsSecretName(), Namespace: k.instance.GetNamespace()})
+	if err != nil {
+		return err
+	}
+	if deployedSecret != nil {
+		deployedResources[reflect.TypeOf(v12.Secret{})] = []client.Object{deployedSecret}
+	}
+
+	deltaProcessor := infrastructure.NewDeltaProcessor(k.Context)
+	if _, err = deltaProcessor.ProcessDelta(k.secretHandler.GetComparator(), requestedResources, deployedResources); err != nil {
+		return err
+	}
+	k.instance.GetStatus().AddSecretEnvFromReferences(k.getCredentialsSecretName())
+	return nil
+}
+
+func (k *externalKafkaReconciler) getCredentialsSecretName() string {
+	return fmt.Sprintf(kafkaCredentialsSecretName, k.instance.GetName())
+}
+
+// fetchExternalKafkaCredentials fetches the secret referenced in the infra properties, nil if none is referenced
+func fetchExternalKafkaCredentials(context operator.Context, instance api.KogitoInfraInterface) (*v12.Secret, error) {
+	secretName := instance.GetSpec().GetInfraProperties()[infraPropertiesCredentialsSecretKey]
+	if len(secretName) == 0 {
+		return nil, nil
+	}
+	secret := &v12.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: instance.GetNamespace()}}
+	if exists, err := kubernetes.ResourceC(context.Client).Fetch(secret); err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, errorForResourceNotFound("Secret", secretName, instance.GetNamespace())
+	}
+	if len(secret.Data[kafkaCredentialsUsernameKey]) == 0 || len(secret.Data[kafkaCredentialsPasswordKey]) == 0 {
+		return nil, errorForResourceConfigError(instance, fmt.Sprintf("Secret %s must have the keys %s and %s", secretName, kafkaCredentialsUsernameKey, kafkaCredentialsPasswordKey))
+	}
+	return secret, nil
+}
+
+// getExternalKafkaSecurity gets the security protocol and SASL mechanism from the infra properties
+func getExternalKafkaSecurity(instance api.KogitoInfraInterface, hasCredentials bool) (securityProtocol, saslMechanism string) {
+	securityProtocol = instance.GetSpec().GetInfraProperties()[KafkaSecurityProtocolInfraPropertyKey]
+	if len(securityProtocol) == 0 {
+		securityProtocol = kafkaPlaintextSecurityProtocol
+		if hasCredentials {
+			securityProtocol = kafkaSaslSslSecurityProtocol
+		}
+	}
+	if securityProtocol == kafkaSaslPlaintextSecurityProtocol || securityProtocol == kafkaSaslSslSecurityProtocol {
+		saslMechanism = instance.GetSpec().GetInfraProperties()[KafkaSaslMechanismInfraPropertyKey]
+		if len(saslMechanism) == 0 {
+			saslMechanism = kafkaclient.SASLMechanismScramSha512
+		}
+	}
+	return
+}
+
+func getKafkaJaasConfig(saslMechanism, username, password string) string {
+	escaper := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	return fmt.Sprintf(kafkaJaasConfig, kafkaLoginModules[saslMechanism], escaper.Replace(username), escaper.Replace(password))
+}
+
+// NewExternalKafkaAdminConfig gets the connection of the Kafka Admin client to the cluster referenced by the given KogitoInfra
+func NewExternalKafkaAdminConfig(context operator.Context, instance api.KogitoInfraInterface) (*kafkaclient.Config, error) {
+	credentials, err := fetchExternalKafkaCredentials(context, instance)
+	if err != nil {
+		return nil, err
+	}
+	config := &kafkaclient.Config{
+		BootstrapServers: strings.Split(instance.GetSpec().GetInfraProperties()[KafkaBootstrapServersInfraPropertyKey], ","),
+	}
+	securityProtocol, saslMechanism := getExternalKafkaSecurity(instance, credentials != nil)
+	if securityProtocol == kafkaSslSecurityProtocol || securityProtocol == kafkaSaslSslSecurityProtocol {
+		config.TLS = &tls.Config{MinVersion: tls.VersionTLS12}
+		if credentials != nil && len(credentials.Data[kafkaTrustStoreSecretKey]) > 0 {
+			config.TLS.RootCAs = x509.NewCertPool()
+			if !config.TLS.RootCAs.AppendCertsFromPEM(credentials.Data[kafkaTrustStoreSecretKey]) {
+				return nil, errors.New("no valid PEM certificate found in the Kafka credentials secret")
+			}
+		}
+	}
+	if len(saslMechanism) > 0 {
+		if credentials == nil {
+			return nil, errorForMissingResourceConfig(instance, infraPropertiesCredentialsSecretKey)
+		}
+		config.SASL = &kafkaclient.SASLConfig{
+			Mechanism: saslMechanism,
+			Username:  string(credentials.Data[kafkaCredentialsUsernameKey]),
+			Password:  string(credentials.Data[kafkaCredentialsPasswordKey]),
+		}
+	}
+	return config, nil
+}
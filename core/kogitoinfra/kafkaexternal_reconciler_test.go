@@ -0,0 +1,135 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoinfra
+
+import (
+	"testing"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	kafkaclient "github.com/kiegroup/kogito-operator/core/client/kafka"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	v12 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestExternalKafkaReconciler_Plaintext(t *testing.T) {
+	ns := t.Name()
+	kogitoKafkaInstance := test.CreateFakeKogitoKafka(ns)
+	kogitoKafkaInstance.GetSpec().AddInfraProperties(map[string]string{KafkaBootstrapServersInfraPropertyKey: "localhost:9092"})
+	cli := test.NewFakeClientBuilder().Build()
+	infraContext := infraContext{
+		Context: operator.Context{
+			Client: cli,
+			Log:    test.TestLogger,
+			Scheme: meta.GetRegisteredSchema(),
+		},
+		instance: kogitoKafkaInstance,
+	}
+
+	assert.True(t, IsExternalKafka(kogitoKafkaInstance))
+	assert.NoError(t, initKafkaInfraReconciler(infraContext).Reconcile())
+	assert.Equal(t, 2, len(kogitoKafkaInstance.GetStatus().GetConfigMapEnvFromReferences()))
+	assert.Empty(t, kogitoKafkaInstance.GetStatus().GetSecretEnvFromReferences())
+
+	kafkaConfigMap := &v12.ConfigMap{ObjectMeta: v1.ObjectMeta{Name: GetKafkaConfigMapName(api.QuarkusRuntimeType), Namespace: ns}}
+	exist, err := kubernetes.ResourceC(cli).Fetch(kafkaConfigMap)
+	assert.NoError(t, err)
+	assert.True(t, exist)
+	assert.Equal(t, map[string]string{enableEventsEnvKey: "true", QuarkusKafkaBootstrapAppProp: "localhost:9092"}, kafkaConfigMap.Data)
+
+	config, err := NewExternalKafkaAdminConfig(infraContext.Context, kogitoKafkaInstance)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"localhost:9092"}, config.BootstrapServers)
+	assert.Nil(t, config.TLS)
+	assert.Nil(t, config.SASL)
+}
+
+func TestExternalKafkaReconciler_SaslSsl(t *testing.T) {
+	ns := t.Name()
+	kogitoKafkaInstance := test.CreateFakeKogitoKafka(ns)
+	kogitoKafkaInstance.GetSpec().AddInfraProperties(map[string]string{
+		KafkaBootstrapServersInfraPropertyKey: "broker-0:9093,broker-1:9093",
+		KafkaSaslMechanismInfraPropertyKey:    kafkaclient.SASLMechanismPlain,
+		infraPropertiesCredentialsSecretKey:   "kafka-credentials",
+	})
+	credentials := &v12.Secret{
+		ObjectMeta: v1.ObjectMeta{Name: "kafka-credentials", Namespace: ns},
+		Data: map[string][]byte{
+			kafkaCredentialsUsernameKey: []byte("kogito"),
+			kafkaCredentialsPasswordKey: []byte(`se"cret`),
+		},
+	}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(credentials).Build()
+	infraContext := infraContext{
+		Context: operator.Context{
+			Client: cli,
+			Log:    test.TestLogger,
+			Scheme: meta.GetRegisteredSchema(),
+		},
+		instance: kogitoKafkaInstance,
+	}
+
+	assert.NoError(t, newExternalKafkaReconciler(infraContext).Reconcile())
+	assert.Equal(t, []string{"kogito-kafka-" + kogitoKafkaInstance.GetName() + "-credential"}, kogitoKafkaInstance.GetStatus().GetSecretEnvFromReferences())
+	// the CA of the brokers is trusted by default when no certificate is given
+	assert.Empty(t, kogitoKafkaInstance.GetStatus().GetSecretVolumeReferences())
+
+	kafkaConfigMap := &v12.ConfigMap{ObjectMeta: v1.ObjectMeta{Name: GetKafkaConfigMapName(api.SpringBootRuntimeType), Namespace: ns}}
+	_, err := kubernetes.ResourceC(cli).Fetch(kafkaConfigMap)
+	assert.NoError(t, err)
+	assert.Equal(t, "broker-0:9093,broker-1:9093", kafkaConfigMap.Data[springKafkaBootstrapAppProp])
+	assert.Equal(t, kafkaSaslSslSecurityProtocol, kafkaConfigMap.Data["spring.kafka.security.protocol"])
+	assert.Equal(t, kafkaclient.SASLMechanismPlain, kafkaConfigMap.Data["spring.kafka.properties.sasl.mechanism"])
+
+	credentialsSecret := &v12.Secret{ObjectMeta: v1.ObjectMeta{Name: "kogito-kafka-" + kogitoKafkaInstance.GetName() + "-credential", Namespace: ns}}
+	_, err = kubernetes.ResourceC(cli).Fetch(credentialsSecret)
+	assert.NoError(t, err)
+	assert.Equal(t, `org.apache.kafka.common.security.plain.PlainLoginModule required username="kogito" password="se\"cret";`,
+		string(credentialsSecret.Data["kafka.sasl.jaas.config"]))
+
+	config, err := NewExternalKafkaAdminConfig(infraContext.Context, kogitoKafkaInstance)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"broker-0:9093", "broker-1:9093"}, config.BootstrapServers)
+	assert.NotNil(t, config.TLS)
+	assert.Equal(t, &kafkaclient.SASLConfig{Mechanism: kafkaclient.SASLMechanismPlain, Username: "kogito", Password: `se"cret`}, config.SASL)
+}
+
+func TestExternalKafkaReconciler_InvalidCredentials(t *testing.T) {
+	ns := t.Name()
+	kogitoKafkaInstance := test.CreateFakeKogitoKafka(ns)
+	kogitoKafkaInstance.GetSpec().AddInfraProperties(map[string]string{
+		KafkaBootstrapServersInfraPropertyKey: "localhost:9092",
+		infraPropertiesCredentialsSecretKey:   "kafka-credentials",
+	})
+	credentials := &v12.Secret{ObjectMeta: v1.ObjectMeta{Name: "kafka-credentials", Namespace: ns}, Data: map[string][]byte{kafkaCredentialsUsernameKey: []byte("kogito")}}
+	infraContext := infraContext{
+		Context: operator.Context{
+			Client: test.NewFakeClientBuilder().AddK8sObjects(credentials).Build(),
+			Log:    test.TestLogger,
+			Scheme: meta.GetRegisteredSchema(),
+		},
+		instance: kogitoKafkaInstance,
+	}
+	err := newExternalKafkaReconciler(infraContext).Reconcile()
+	assert.Error(t, err)
+	assert.Equal(t, api.ResourceConfigError, reasonForError(err))
+}
@@ -0,0 +1,139 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoinfra
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	v12 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	kafkaTrustStoreSecretName = "kogito-kafka-%s-truststore"
+	kafkaCertMountPath        = operator.KogitoHomeDir + "/certs/kafka"
+	kafkaTrustStoreSecretKey  = "ca.crt"
+	kafkaTrustStoreMountPath  = kafkaCertMountPath + "/" + kafkaTrustStoreSecretKey
+	// kafkaClusterCASecretName is the secret holding the cluster CA certificate created by Strimzi for each Kafka cluster
+	kafkaClusterCASecretName = "%s-cluster-ca-cert"
+	pemCertType              = "PEM"
+
+	// KafkaUserSaslJaasConfigKey is the key of the SCRAM-SHA-512 credentials in the secret created by Strimzi for a KafkaUser
+	KafkaUserSaslJaasConfigKey = "sasl.jaas.config"
+	// KafkaUserKeyStoreKey is the key of the TLS keystore in the secret created by Strimzi for a KafkaUser
+	KafkaUserKeyStoreKey = "user.p12"
+	// KafkaUserKeyStorePasswordKey is the key of the TLS keystore password in the secret created by Strimzi for a KafkaUser
+	KafkaUserKeyStorePasswordKey = "user.password"
+)
+
+// kafkaTrustStoreReconciler publishes the CA certificates of the Kafka cluster to the services, in PEM format
+type kafkaTrustStoreReconciler struct {
+	infraContext
+	certificates  string
+	secretHandler infrastructure.SecretHandler
+}
+
+func newKafkaTrustStoreReconciler(context infraContext, certificates string) Reconciler {
+	return &kafkaTrustStoreReconciler{
+		infraContext:  context,
+		certificates:  certificates,
+		secretHandler: infrastructure.NewSecretHandler(context.Context),
+	}
+}
+
+func (k *kafkaTrustStoreReconciler) Reconcile() (err error) {
+	// Create Required resource
+	requestedResources, err := k.createRequiredResources()
+	if err != nil {
+		return
+	}
+
+	// Get Deployed resource
+	deployedResources, err := k.getDeployedResources()
+	if err != nil {
+		return
+	}
+
+	// Process Delta
+	comparator := k.secretHandler.GetComparator()
+	deltaProcessor := infrastructure.NewDeltaProcessor(k.Context)
+	if _, err = deltaProcessor.ProcessDelta(comparator, requestedResources, deployedResources); err != nil {
+		return err
+	}
+
+	k.instance.GetStatus().AddSecretVolumeReference(k.getTrustStoreSecretName(), kafkaCertMountPath, &framework.ModeForCertificates, nil)
+	return nil
+}
+
+func (k *kafkaTrustStoreReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	secret := &v12.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      k.getTrustStoreSecretName(),
+			Namespace: k.instance.GetNamespace(),
+			Labels: map[string]string{
+				framework.LabelAppKey: k.instance.GetName(),
+			},
+		},
+		Type: v12.SecretTypeOpaque,
+		Data: map[string][]byte{
+			kafkaTrustStoreSecretKey: []byte(k.certificates),
+		},
+	}
+	if err := framework.SetOwner(k.instance, k.Scheme, secret); err != nil {
+		return resources, err
+	}
+	resources[reflect.TypeOf(v12.Secret{})] = []client.Object{secret}
+	return resources, nil
+}
+
+// getKafkaCACertificates reads the certificates from the Strimzi listener status, or from the cluster CA secret with older Strimzi versions
+func getKafkaCACertificates(secretHandler infrastructure.SecretHandler, kafkaInstance *v1beta2.Kafka, listener string) (string, error) {
+	if certificates := infrastructure.GetKafkaListenerCertificates(kafkaInstance, listener); len(certificates) > 0 {
+		return strings.Join(certificates, "\n"), nil
+	}
+	caSecret, err := secretHandler.MustFetchSecret(types.NamespacedName{Name: fmt.Sprintf(kafkaClusterCASecretName, kafkaInstance.Name), Namespace: kafkaInstance.Namespace})
+	if err != nil {
+		return "", err
+	}
+	return string(caSecret.Data[kafkaTrustStoreSecretKey]), nil
+}
+
+func (k *kafkaTrustStoreReconciler) getDeployedResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	deployedSecret, err := k.secretHandler.FetchSecret(types.NamespacedName{Name: k.getTrustStoreSecretName(), Namespace: k.instance.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	if deployedSecret != nil {
+		resources[reflect.TypeOf(v12.Secret{})] = []client.Object{deployedSecret}
+	}
+	return resources, nil
+}
+
+func (k *kafkaTrustStoreReconciler) getTrustStoreSecretName() string {
+	return fmt.Sprintf(kafkaTrustStoreSecretName, k.instance.GetName())
+}
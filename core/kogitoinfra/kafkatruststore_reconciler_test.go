@@ -0,0 +1,71 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoinfra
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	v12 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestKafkaTrustStoreReconciler_ClusterCASecret(t *testing.T) {
+	ns := t.Name()
+	kogitoKafkaInstance := test.CreateFakeKogitoKafka(ns)
+	kafkaInstance := test.CreateFakeKafka(ns)
+	caSecret := &v12.Secret{
+		ObjectMeta: v1.ObjectMeta{Name: "kogito-kafka-cluster-ca-cert", Namespace: ns},
+		Data:       map[string][]byte{kafkaTrustStoreSecretKey: []byte("cluster-ca")},
+	}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(caSecret).Build()
+	infraContext := infraContext{
+		Context: operator.Context{
+			Client: cli,
+			Log:    test.TestLogger,
+			Scheme: meta.GetRegisteredSchema(),
+		},
+		instance: kogitoKafkaInstance,
+	}
+
+	certificates, err := getKafkaCACertificates(infrastructure.NewSecretHandler(infraContext.Context), kafkaInstance, "tls")
+	assert.NoError(t, err)
+	assert.NoError(t, newKafkaTrustStoreReconciler(infraContext, certificates).Reconcile())
+	assert.Equal(t, 1, len(kogitoKafkaInstance.GetStatus().GetSecretVolumeReferences()))
+	assert.Equal(t, kafkaCertMountPath, kogitoKafkaInstance.GetStatus().GetSecretVolumeReferences()[0].GetMountPath())
+
+	trustStoreSecret := &v12.Secret{ObjectMeta: v1.ObjectMeta{Name: "kogito-kafka-" + kogitoKafkaInstance.GetName() + "-truststore", Namespace: ns}}
+	exist, err := kubernetes.ResourceC(cli).Fetch(trustStoreSecret)
+	assert.NoError(t, err)
+	assert.True(t, exist)
+	assert.Equal(t, "cluster-ca", string(trustStoreSecret.Data[kafkaTrustStoreSecretKey]))
+}
+
+func TestGetKafkaCACertificates_ListenerStatus(t *testing.T) {
+	kafkaInstance := test.CreateFakeKafka(t.Name())
+	kafkaInstance.Status.Listeners = append(kafkaInstance.Status.Listeners, v1beta2.ListenerStatus{Name: "tls", Certificates: []string{"first", "second"}})
+	certificates, err := getKafkaCACertificates(infrastructure.NewSecretHandler(operator.Context{Client: test.NewFakeClientBuilder().Build(), Log: test.TestLogger}), kafkaInstance, "tls")
+	assert.NoError(t, err)
+	assert.Equal(t, "first\nsecond", certificates)
+}
@@ -18,22 +18,75 @@
 package kogitoinfra
 
 import (
+	"fmt"
+
+	api "github.com/kiegroup/kogito-operator/apis"
 	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
 	"github.com/kiegroup/kogito-operator/core/infrastructure"
 	keycloakv1alpha1 "github.com/kiegroup/kogito-operator/core/infrastructure/keycloak/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 )
 
+const (
+	appPropKeycloakAuthServerURL        = iota // for Quarkus
+	appPropKeycloakClientID                    // for Quarkus
+	appPropKeycloakIssuerURI                   // for Spring Boot resource server
+	appPropKeycloakProviderIssuerURI           // for Spring Boot client
+	appPropKeycloakRegistrationClientID        // for Spring Boot client
+
+	envVarKeycloakClientSecret
+
+	// infraPropertiesKeycloakRealmKey name of the KeycloakRealm the services will authenticate against
+	infraPropertiesKeycloakRealmKey = "keycloak-realm"
+	// infraPropertiesKeycloakClientKey name of the KeycloakClient holding the credentials of the services, created by the operator if not set
+	infraPropertiesKeycloakClientKey = "keycloak-client"
+
+	keycloakClientNameSuffix = "-client"
+)
+
+var (
+	// Keycloak variables for the KogitoInfra deployed infrastructure.
+	//For Quarkus: https://quarkus.io/guides/security-openid-connect#configuration-reference
+	//For Spring: https://docs.spring.io/spring-security/reference/servlet/oauth2/index.html
+
+	propertiesKeycloak = map[api.RuntimeType]map[int]string{
+		api.QuarkusRuntimeType: {
+			appPropKeycloakAuthServerURL: "quarkus.oidc.auth-server-url",
+			appPropKeycloakClientID:      "quarkus.oidc.client-id",
+
+			envVarKeycloakClientSecret: "QUARKUS_OIDC_CREDENTIALS_SECRET",
+		},
+		api.SpringBootRuntimeType: {
+			appPropKeycloakIssuerURI:            "spring.security.oauth2.resourceserver.jwt.issuer-uri",
+			appPropKeycloakProviderIssuerURI:    "spring.security.oauth2.client.provider.keycloak.issuer-uri",
+			appPropKeycloakRegistrationClientID: "spring.security.oauth2.client.registration.keycloak.client-id",
+
+			envVarKeycloakClientSecret: "SPRING_SECURITY_OAUTH2_CLIENT_REGISTRATION_KEYCLOAK_CLIENT_SECRET",
+		},
+	}
+)
+
+// KeycloakConnection holds the information needed by a runtime to authenticate against a Keycloak realm
+type KeycloakConnection struct {
+	AuthServerURL string
+	ClientID      string
+	ClientSecret  string
+}
+
 // keycloakInfraReconciler implementation of KogitoInfraResource
 type keycloakInfraReconciler struct {
 	infraContext
+	keycloakHandler infrastructure.KeycloakHandler
 }
 
 func initkeycloakInfraReconciler(context infraContext) Reconciler {
 	context.Log = context.Log.WithValues("resource", "keycloak")
 	return &keycloakInfraReconciler{
-		infraContext: context,
+		infraContext:    context,
+		keycloakHandler: infrastructure.NewKeycloakHandler(context.Context),
 	}
 }
 
@@ -45,14 +98,13 @@ func AppendKeycloakWatchedObjects(b *builder.Builder) *builder.Builder {
 // Reconcile reconcile Kogito infra object
 func (k *keycloakInfraReconciler) Reconcile() (resultErr error) {
 	var keycloakInstance *keycloakv1alpha1.Keycloak
-	keycloakHandler := infrastructure.NewKeycloakHandler(k.Context)
-	if !keycloakHandler.IsKeycloakAvailable() {
+	if !k.keycloakHandler.IsKeycloakAvailable() {
 		return errorForResourceAPINotFound(k.instance.GetSpec().GetResource().GetAPIVersion())
 	}
 
+	namespace := k.instance.GetSpec().GetResource().GetNamespace()
 	if len(k.instance.GetSpec().GetResource().GetName()) > 0 {
 		k.Log.Debug("Custom Keycloak instance reference is provided")
-		namespace := k.instance.GetSpec().GetResource().GetNamespace()
 		if len(namespace) == 0 {
 			namespace = k.instance.GetNamespace()
 			k.Log.Debug("Namespace is not provided for custom resource, taking instance", "Namespace", namespace)
@@ -65,6 +117,50 @@ func (k *keycloakInfraReconciler) Reconcile() (resultErr error) {
 	} else {
 		return errorForResourceConfigError(k.instance, "No Keycloak resource name given")
 	}
+
+	// OIDC configuration is only injected when a realm is referenced
+	realmName := k.instance.GetSpec().GetInfraProperties()[infraPropertiesKeycloakRealmKey]
+	if len(realmName) == 0 {
+		k.Log.Debug("No KeycloakRealm reference is provided, skipping OIDC configuration")
+		return nil
+	}
+	if !keycloakInstance.Status.Ready {
+		return errorForResourceNotReadyError(fmt.Errorf("Keycloak instance %s not ready yet", keycloakInstance.Name))
+	}
+
+	realm, resultErr := k.keycloakHandler.FetchKeycloakRealm(types.NamespacedName{Name: realmName, Namespace: namespace})
+	if resultErr != nil {
+		return resultErr
+	} else if realm == nil {
+		return errorForResourceNotFound(infrastructure.KeycloakRealmKind, realmName, namespace)
+	} else if realm.Spec.Realm == nil {
+		return errorForResourceConfigError(k.instance, fmt.Sprintf("No realm found in the KeycloakRealm %s", realm.Name))
+	} else if !realm.Status.Ready {
+		return errorForResourceNotReadyError(fmt.Errorf("KeycloakRealm instance %s not ready yet", realm.Name))
+	}
+
+	keycloakClient, resultErr := k.getKeycloakClient(realm)
+	if resultErr != nil {
+		return resultErr
+	}
+
+	connection := &KeycloakConnection{
+		AuthServerURL: k.keycloakHandler.GetKeycloakRealmURL(keycloakInstance, realm),
+		ClientID:      keycloakClient.Spec.Client.ClientID,
+	}
+	if !keycloakClient.Spec.Client.PublicClient {
+		if connection.ClientSecret, resultErr = k.getKeycloakClientSecret(keycloakClient); resultErr != nil {
+			return resultErr
+		}
+	}
+
+	k.Log.Info("Keycloak realm is ready", "realm", realm.Spec.Realm.Realm, "client", connection.ClientID)
+	if resultErr = k.updateKeycloakRuntimePropsInStatus(connection, api.QuarkusRuntimeType); resultErr != nil {
+		return resultErr
+	}
+	if resultErr = k.updateKeycloakRuntimePropsInStatus(connection, api.SpringBootRuntimeType); resultErr != nil {
+		return resultErr
+	}
 	return nil
 }
 
@@ -82,3 +178,108 @@ func (k *keycloakInfraReconciler) loadDeployedKeycloakInstance(name string, name
 		return keycloakInstance, nil
 	}
 }
+
+// getKeycloakClient gets the KeycloakClient referenced in the infra properties, or creates one bound to the given realm
+func (k *keycloakInfraReconciler) getKeycloakClient(realm *keycloakv1alpha1.KeycloakRealm) (*keycloakv1alpha1.KeycloakClient, error) {
+	clientName := k.instance.GetSpec().GetInfraProperties()[infraPropertiesKeycloakClientKey]
+	if len(clientName) == 0 {
+		clientName = k.instance.GetName() + keycloakClientNameSuffix
+	}
+	keycloakClient, err := k.keycloakHandler.FetchKeycloakClient(types.NamespacedName{Name: clientName, Namespace: realm.Namespace})
+	if err != nil {
+		return nil, err
+	}
+	if keycloakClient == nil {
+		if len(k.instance.GetSpec().GetInfraProperties()[infraPropertiesKeycloakClientKey]) > 0 {
+			return nil, errorForResourceNotFound(infrastructure.KeycloakClientKind, clientName, realm.Namespace)
+		}
+		if keycloakClient, err = k.createKeycloakClient(clientName, realm); err != nil {
+			return nil, err
+		}
+	}
+	if keycloakClient.Spec.Client == nil || len(keycloakClient.Spec.Client.ClientID) == 0 {
+		return nil, errorForResourceConfigError(k.instance, fmt.Sprintf("No client ID found in the KeycloakClient %s", keycloakClient.Name))
+	}
+	if !keycloakClient.Status.Ready {
+		return nil, errorForResourceNotReadyError(fmt.Errorf("KeycloakClient instance %s not ready yet", keycloakClient.Name))
+	}
+	return keycloakClient, nil
+}
+
+// createKeycloakClient creates a KeycloakClient bound to the given realm.
+// The Keycloak Operator binds the client to the realms matching its selector, so the realm labels must select that realm alone.
+func (k *keycloakInfraReconciler) createKeycloakClient(name string, realm *keycloakv1alpha1.KeycloakRealm) (*keycloakv1alpha1.KeycloakClient, error) {
+	if len(realm.Labels) == 0 {
+		return nil, errorForResourceConfigError(k.instance,
+			fmt.Sprintf("KeycloakRealm %s has no labels to select it from a KeycloakClient, label it or set the %s infra property", realm.Name, infraPropertiesKeycloakClientKey))
+	}
+	realms := &keycloakv1alpha1.KeycloakRealmList{}
+	if err := kubernetes.ResourceC(k.Client).ListWithNamespaceAndLabel(realm.Namespace, realms, realm.Labels); err != nil {
+		return nil, err
+	}
+	if len(realms.Items) > 1 {
+		return nil, errorForResourceConfigError(k.instance,
+			fmt.Sprintf("KeycloakRealm %s labels also select other realms in namespace %s, make them unique or set the %s infra property", realm.Name, realm.Namespace, infraPropertiesKeycloakClientKey))
+	}
+
+	k.Log.Info("Creating KeycloakClient", "name", name, "realm", realm.Name)
+	keycloakClient := k.newKeycloakClient(name, realm)
+	// owner references can't cross namespaces, the clients created in another namespace are deleted by a finalizer
+	if realm.Namespace == k.instance.GetNamespace() {
+		return keycloakClient, kubernetes.ResourceC(k.Client).CreateForOwner(keycloakClient, k.instance, k.Scheme)
+	}
+	if err := NewKeycloakClientFinalizerHandler(k.Context).Ensure(k.instance, keycloakClient); err != nil {
+		return nil, err
+	}
+	return keycloakClient, kubernetes.ResourceC(k.Client).Create(keycloakClient)
+}
+
+func (k *keycloakInfraReconciler) newKeycloakClient(name string, realm *keycloakv1alpha1.KeycloakRealm) *keycloakv1alpha1.KeycloakClient {
+	return &keycloakv1alpha1.KeycloakClient{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: realm.Namespace,
+		},
+		Spec: keycloakv1alpha1.KeycloakClientSpec{
+			RealmSelector: &metav1.LabelSelector{MatchLabels: realm.Labels},
+			Client: &keycloakv1alpha1.KeycloakAPIClient{
+				ClientID:                  name,
+				Enabled:                   true,
+				ClientAuthenticatorType:   "client-secret",
+				StandardFlowEnabled:       true,
+				DirectAccessGrantsEnabled: true,
+				ServiceAccountsEnabled:    true,
+			},
+		},
+	}
+}
+
+// getKeycloakClientSecret gets the client secret from the Secret created by the Keycloak Operator for the given client
+func (k *keycloakInfraReconciler) getKeycloakClientSecret(keycloakClient *keycloakv1alpha1.KeycloakClient) (string, error) {
+	secretName := k.keycloakHandler.GetKeycloakClientSecretName(keycloakClient)
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: keycloakClient.Namespace}}
+	if exists, err := kubernetes.ResourceC(k.Client).Fetch(secret); err != nil {
+		return "", err
+	} else if !exists {
+		return "", errorForResourceNotReadyError(fmt.Errorf("Secret %s for the KeycloakClient %s not created yet", secretName, keycloakClient.Name))
+	}
+	clientSecret := string(secret.Data[infrastructure.KeycloakClientSecretKey])
+	if len(clientSecret) == 0 {
+		clientSecret = keycloakClient.Spec.Client.Secret
+	}
+	return clientSecret, nil
+}
+
+func (k *keycloakInfraReconciler) updateKeycloakRuntimePropsInStatus(connection *KeycloakConnection, runtime api.RuntimeType) error {
+	k.Log.Debug("going to Update Keycloak runtime properties in kogito infra instance status", "runtime", runtime)
+	keycloakConfigReconciler := newKeycloakConfigReconciler(k.infraContext, connection, runtime)
+	if err := keycloakConfigReconciler.Reconcile(); err != nil {
+		return err
+	}
+
+	if len(connection.ClientSecret) == 0 {
+		return nil
+	}
+	keycloakCredentialReconciler := newKeycloakCredentialReconciler(k.infraContext, connection, runtime)
+	return keycloakCredentialReconciler.Reconcile()
+}
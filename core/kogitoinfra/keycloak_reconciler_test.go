@@ -0,0 +1,197 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoinfra
+
+import (
+	"testing"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	keycloakv1alpha1 "github.com/kiegroup/kogito-operator/core/infrastructure/keycloak/v1alpha1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestKeycloakInfraReconciler_WithClient(t *testing.T) {
+	ns := t.Name()
+	kogitoKeycloakInstance := test.CreateFakeKogitoKeycloak(ns)
+	kogitoKeycloakInstance.GetSpec().GetInfraProperties()["keycloak-client"] = "kogito-client"
+	keycloak := test.CreateFakeKeycloak(ns)
+	realm := test.CreateFakeKeycloakRealm(ns)
+	keycloakClient := test.CreateFakeKeycloakClient(ns)
+	clientSecret := test.CreateFakeKeycloakClientSecret(ns)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kogitoKeycloakInstance, keycloak, realm, keycloakClient, clientSecret).Build()
+	infraContext := infraContext{
+		Context: operator.Context{
+			Client: cli,
+			Log:    test.TestLogger,
+			Scheme: meta.GetRegisteredSchema(),
+		},
+		instance: kogitoKeycloakInstance,
+	}
+	reconciler, err := NewReconcilerHandler(infraContext.Context).GetInfraReconciler(kogitoKeycloakInstance)
+	assert.NoError(t, err)
+	err = reconciler.Reconcile()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(kogitoKeycloakInstance.GetStatus().GetConfigMapEnvFromReferences()))
+	assert.Equal(t, 2, len(kogitoKeycloakInstance.GetStatus().GetSecretEnvFromReferences()))
+
+	configMap := &v1.ConfigMap{ObjectMeta: v12.ObjectMeta{Name: "kogito-keycloak-quarkus-config", Namespace: ns}}
+	exist, err := kubernetes.ResourceC(cli).Fetch(configMap)
+	assert.True(t, exist)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://keycloak."+ns+".svc:8443/auth/realms/kogito", configMap.Data["quarkus.oidc.auth-server-url"])
+	assert.Equal(t, "kogito-app", configMap.Data["quarkus.oidc.client-id"])
+
+	configMap = &v1.ConfigMap{ObjectMeta: v12.ObjectMeta{Name: "kogito-keycloak-springboot-config", Namespace: ns}}
+	exist, err = kubernetes.ResourceC(cli).Fetch(configMap)
+	assert.True(t, exist)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://keycloak."+ns+".svc:8443/auth/realms/kogito", configMap.Data["spring.security.oauth2.resourceserver.jwt.issuer-uri"])
+
+	secret := &v1.Secret{ObjectMeta: v12.ObjectMeta{Name: "kogito-keycloak-quarkus-credential", Namespace: ns}}
+	exist, err = kubernetes.ResourceC(cli).Fetch(secret)
+	assert.True(t, exist)
+	assert.NoError(t, err)
+	assert.Equal(t, "secretToFind", secret.StringData["QUARKUS_OIDC_CREDENTIALS_SECRET"])
+}
+
+func TestKeycloakInfraReconciler_CreatesClient(t *testing.T) {
+	ns := t.Name()
+	kogitoKeycloakInstance := test.CreateFakeKogitoKeycloak(ns)
+	keycloak := test.CreateFakeKeycloak(ns)
+	realm := test.CreateFakeKeycloakRealm(ns)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kogitoKeycloakInstance, keycloak, realm).Build()
+	infraContext := infraContext{
+		Context: operator.Context{
+			Client: cli,
+			Log:    test.TestLogger,
+			Scheme: meta.GetRegisteredSchema(),
+		},
+		instance: kogitoKeycloakInstance,
+	}
+	reconciler, err := NewReconcilerHandler(infraContext.Context).GetInfraReconciler(kogitoKeycloakInstance)
+	assert.NoError(t, err)
+	err = reconciler.Reconcile()
+	assert.Error(t, err)
+	assert.Equal(t, api.ResourceNotReady, reasonForError(err))
+
+	keycloakClient := &keycloakv1alpha1.KeycloakClient{ObjectMeta: v12.ObjectMeta{Name: "kogito-keycloak-infra-client", Namespace: ns}}
+	exist, err := kubernetes.ResourceC(cli).Fetch(keycloakClient)
+	assert.True(t, exist)
+	assert.NoError(t, err)
+	assert.Equal(t, "kogito-keycloak-infra-client", keycloakClient.Spec.Client.ClientID)
+	assert.Equal(t, realm.Labels, keycloakClient.Spec.RealmSelector.MatchLabels)
+	assert.Equal(t, 1, len(keycloakClient.OwnerReferences))
+}
+
+func TestKeycloakInfraReconciler_CreatesClientInAnotherNamespace(t *testing.T) {
+	ns := t.Name()
+	keycloakNs := "keycloak"
+	kogitoKeycloakInstance := test.CreateFakeKogitoKeycloak(ns)
+	kogitoKeycloakInstance.GetSpec().GetResource().(*v1beta1.InfraResource).Namespace = keycloakNs
+	keycloak := test.CreateFakeKeycloak(keycloakNs)
+	realm := test.CreateFakeKeycloakRealm(keycloakNs)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kogitoKeycloakInstance, keycloak, realm).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	reconciler, err := NewReconcilerHandler(context).GetInfraReconciler(kogitoKeycloakInstance)
+	assert.NoError(t, err)
+	err = reconciler.Reconcile()
+	assert.Error(t, err)
+	assert.Equal(t, api.ResourceNotReady, reasonForError(err))
+
+	keycloakClient := &keycloakv1alpha1.KeycloakClient{ObjectMeta: v12.ObjectMeta{Name: "kogito-keycloak-infra-client", Namespace: keycloakNs}}
+	test.AssertFetchMustExist(t, cli, keycloakClient)
+	assert.Empty(t, keycloakClient.OwnerReferences)
+	assert.Contains(t, kogitoKeycloakInstance.GetFinalizers(), KeycloakClientFinalizer)
+	assert.Equal(t, keycloakNs+"/kogito-keycloak-infra-client", kogitoKeycloakInstance.GetAnnotations()[keycloakClientAnnotation])
+
+	assert.NoError(t, NewKeycloakClientFinalizerHandler(context).Finalize(kogitoKeycloakInstance))
+	exist, err := kubernetes.ResourceC(cli).Fetch(keycloakClient)
+	assert.NoError(t, err)
+	assert.False(t, exist)
+	assert.NotContains(t, kogitoKeycloakInstance.GetFinalizers(), KeycloakClientFinalizer)
+}
+
+func TestKeycloakInfraReconciler_RealmNotSelectable(t *testing.T) {
+	ns := t.Name()
+	unlabeledRealm := test.CreateFakeKeycloakRealm(ns)
+	unlabeledRealm.Labels = nil
+	otherRealm := test.CreateFakeKeycloakRealm(ns)
+	otherRealm.Name = "other-realm"
+	tests := []struct {
+		name    string
+		objects []runtime.Object
+	}{
+		{"WithoutLabels", []runtime.Object{unlabeledRealm}},
+		{"SharedLabels", []runtime.Object{test.CreateFakeKeycloakRealm(ns), otherRealm}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kogitoKeycloakInstance := test.CreateFakeKogitoKeycloak(ns)
+			objects := append([]runtime.Object{kogitoKeycloakInstance, test.CreateFakeKeycloak(ns)}, tt.objects...)
+			cli := test.NewFakeClientBuilder().AddK8sObjects(objects...).Build()
+			context := operator.Context{
+				Client: cli,
+				Log:    test.TestLogger,
+				Scheme: meta.GetRegisteredSchema(),
+			}
+			reconciler, err := NewReconcilerHandler(context).GetInfraReconciler(kogitoKeycloakInstance)
+			assert.NoError(t, err)
+			err = reconciler.Reconcile()
+			assert.Error(t, err)
+			assert.Equal(t, api.ResourceConfigError, reasonForError(err))
+
+			keycloakClient := &keycloakv1alpha1.KeycloakClient{ObjectMeta: v12.ObjectMeta{Name: "kogito-keycloak-infra-client", Namespace: ns}}
+			exist, err := kubernetes.ResourceC(cli).Fetch(keycloakClient)
+			assert.NoError(t, err)
+			assert.False(t, exist)
+		})
+	}
+}
+
+func TestKeycloakInfraReconciler_WithoutRealm(t *testing.T) {
+	ns := t.Name()
+	kogitoKeycloakInstance := test.CreateFakeKogitoKeycloak(ns)
+	delete(kogitoKeycloakInstance.GetSpec().GetInfraProperties(), "keycloak-realm")
+	keycloak := test.CreateFakeKeycloak(ns)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kogitoKeycloakInstance, keycloak).Build()
+	infraContext := infraContext{
+		Context: operator.Context{
+			Client: cli,
+			Log:    test.TestLogger,
+			Scheme: meta.GetRegisteredSchema(),
+		},
+		instance: kogitoKeycloakInstance,
+	}
+	reconciler, err := NewReconcilerHandler(infraContext.Context).GetInfraReconciler(kogitoKeycloakInstance)
+	assert.NoError(t, err)
+	err = reconciler.Reconcile()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(kogitoKeycloakInstance.GetStatus().GetConfigMapEnvFromReferences()))
+}
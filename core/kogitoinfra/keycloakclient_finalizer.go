@@ -0,0 +1,104 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoinfra
+
+import (
+	"fmt"
+	"strings"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	keycloakv1alpha1 "github.com/kiegroup/kogito-operator/core/infrastructure/keycloak/v1alpha1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// KeycloakClientFinalizer is set on the KogitoInfras that created a KeycloakClient in another namespace,
+	// deleted with them since owner references can't cross namespaces
+	KeycloakClientFinalizer = "kogito.kie.org/keycloak-client"
+	// keycloakClientAnnotation references the KeycloakClient, as namespace/name, created by the KogitoInfra in another namespace
+	keycloakClientAnnotation = "kogito.kie.org/keycloak-client"
+)
+
+// KeycloakClientFinalizerHandler handles the deletion of the KeycloakClients created by a KogitoInfra in another namespace
+type KeycloakClientFinalizerHandler interface {
+	// Ensure adds the finalizer to the KogitoInfra, keeping a reference to the given KeycloakClient to delete with it
+	Ensure(instance api.KogitoInfraInterface, keycloakClient *keycloakv1alpha1.KeycloakClient) error
+	// Finalize deletes the KeycloakClient created by the KogitoInfra and releases it
+	Finalize(instance api.KogitoInfraInterface) error
+}
+
+type keycloakClientFinalizerHandler struct {
+	operator.Context
+	keycloakHandler infrastructure.KeycloakHandler
+}
+
+// NewKeycloakClientFinalizerHandler ...
+func NewKeycloakClientFinalizerHandler(context operator.Context) KeycloakClientFinalizerHandler {
+	return &keycloakClientFinalizerHandler{
+		Context:         context,
+		keycloakHandler: infrastructure.NewKeycloakHandler(context),
+	}
+}
+
+func (k *keycloakClientFinalizerHandler) Ensure(instance api.KogitoInfraInterface, keycloakClient *keycloakv1alpha1.KeycloakClient) error {
+	clientKey := fmt.Sprintf("%s/%s", keycloakClient.Namespace, keycloakClient.Name)
+	if controllerutil.ContainsFinalizer(instance, KeycloakClientFinalizer) && instance.GetAnnotations()[keycloakClientAnnotation] == clientKey {
+		return nil
+	}
+	// the status of the given instance is persisted at the end of the reconciliation, so it must not be overridden by the update
+	updated := instance.DeepCopyObject().(api.KogitoInfraInterface)
+	annotations := updated.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[keycloakClientAnnotation] = clientKey
+	updated.SetAnnotations(annotations)
+	controllerutil.AddFinalizer(updated, KeycloakClientFinalizer)
+	if err := kubernetes.ResourceC(k.Client).Update(updated); err != nil {
+		return err
+	}
+	instance.SetAnnotations(updated.GetAnnotations())
+	instance.SetFinalizers(updated.GetFinalizers())
+	instance.SetResourceVersion(updated.GetResourceVersion())
+	return nil
+}
+
+func (k *keycloakClientFinalizerHandler) Finalize(instance api.KogitoInfraInterface) error {
+	if !controllerutil.ContainsFinalizer(instance, KeycloakClientFinalizer) {
+		return nil
+	}
+	if clientKey := strings.SplitN(instance.GetAnnotations()[keycloakClientAnnotation], "/", 2); len(clientKey) == 2 && k.keycloakHandler.IsKeycloakAvailable() {
+		namespace, name := clientKey[0], clientKey[1]
+		keycloakClient, err := k.keycloakHandler.FetchKeycloakClient(types.NamespacedName{Name: name, Namespace: namespace})
+		if err != nil {
+			return err
+		}
+		if keycloakClient != nil {
+			k.Log.Info("Deleting KeycloakClient created in another namespace", "name", name, "namespace", namespace)
+			if err = kubernetes.ResourceC(k.Client).Delete(keycloakClient); err != nil {
+				return err
+			}
+		}
+	}
+	controllerutil.RemoveFinalizer(instance, KeycloakClientFinalizer)
+	return kubernetes.ResourceC(k.Client).Update(instance)
+}
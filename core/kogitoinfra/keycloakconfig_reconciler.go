@@ -0,0 +1,134 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoinfra
+
+import (
+	"fmt"
+	"reflect"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	v12 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	keycloakConfigMapName = "kogito-keycloak-%s-config"
+)
+
+type keycloakConfigReconciler struct {
+	infraContext
+	connection       *KeycloakConnection
+	runtime          api.RuntimeType
+	configMapHandler infrastructure.ConfigMapHandler
+}
+
+func newKeycloakConfigReconciler(ctx infraContext, connection *KeycloakConnection, runtime api.RuntimeType) Reconciler {
+	return &keycloakConfigReconciler{
+		infraContext:     ctx,
+		connection:       connection,
+		runtime:          runtime,
+		configMapHandler: infrastructure.NewConfigMapHandler(ctx.Context),
+	}
+}
+
+func (i *keycloakConfigReconciler) Reconcile() (err error) {
+
+	// Create Required resource
+	requestedResources, err := i.createRequiredResources()
+	if err != nil {
+		return
+	}
+
+	// Get Deployed resource
+	deployedResources, err := i.getDeployedResources()
+	if err != nil {
+		return
+	}
+
+	// Process Delta
+	if err = i.processDelta(requestedResources, deployedResources); err != nil {
+		return err
+	}
+
+	i.instance.GetStatus().AddConfigMapEnvFromReferences(i.getKeycloakConfigMapName())
+	return nil
+}
+
+func (i *keycloakConfigReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	configMap := i.createKeycloakConfigMap(i.getKeycloakAppProps())
+	if err := framework.SetOwner(i.infraContext.instance, i.infraContext.Scheme, configMap); err != nil {
+		return resources, err
+	}
+	resources[reflect.TypeOf(v12.ConfigMap{})] = []client.Object{configMap}
+	return resources, nil
+}
+
+func (i *keycloakConfigReconciler) getDeployedResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	deployedConfigMap, err := i.configMapHandler.FetchConfigMap(types.NamespacedName{Name: i.getKeycloakConfigMapName(), Namespace: i.infraContext.instance.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	if deployedConfigMap != nil {
+		resources[reflect.TypeOf(v12.ConfigMap{})] = []client.Object{deployedConfigMap}
+	}
+	return resources, nil
+}
+
+func (i *keycloakConfigReconciler) processDelta(requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) (err error) {
+	comparator := i.configMapHandler.GetComparator()
+	deltaProcessor := infrastructure.NewDeltaProcessor(i.infraContext.Context)
+	_, err = deltaProcessor.ProcessDelta(comparator, requestedResources, deployedResources)
+	return err
+}
+
+func (i *keycloakConfigReconciler) getKeycloakAppProps() map[string]string {
+	appProps := map[string]string{}
+	if i.runtime == api.QuarkusRuntimeType {
+		appProps[propertiesKeycloak[i.runtime][appPropKeycloakAuthServerURL]] = i.connection.AuthServerURL
+		appProps[propertiesKeycloak[i.runtime][appPropKeycloakClientID]] = i.connection.ClientID
+	} else if i.runtime == api.SpringBootRuntimeType {
+		appProps[propertiesKeycloak[i.runtime][appPropKeycloakIssuerURI]] = i.connection.AuthServerURL
+		appProps[propertiesKeycloak[i.runtime][appPropKeycloakProviderIssuerURI]] = i.connection.AuthServerURL
+		appProps[propertiesKeycloak[i.runtime][appPropKeycloakRegistrationClientID]] = i.connection.ClientID
+	}
+	return appProps
+}
+
+func (i *keycloakConfigReconciler) createKeycloakConfigMap(appProps map[string]string) *v12.ConfigMap {
+	configMap := &v12.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      i.getKeycloakConfigMapName(),
+			Namespace: i.infraContext.instance.GetNamespace(),
+			Labels: map[string]string{
+				framework.LabelAppKey: i.infraContext.instance.GetName(),
+			},
+		},
+		Data: appProps,
+	}
+	return configMap
+}
+
+func (i *keycloakConfigReconciler) getKeycloakConfigMapName() string {
+	return fmt.Sprintf(keycloakConfigMapName, i.runtime)
+}
@@ -19,8 +19,15 @@ package kogitoinfra
 
 import (
 	"fmt"
+	kogitocli "github.com/kiegroup/kogito-operator/core/client"
 	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 // knativeInfraReconciler for Knative resources reconciliation
@@ -35,6 +42,16 @@ func initknativeInfraReconciler(context infraContext) Reconciler {
 	}
 }
 
+// AppendKnativeWatchedObjects watches the Knative Eventing Brokers referenced by the KogitoInfras of the given type, so their changes trigger a reconciliation.
+// Knative Eventing might not be installed, so the watch is added to the built controller once the Broker API is available.
+func AppendKnativeWatchedObjects(c controller.Controller, cli *kogitocli.Client, scheme *runtime.Scheme, infraType client.Object) error {
+	mapper, err := newReferencedResourceMapper(cli, scheme, infraType, infrastructure.KnativeEventingBrokerKind)
+	if err != nil {
+		return err
+	}
+	return c.Watch(&source.Kind{Type: &eventingv1.Broker{}}, handler.EnqueueRequestsFromMapFunc(mapper))
+}
+
 // Reconcile ...
 func (k *knativeInfraReconciler) Reconcile() (resultErr error) {
 	knativeHandler := infrastructure.NewKnativeHandler(k.Context)
@@ -0,0 +1,291 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoinfra
+
+import (
+	"fmt"
+	"strconv"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	kogitocli "github.com/kiegroup/kogito-operator/core/client"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	postgresql "github.com/kiegroup/kogito-operator/core/infrastructure/postgresql/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	appPropPostgreSQLDBKind      = iota // for Quarkus
+	appPropPostgreSQLJdbcURL            // for Quarkus and Spring Boot
+	appPropPostgreSQLReactiveURL        // for Quarkus
+	appPropPostgreSQLPersistence
+
+	envVarPostgreSQLUser
+	envVarPostgreSQLPassword
+
+	postgreSQLDBKind      = "postgresql"
+	postgreSQLJdbcURL     = "jdbc:postgresql://%s:%d/%s"
+	postgreSQLReactiveURL = "postgresql://%s:%d/%s"
+
+	// infraPropertiesCredentialsSecretKey name of the Secret holding the credentials of a PostgreSQL server exposed by a plain Service
+	infraPropertiesCredentialsSecretKey = "credentials-secret"
+	postgreSQLSecretUsernameKey         = "username"
+	postgreSQLSecretPasswordKey         = "password"
+	postgreSQLSecretDatabaseKey         = "database"
+	postgreSQLServicePortName           = "postgresql"
+)
+
+var (
+	// PostgreSQL variables for the KogitoInfra deployed infrastructure.
+	//For Quarkus: https://quarkus.io/guides/datasource#configuration-reference
+	//For Spring: https://docs.spring.io/spring-boot/docs/current/reference/htmlsingle/#data.sql.datasource
+
+	propertiesPostgreSQL = map[api.RuntimeType]map[int]string{
+		api.QuarkusRuntimeType: {
+			appPropPostgreSQLDBKind:      "quarkus.datasource.db-kind",
+			appPropPostgreSQLJdbcURL:     "quarkus.datasource.jdbc.url",
+			appPropPostgreSQLReactiveURL: "quarkus.datasource.reactive.url",
+			appPropPostgreSQLPersistence: "kogito.persistence.type",
+
+			envVarPostgreSQLUser:     "QUARKUS_DATASOURCE_USERNAME",
+			envVarPostgreSQLPassword: "QUARKUS_DATASOURCE_PASSWORD",
+		},
+		api.SpringBootRuntimeType: {
+			appPropPostgreSQLJdbcURL:     "spring.datasource.url",
+			appPropPostgreSQLPersistence: "kogito.persistence.type",
+
+			envVarPostgreSQLUser:     "SPRING_DATASOURCE_USERNAME",
+			envVarPostgreSQLPassword: "SPRING_DATASOURCE_PASSWORD",
+		},
+	}
+)
+
+// PostgreSQLConnection holds the information needed by a runtime to connect to a PostgreSQL database
+type PostgreSQLConnection struct {
+	Host     string
+	Port     int
+	Database string
+	Username string
+	Password string
+}
+
+type postgreSQLInfraReconciler struct {
+	infraContext
+	postgreSQLHandler infrastructure.PostgreSQLHandler
+}
+
+func initPostgreSQLInfraReconciler(context infraContext) Reconciler {
+	context.Log = context.Log.WithValues("resource", "postgreSQL")
+	return &postgreSQLInfraReconciler{
+		infraContext:      context,
+		postgreSQLHandler: infrastructure.NewPostgreSQLHandler(context.Context),
+	}
+}
+
+// AppendPostgreSQLWatchedObjects watches the PostgresClusters referenced by the KogitoInfras of the given type, so their readiness changes trigger a reconciliation.
+// PostgresClusters are not owned by the KogitoInfras and the Crunchy Data PostgreSQL Operator might not be installed,
+// so the watch is added to the built controller once the PostgresCluster API is available.
+func AppendPostgreSQLWatchedObjects(c controller.Controller, cli *kogitocli.Client, scheme *runtime.Scheme, infraType client.Object) error {
+	mapper, err := newReferencedResourceMapper(cli, scheme, infraType, infrastructure.PostgresClusterKind)
+	if err != nil {
+		return err
+	}
+	return c.Watch(&source.Kind{Type: &postgresql.PostgresCluster{}}, handler.EnqueueRequestsFromMapFunc(mapper))
+}
+
+// Reconcile reconcile Kogito infra object
+func (i *postgreSQLInfraReconciler) Reconcile() (resultErr error) {
+	// Step 1: check whether user has provided custom PostgreSQL instance reference
+	postgreSQLNamespace := i.instance.GetSpec().GetResource().GetNamespace()
+	postgreSQLName := i.instance.GetSpec().GetResource().GetName()
+	if len(postgreSQLNamespace) == 0 {
+		postgreSQLNamespace = i.instance.GetNamespace()
+		i.Log.Debug("Namespace is not provided for infrastructure PostgreSQL resource", "instance", i.instance.GetName(), "namespace", postgreSQLNamespace)
+	}
+	if len(postgreSQLName) == 0 {
+		return errorForResourceConfigError(i.instance, "No resource name given")
+	}
+
+	// Step 2: resolve the connection either from the PostgresCluster or from the Service/Secret pair
+	var connection *PostgreSQLConnection
+	key := types.NamespacedName{Name: postgreSQLName, Namespace: postgreSQLNamespace}
+	if i.instance.GetSpec().GetResource().GetKind() == infrastructure.PostgresClusterKind {
+		connection, resultErr = i.getConnectionFromPostgresCluster(key)
+	} else {
+		connection, resultErr = i.getConnectionFromService(key)
+	}
+	if resultErr != nil {
+		return resultErr
+	}
+
+	i.Log.Info("PostgreSQL instance is ready", "host", connection.Host, "database", connection.Database)
+	if resultErr = i.updatePostgreSQLRuntimePropsInStatus(connection, api.QuarkusRuntimeType); resultErr != nil {
+		return resultErr
+	}
+	if resultErr = i.updatePostgreSQLRuntimePropsInStatus(connection, api.SpringBootRuntimeType); resultErr != nil {
+		return resultErr
+	}
+	return resultErr
+}
+
+// getConnectionFromPostgresCluster resolves the connection from a PostgresCluster deployed by the Crunchy Data PostgreSQL Operator
+// and the user secret the operator creates for it
+func (i *postgreSQLInfraReconciler) getConnectionFromPostgresCluster(key types.NamespacedName) (*PostgreSQLConnection, error) {
+	if !i.postgreSQLHandler.IsPostgresClusterAvailable() {
+		return nil, errorForResourceAPINotFound(i.instance.GetSpec().GetResource().GetAPIVersion())
+	}
+	postgresCluster, err := i.postgreSQLHandler.FetchPostgresClusterInstance(key)
+	if err != nil {
+		return nil, err
+	} else if postgresCluster == nil {
+		return nil, errorForResourceNotFound(infrastructure.PostgresClusterKind, key.Name, key.Namespace)
+	}
+	if !i.postgreSQLHandler.IsPostgresClusterReady(postgresCluster) {
+		return nil, errorForResourceNotReadyError(fmt.Errorf("PostgresCluster instance %s not ready. Waiting for at least one ready instance", postgresCluster.Name))
+	}
+
+	username := i.getPostgresClusterUser(postgresCluster)
+	secret, err := i.fetchCredentialsSecret(i.postgreSQLHandler.GetPostgresClusterUserSecretName(postgresCluster, username.Name), key.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	connection := &PostgreSQLConnection{
+		Host:     string(secret.Data[infrastructure.PostgreSQLSecretHostKey]),
+		Database: i.instance.GetSpec().GetInfraProperties()[infraPropertiesDatabaseKey],
+		Username: username.Name,
+		Password: string(secret.Data[infrastructure.PostgreSQLSecretPasswordKey]),
+	}
+	if len(connection.Host) == 0 {
+		connection.Host = i.postgreSQLHandler.GetPostgresClusterPrimaryHost(postgresCluster)
+	}
+	if port, err := strconv.Atoi(string(secret.Data[infrastructure.PostgreSQLSecretPortKey])); err == nil {
+		connection.Port = port
+	} else if postgresCluster.Spec.Port != nil {
+		connection.Port = int(*postgresCluster.Spec.Port)
+	} else {
+		connection.Port = infrastructure.DefaultPostgreSQLPort
+	}
+	if len(connection.Database) == 0 {
+		connection.Database = string(secret.Data[infrastructure.PostgreSQLSecretDatabaseKey])
+	}
+	if len(connection.Database) == 0 && len(username.Databases) > 0 {
+		connection.Database = username.Databases[0]
+	}
+	if len(connection.Database) == 0 {
+		return nil, errorForMissingResourceConfig(i.instance, infraPropertiesDatabaseKey)
+	}
+	return connection, nil
+}
+
+// getPostgresClusterUser gets the user to connect with, by default the Crunchy Data PostgreSQL Operator creates a user named after the cluster
+func (i *postgreSQLInfraReconciler) getPostgresClusterUser(postgresCluster *postgresql.PostgresCluster) postgresql.PostgresUserSpec {
+	username := i.instance.GetSpec().GetInfraProperties()[infraPropertiesUserKey]
+	for _, user := range postgresCluster.Spec.Users {
+		if len(username) == 0 || user.Name == username {
+			return user
+		}
+	}
+	if len(username) == 0 {
+		username = postgresCluster.Name
+	}
+	return postgresql.PostgresUserSpec{Name: username}
+}
+
+// getConnectionFromService resolves the connection from a plain Service exposing a PostgreSQL server and
+// the Secret referenced in the infra properties holding its credentials
+func (i *postgreSQLInfraReconciler) getConnectionFromService(key types.NamespacedName) (*PostgreSQLConnection, error) {
+	service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}
+	if exists, err := kubernetes.ResourceC(i.Client).Fetch(service); err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, errorForResourceNotFound(infrastructure.PostgreSQLServiceKind, key.Name, key.Namespace)
+	}
+
+	secretName := i.instance.GetSpec().GetInfraProperties()[infraPropertiesCredentialsSecretKey]
+	if len(secretName) == 0 {
+		return nil, errorForMissingResourceConfig(i.instance, infraPropertiesCredentialsSecretKey)
+	}
+	secret, err := i.fetchCredentialsSecret(secretName, key.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	connection := &PostgreSQLConnection{
+		Host:     fmt.Sprintf("%s.%s.svc", service.Name, service.Namespace),
+		Port:     getPostgreSQLServicePort(service),
+		Database: i.instance.GetSpec().GetInfraProperties()[infraPropertiesDatabaseKey],
+		Username: i.instance.GetSpec().GetInfraProperties()[infraPropertiesUserKey],
+		Password: string(secret.Data[postgreSQLSecretPasswordKey]),
+	}
+	if len(connection.Database) == 0 {
+		connection.Database = string(secret.Data[postgreSQLSecretDatabaseKey])
+	}
+	if len(connection.Database) == 0 {
+		return nil, errorForMissingResourceConfig(i.instance, infraPropertiesDatabaseKey)
+	}
+	if len(connection.Username) == 0 {
+		connection.Username = string(secret.Data[postgreSQLSecretUsernameKey])
+	}
+	if len(connection.Username) == 0 {
+		return nil, errorForResourceConfigError(i.instance, fmt.Sprintf("No %s found in the Secret %s", postgreSQLSecretUsernameKey, secretName))
+	}
+	return connection, nil
+}
+
+func (i *postgreSQLInfraReconciler) fetchCredentialsSecret(name, namespace string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	if exists, err := kubernetes.ResourceC(i.Client).Fetch(secret); err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, errorForResourceNotFound("Secret", name, namespace)
+	}
+	i.Log.Debug("Found PostgreSQL secret", "secret", name)
+	return secret, nil
+}
+
+func (i *postgreSQLInfraReconciler) updatePostgreSQLRuntimePropsInStatus(connection *PostgreSQLConnection, runtime api.RuntimeType) error {
+	i.Log.Debug("going to Update PostgreSQL runtime properties in kogito infra instance status", "runtime", runtime)
+	postgreSQLConfigReconciler := newPostgreSQLConfigReconciler(i.infraContext, connection, runtime)
+	if err := postgreSQLConfigReconciler.Reconcile(); err != nil {
+		return err
+	}
+
+	postgreSQLCredentialReconciler := newPostgreSQLCredentialReconciler(i.infraContext, connection, runtime)
+	return postgreSQLCredentialReconciler.Reconcile()
+}
+
+// getPostgreSQLServicePort gets the port named postgresql, the first one exposed by the Service otherwise
+func getPostgreSQLServicePort(service *corev1.Service) int {
+	for _, port := range service.Spec.Ports {
+		if port.Name == postgreSQLServicePortName {
+			return int(port.Port)
+		}
+	}
+	if len(service.Spec.Ports) > 0 {
+		return int(service.Spec.Ports[0].Port)
+	}
+	return infrastructure.DefaultPostgreSQLPort
+}
@@ -0,0 +1,150 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoinfra
+
+import (
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"testing"
+)
+
+func TestPostgreSQLInfraReconciler_PostgresCluster(t *testing.T) {
+	ns := t.Name()
+	kogitoPostgreSQLInstance := test.CreateFakeKogitoPostgresCluster(ns)
+	postgresCluster := test.CreateFakePostgresCluster(ns)
+	userSecret := test.CreateFakePostgresClusterUserSecret(ns)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kogitoPostgreSQLInstance, postgresCluster, userSecret).Build()
+	infraContext := infraContext{
+		Context: operator.Context{
+			Client: cli,
+			Log:    test.TestLogger,
+			Scheme: meta.GetRegisteredSchema(),
+		},
+		instance: kogitoPostgreSQLInstance,
+	}
+	reconciler, err := NewReconcilerHandler(infraContext.Context).GetInfraReconciler(kogitoPostgreSQLInstance)
+	assert.NoError(t, err)
+	err = reconciler.Reconcile()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(kogitoPostgreSQLInstance.GetStatus().GetConfigMapEnvFromReferences()))
+	assert.Equal(t, 2, len(kogitoPostgreSQLInstance.GetStatus().GetSecretEnvFromReferences()))
+
+	configMap := &v1.ConfigMap{ObjectMeta: v12.ObjectMeta{Name: "kogito-postgresql-quarkus-config", Namespace: ns}}
+	exist, err := kubernetes.ResourceC(cli).Fetch(configMap)
+	assert.True(t, exist)
+	assert.NoError(t, err)
+	assert.Equal(t, "jdbc:postgresql://kogito-postgresql-primary."+ns+".svc:5432/kogito", configMap.Data["quarkus.datasource.jdbc.url"])
+}
+
+func TestPostgreSQLInfraReconciler_Service(t *testing.T) {
+	ns := t.Name()
+	kogitoPostgreSQLInstance := test.CreateFakeKogitoPostgreSQLService(ns)
+	service := test.CreateFakePostgreSQLService(ns)
+	secret := test.CreateFakePostgreSQLSecret(ns)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kogitoPostgreSQLInstance, service, secret).Build()
+	infraContext := infraContext{
+		Context: operator.Context{
+			Client: cli,
+			Log:    test.TestLogger,
+			Scheme: meta.GetRegisteredSchema(),
+		},
+		instance: kogitoPostgreSQLInstance,
+	}
+	reconciler, err := NewReconcilerHandler(infraContext.Context).GetInfraReconciler(kogitoPostgreSQLInstance)
+	assert.NoError(t, err)
+	err = reconciler.Reconcile()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(kogitoPostgreSQLInstance.GetStatus().GetConfigMapEnvFromReferences()))
+	assert.Equal(t, 2, len(kogitoPostgreSQLInstance.GetStatus().GetSecretEnvFromReferences()))
+
+	configMap := &v1.ConfigMap{ObjectMeta: v12.ObjectMeta{Name: "kogito-postgresql-springboot-config", Namespace: ns}}
+	exist, err := kubernetes.ResourceC(cli).Fetch(configMap)
+	assert.True(t, exist)
+	assert.NoError(t, err)
+	assert.Equal(t, "jdbc:postgresql://postgresql."+ns+".svc:5433/kogito", configMap.Data["spring.datasource.url"])
+}
+
+func TestPostgreSQLInfraReconciler_ServiceWithoutCredentials(t *testing.T) {
+	ns := t.Name()
+	kogitoPostgreSQLInstance := test.CreateFakeKogitoPostgreSQLService(ns)
+	kogitoPostgreSQLInstance.GetSpec().GetInfraProperties()["credentials-secret"] = ""
+	service := test.CreateFakePostgreSQLService(ns)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kogitoPostgreSQLInstance, service).Build()
+	infraContext := infraContext{
+		Context: operator.Context{
+			Client: cli,
+			Log:    test.TestLogger,
+			Scheme: meta.GetRegisteredSchema(),
+		},
+		instance: kogitoPostgreSQLInstance,
+	}
+	postgreSQLInfraReconciler := initPostgreSQLInfraReconciler(infraContext)
+	err := postgreSQLInfraReconciler.Reconcile()
+	assert.Error(t, err)
+	assert.Equal(t, reasonForError(err), api.ResourceMissingResourceConfig)
+}
+
+func TestPostgreSQLInfraReconciler_PostgresClusterNotReady(t *testing.T) {
+	ns := t.Name()
+	kogitoPostgreSQLInstance := test.CreateFakeKogitoPostgresCluster(ns)
+	postgresCluster := test.CreateFakePostgresCluster(ns)
+	postgresCluster.Status.InstanceSets[0].ReadyReplicas = 0
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kogitoPostgreSQLInstance, postgresCluster).Build()
+	infraContext := infraContext{
+		Context: operator.Context{
+			Client: cli,
+			Log:    test.TestLogger,
+			Scheme: meta.GetRegisteredSchema(),
+		},
+		instance: kogitoPostgreSQLInstance,
+	}
+	postgreSQLInfraReconciler := initPostgreSQLInfraReconciler(infraContext)
+	err := postgreSQLInfraReconciler.Reconcile()
+	assert.Errorf(t, err, "PostgresCluster instance kogito-postgresql not ready. Waiting for at least one ready instance")
+	assert.Equal(t, reasonForError(err), api.ResourceNotReady)
+}
+
+func TestPostgresClusterMapper(t *testing.T) {
+	ns := t.Name()
+	kogitoPostgreSQLInstance := test.CreateFakeKogitoPostgresCluster(ns)
+	kogitoPostgreSQLService := test.CreateFakeKogitoPostgreSQLService(ns)
+	postgresCluster := test.CreateFakePostgresCluster(ns)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kogitoPostgreSQLInstance, kogitoPostgreSQLService, postgresCluster).Build()
+
+	mapper, err := newReferencedResourceMapper(cli, meta.GetRegisteredSchema(), kogitoPostgreSQLInstance, infrastructure.PostgresClusterKind)
+	assert.NoError(t, err)
+	requests := mapper(postgresCluster)
+	assert.Len(t, requests, 1)
+	assert.Equal(t, kogitoPostgreSQLInstance.GetName(), requests[0].Name)
+	assert.Equal(t, ns, requests[0].Namespace)
+
+	// only the resources of the referenced kind are mapped
+	kafkaMapper, err := newReferencedResourceMapper(cli, meta.GetRegisteredSchema(), kogitoPostgreSQLInstance, infrastructure.KafkaKind)
+	assert.NoError(t, err)
+	assert.Empty(t, kafkaMapper(postgresCluster))
+
+	postgresCluster.Namespace = "another-namespace"
+	assert.Empty(t, mapper(postgresCluster))
+}
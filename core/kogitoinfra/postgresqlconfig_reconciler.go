@@ -0,0 +1,134 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoinfra
+
+import (
+	"fmt"
+	"reflect"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	v12 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	postgreSQLConfigMapName           = "kogito-postgresql-%s-config"
+	postgreSQLEnablePersistenceEnvKey = "ENABLE_PERSISTENCE"
+)
+
+type postgreSQLConfigReconciler struct {
+	infraContext
+	connection       *PostgreSQLConnection
+	runtime          api.RuntimeType
+	configMapHandler infrastructure.ConfigMapHandler
+}
+
+func newPostgreSQLConfigReconciler(ctx infraContext, connection *PostgreSQLConnection, runtime api.RuntimeType) Reconciler {
+	return &postgreSQLConfigReconciler{
+		infraContext:     ctx,
+		connection:       connection,
+		runtime:          runtime,
+		configMapHandler: infrastructure.NewConfigMapHandler(ctx.Context),
+	}
+}
+
+func (i *postgreSQLConfigReconciler) Reconcile() (err error) {
+
+	// Create Required resource
+	requestedResources, err := i.createRequiredResources()
+	if err != nil {
+		return
+	}
+
+	// Get Deployed resource
+	deployedResources, err := i.getDeployedResources()
+	if err != nil {
+		return
+	}
+
+	// Process Delta
+	if err = i.processDelta(requestedResources, deployedResources); err != nil {
+		return err
+	}
+
+	i.instance.GetStatus().AddConfigMapEnvFromReferences(i.getPostgreSQLConfigMapName())
+	return nil
+}
+
+func (i *postgreSQLConfigReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	configMap := i.createPostgreSQLConfigMap(i.getPostgreSQLAppProps())
+	if err := framework.SetOwner(i.infraContext.instance, i.infraContext.Scheme, configMap); err != nil {
+		return resources, err
+	}
+	resources[reflect.TypeOf(v12.ConfigMap{})] = []client.Object{configMap}
+	return resources, nil
+}
+
+func (i *postgreSQLConfigReconciler) getDeployedResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	deployedConfigMap, err := i.configMapHandler.FetchConfigMap(types.NamespacedName{Name: i.getPostgreSQLConfigMapName(), Namespace: i.infraContext.instance.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	if deployedConfigMap != nil {
+		resources[reflect.TypeOf(v12.ConfigMap{})] = []client.Object{deployedConfigMap}
+	}
+	return resources, nil
+}
+
+func (i *postgreSQLConfigReconciler) processDelta(requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) (err error) {
+	comparator := i.configMapHandler.GetComparator()
+	deltaProcessor := infrastructure.NewDeltaProcessor(i.infraContext.Context)
+	_, err = deltaProcessor.ProcessDelta(comparator, requestedResources, deployedResources)
+	return err
+}
+
+func (i *postgreSQLConfigReconciler) getPostgreSQLAppProps() map[string]string {
+	appProps := map[string]string{}
+	appProps[postgreSQLEnablePersistenceEnvKey] = "true"
+	appProps[propertiesPostgreSQL[i.runtime][appPropPostgreSQLPersistence]] = postgreSQLDBKind
+	appProps[propertiesPostgreSQL[i.runtime][appPropPostgreSQLJdbcURL]] = fmt.Sprintf(postgreSQLJdbcURL, i.connection.Host, i.connection.Port, i.connection.Database)
+	if i.runtime == api.QuarkusRuntimeType {
+		appProps[propertiesPostgreSQL[i.runtime][appPropPostgreSQLDBKind]] = postgreSQLDBKind
+		appProps[propertiesPostgreSQL[i.runtime][appPropPostgreSQLReactiveURL]] = fmt.Sprintf(postgreSQLReactiveURL, i.connection.Host, i.connection.Port, i.connection.Database)
+	}
+	return appProps
+}
+
+func (i *postgreSQLConfigReconciler) createPostgreSQLConfigMap(appProps map[string]string) *v12.ConfigMap {
+	configMap := &v12.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      i.getPostgreSQLConfigMapName(),
+			Namespace: i.infraContext.instance.GetNamespace(),
+			Labels: map[string]string{
+				framework.LabelAppKey: i.infraContext.instance.GetName(),
+			},
+		},
+		Data: appProps,
+	}
+	return configMap
+}
+
+func (i *postgreSQLConfigReconciler) getPostgreSQLConfigMapName() string {
+	return fmt.Sprintf(postgreSQLConfigMapName, i.runtime)
+}
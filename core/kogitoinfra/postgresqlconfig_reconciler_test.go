@@ -0,0 +1,64 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoinfra
+
+import (
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"testing"
+)
+
+func TestPostgreSQLConfigReconciler(t *testing.T) {
+	ns := t.Name()
+	kogitoPostgreSQLInstance := test.CreateFakeKogitoPostgresCluster(ns)
+	connection := &PostgreSQLConnection{Host: "postgresql-host", Port: 5432, Database: "kogito", Username: "kogito", Password: "pass"}
+	cli := test.NewFakeClientBuilder().AddK8sObjects().Build()
+	infraContext := infraContext{
+		Context: operator.Context{
+			Client: cli,
+			Log:    test.TestLogger,
+			Scheme: meta.GetRegisteredSchema(),
+		},
+		instance: kogitoPostgreSQLInstance,
+	}
+	postgreSQLConfigReconciler := newPostgreSQLConfigReconciler(infraContext, connection, api.QuarkusRuntimeType)
+	err := postgreSQLConfigReconciler.Reconcile()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(kogitoPostgreSQLInstance.GetStatus().GetConfigMapEnvFromReferences()))
+
+	configMap := &v1.ConfigMap{
+		ObjectMeta: v12.ObjectMeta{
+			Name:      kogitoPostgreSQLInstance.GetStatus().GetConfigMapEnvFromReferences()[0],
+			Namespace: ns,
+		},
+	}
+	exist, err := kubernetes.ResourceC(cli).Fetch(configMap)
+	assert.True(t, exist)
+	assert.NoError(t, err)
+	assert.Equal(t, "true", configMap.Data["ENABLE_PERSISTENCE"])
+	assert.Equal(t, "postgresql", configMap.Data["quarkus.datasource.db-kind"])
+	assert.Equal(t, "postgresql", configMap.Data["kogito.persistence.type"])
+	assert.Equal(t, "jdbc:postgresql://postgresql-host:5432/kogito", configMap.Data["quarkus.datasource.jdbc.url"])
+	assert.Equal(t, "postgresql://postgresql-host:5432/kogito", configMap.Data["quarkus.datasource.reactive.url"])
+}
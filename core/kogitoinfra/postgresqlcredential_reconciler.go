@@ -0,0 +1,121 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoinfra
+
+import (
+	"fmt"
+	"reflect"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	v12 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	postgreSQLSecretName = "kogito-postgresql-%s-credential"
+)
+
+type postgreSQLCredentialReconciler struct {
+	infraContext
+	connection    *PostgreSQLConnection
+	runtime       api.RuntimeType
+	secretHandler infrastructure.SecretHandler
+}
+
+func newPostgreSQLCredentialReconciler(infraContext infraContext, connection *PostgreSQLConnection, runtime api.RuntimeType) Reconciler {
+	return &postgreSQLCredentialReconciler{
+		infraContext:  infraContext,
+		connection:    connection,
+		runtime:       runtime,
+		secretHandler: infrastructure.NewSecretHandler(infraContext.Context),
+	}
+}
+
+func (i *postgreSQLCredentialReconciler) Reconcile() (err error) {
+	// Create Required resource
+	requestedResources, err := i.createRequiredResources()
+	if err != nil {
+		return
+	}
+
+	// Get Deployed resource
+	deployedResources, err := i.getDeployedResources()
+	if err != nil {
+		return
+	}
+
+	// Process Delta
+	if err = i.processDelta(requestedResources, deployedResources); err != nil {
+		return err
+	}
+
+	i.instance.GetStatus().AddSecretEnvFromReferences(i.getCredentialSecretName())
+	return nil
+}
+
+func (i *postgreSQLCredentialReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	secret := i.createCustomKogitoPostgreSQLSecret()
+	if err := framework.SetOwner(i.infraContext.instance, i.infraContext.Scheme, secret); err != nil {
+		return resources, err
+	}
+	resources[reflect.TypeOf(v12.Secret{})] = []client.Object{secret}
+	return resources, nil
+}
+
+func (i *postgreSQLCredentialReconciler) getDeployedResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	deployedSecret, err := i.secretHandler.FetchSecret(types.NamespacedName{Name: i.getCredentialSecretName(), Namespace: i.infraContext.instance.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	if deployedSecret != nil {
+		resources[reflect.TypeOf(v12.Secret{})] = []client.Object{deployedSecret}
+	}
+	return resources, nil
+}
+
+func (i *postgreSQLCredentialReconciler) processDelta(requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) (err error) {
+	comparator := i.secretHandler.GetComparator()
+	deltaProcessor := infrastructure.NewDeltaProcessor(i.infraContext.Context)
+	_, err = deltaProcessor.ProcessDelta(comparator, requestedResources, deployedResources)
+	return err
+}
+
+func (i *postgreSQLCredentialReconciler) createCustomKogitoPostgreSQLSecret() *v12.Secret {
+	secret := &v12.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      i.getCredentialSecretName(),
+			Namespace: i.instance.GetNamespace(),
+		},
+		Type: v12.SecretTypeOpaque,
+		StringData: map[string]string{
+			propertiesPostgreSQL[i.runtime][envVarPostgreSQLUser]:     i.connection.Username,
+			propertiesPostgreSQL[i.runtime][envVarPostgreSQLPassword]: i.connection.Password,
+		},
+	}
+	return secret
+}
+
+func (i *postgreSQLCredentialReconciler) getCredentialSecretName() string {
+	return fmt.Sprintf(postgreSQLSecretName, i.runtime)
+}
@@ -0,0 +1,61 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoinfra
+
+import (
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"testing"
+)
+
+func TestPostgreSQLCredentialReconciler(t *testing.T) {
+	ns := t.Name()
+	kogitoPostgreSQLInstance := test.CreateFakeKogitoPostgresCluster(ns)
+	connection := &PostgreSQLConnection{Host: "postgresql-host", Port: 5432, Database: "kogito", Username: "kogito", Password: "pass"}
+	cli := test.NewFakeClientBuilder().Build()
+	infraContext := infraContext{
+		Context: operator.Context{
+			Client: cli,
+			Log:    test.TestLogger,
+			Scheme: meta.GetRegisteredSchema(),
+		},
+		instance: kogitoPostgreSQLInstance,
+	}
+	postgreSQLCredentialReconciler := newPostgreSQLCredentialReconciler(infraContext, connection, api.SpringBootRuntimeType)
+	err := postgreSQLCredentialReconciler.Reconcile()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(kogitoPostgreSQLInstance.GetStatus().GetSecretEnvFromReferences()))
+	secretName := kogitoPostgreSQLInstance.GetStatus().GetSecretEnvFromReferences()[0]
+	credentialSecret := &v1.Secret{
+		ObjectMeta: v12.ObjectMeta{
+			Name:      secretName,
+			Namespace: ns,
+		},
+	}
+	exist, err := kubernetes.ResourceC(cli).Fetch(credentialSecret)
+	assert.True(t, exist)
+	assert.NoError(t, err)
+	assert.Equal(t, "kogito", credentialSecret.StringData["SPRING_DATASOURCE_USERNAME"])
+	assert.Equal(t, "pass", credentialSecret.StringData["SPRING_DATASOURCE_PASSWORD"])
+}
@@ -20,16 +20,22 @@ package kogitoinfra
 import (
 	"fmt"
 	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework/util"
 	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/metrics"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"strings"
 	"time"
 )
 
-const reconciliationStandardInterval = time.Second * 30
+const (
+	reconciliationStandardInterval = time.Second * 30
+	// kogitoInfraKind identifies the KogitoInfra reconciliations in the operator metrics
+	kogitoInfraKind = "KogitoInfra"
+)
 
-// Reconciler Interface to represent type of supported kogito infra reconciliation algorithm for resources like Infinispan, kafka & keycloak
+// Reconciler Interface to represent type of supported kogito infra reconciliation algorithm for resources like Infinispan, kafka, keycloak & PostgreSQL
 type Reconciler interface {
 	Reconcile() error
 }
@@ -66,10 +72,15 @@ func (k *reconcilerHandler) GetInfraReconciler(instance api.KogitoInfraInterface
 		Context:  k.Context,
 		instance: instance,
 	}
-	if infraRes, ok := getSupportedInfraResources(context)[resourceClassForInstance(instance.GetSpec().GetResource())]; ok {
-		return infraRes, nil
+	infraRes, ok := getSupportedInfraResources(context)[resourceClassForInstance(instance.GetSpec().GetResource())]
+	if !ok {
+		return nil, errorForUnsupportedAPI(context)
+	}
+	// resources in namespaces not watched by the operator can't be read, nor can their credentials or certificates
+	if namespace := instance.GetSpec().GetResource().GetNamespace(); !util.IsNamespaceWatched(namespace) {
+		return nil, errorForNamespaceNotWatched(instance, namespace)
 	}
-	return nil, errorForUnsupportedAPI(context)
+	return infraRes, nil
 }
 
 // GetConfigMapReferenceReconciler identify and return request kogito infra reconciliation logic on bases of information provided in kogitoInfra value
@@ -117,29 +128,37 @@ func getSupportedInfraResources(context infraContext) map[string]Reconciler {
 		getResourceClass(infrastructure.KeycloakKind, infrastructure.KeycloakAPIVersion):                     initkeycloakInfraReconciler(context),
 		getResourceClass(infrastructure.KnativeEventingBrokerKind, infrastructure.KnativeEventingAPIVersion): initknativeInfraReconciler(context),
 		getResourceClass(infrastructure.MongoDBKind, infrastructure.MongoDBAPIVersion):                       initMongoDBInfraReconciler(context),
+		getResourceClass(infrastructure.PostgresClusterKind, infrastructure.PostgresClusterAPIVersion):       initPostgreSQLInfraReconciler(context),
+		getResourceClass(infrastructure.PostgreSQLServiceKind, infrastructure.PostgreSQLServiceAPIVersion):   initPostgreSQLInfraReconciler(context),
 	}
 }
 
 func (k *reconcilerHandler) GetReconcileResultFor(err error, requeue bool) (reconcile.Result, error) {
-	switch reasonForError(err) {
+	reason := reasonForError(err)
+	switch reason {
 	case api.ReconciliationFailure:
 		k.Log.Warn("Error while reconciling KogitoInfra", "error", err.Error())
+		metrics.ObserveReconcile(kogitoInfraKind, metrics.ReconcileError, string(reason))
 		return reconcile.Result{RequeueAfter: 0, Requeue: false}, err
-	case api.ResourceMissingResourceConfig, api.ResourceConfigError:
+	case api.ResourceMissingResourceConfig, api.ResourceConfigError, api.ResourceNamespaceNotWatched:
 		k.Log.Error(err, "KogitoInfra configuration error")
+		metrics.ObserveReconcile(kogitoInfraKind, metrics.ReconcileError, string(reason))
 		return reconcile.Result{RequeueAfter: 0, Requeue: false}, nil
 	}
 
 	// no requeue, no errors, stop reconciliation
 	if !requeue && err == nil {
 		k.Log.Debug("No need reconciliation for KogitoInfra")
+		metrics.ObserveReconcile(kogitoInfraKind, metrics.ReconcileSuccess, "")
 		return reconcile.Result{RequeueAfter: 0, Requeue: false}, nil
 	}
 	// caller is asking for a reconciliation
+	requeueAfter := infrastructure.RequeueAfter(reconciliationStandardInterval)
 	if err == nil {
-		k.Log.Info("Waiting for all resources to be created, scheduling reconciliation.", "reconciliation interval", reconciliationStandardInterval.String())
+		k.Log.Info("Waiting for all resources to be created, scheduling reconciliation.", "reconciliation interval", requeueAfter.String())
 	} else { // reconciliation duo to a problem in the env (CRDs missing), infra deployments not ready, operators not installed.. etc. See reconciliation_error.go
-		k.Log.Info("Err", err.Error(), "Scheduling reconciliation", "reconciliation interval", reconciliationStandardInterval.String())
+		k.Log.Info("Err", err.Error(), "Scheduling reconciliation", "reconciliation interval", requeueAfter.String())
 	}
-	return reconcile.Result{RequeueAfter: reconciliationStandardInterval}, nil
+	metrics.ObserveReconcile(kogitoInfraKind, metrics.ReconcileRequeue, string(reason))
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
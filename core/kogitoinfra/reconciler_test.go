@@ -0,0 +1,58 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoinfra
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework/util"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_reconcilerHandler_GetInfraReconciler_NamespaceNotWatched(t *testing.T) {
+	ns := t.Name()
+	t.Setenv(util.WatchNamespaceEnv, ns)
+	kogitoKafka := test.CreateFakeKogitoKafka(ns)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kogitoKafka).Build()
+	handler := NewReconcilerHandler(operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	})
+
+	// same namespace of the KogitoInfra
+	reconciler, err := handler.GetInfraReconciler(kogitoKafka)
+	assert.NoError(t, err)
+	assert.NotNil(t, reconciler)
+
+	kogitoKafka.GetSpec().GetResource().SetNamespace("kafka")
+	reconciler, err = handler.GetInfraReconciler(kogitoKafka)
+	assert.Error(t, err)
+	assert.Nil(t, reconciler)
+	assert.Equal(t, api.ResourceNamespaceNotWatched, reasonForError(err))
+
+	// the user must fix the configuration, no need to requeue
+	result, err := handler.GetReconcileResultFor(err, false)
+	assert.NoError(t, err)
+	assert.False(t, result.Requeue)
+	assert.Zero(t, result.RequeueAfter)
+}
@@ -0,0 +1,74 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoinfra
+
+import (
+	"context"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	kogitocli "github.com/kiegroup/kogito-operator/core/client"
+	"github.com/kiegroup/kogito-operator/core/logger"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// newReferencedResourceMapper maps a resource of the given kind, e.g. a PostgresCluster, to the KogitoInfras referencing it
+func newReferencedResourceMapper(cli *kogitocli.Client, scheme *runtime.Scheme, infraType client.Object, kind string) (handler.MapFunc, error) {
+	gvk, err := apiutil.GVKForObject(infraType, scheme)
+	if err != nil {
+		return nil, err
+	}
+	gvk.Kind = gvk.Kind + "List"
+	return func(object client.Object) []reconcile.Request {
+		listObject, err := scheme.New(gvk)
+		if err != nil {
+			return nil
+		}
+		list := listObject.(client.ObjectList)
+		// the KogitoInfras can reference a resource from another namespace
+		if err := cli.ControlCli.List(context.TODO(), list); err != nil {
+			logger.GetLogger("infra_resource_mapper").Error(err, "Failed to list KogitoInfras referencing resource", "kind", kind, "name", object.GetName(), "namespace", object.GetNamespace())
+			return nil
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil
+		}
+		var requests []reconcile.Request
+		for _, item := range items {
+			infra, ok := item.(api.KogitoInfraInterface)
+			if !ok || infra.GetSpec().IsResourceEmpty() {
+				continue
+			}
+			resource := infra.GetSpec().GetResource()
+			namespace := resource.GetNamespace()
+			if len(namespace) == 0 {
+				namespace = infra.GetNamespace()
+			}
+			if resource.GetKind() == kind && resource.GetName() == object.GetName() && namespace == object.GetNamespace() {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: infra.GetName(), Namespace: infra.GetNamespace()}})
+			}
+		}
+		return requests
+	}, nil
+}
@@ -0,0 +1,77 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoinfra
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework/util"
+	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// SetDefaults sets the default values for the given KogitoInfra
+func SetDefaults(instance api.KogitoInfraInterface) {
+	if !instance.GetSpec().IsResourceEmpty() && len(instance.GetSpec().GetResource().GetNamespace()) == 0 {
+		instance.GetSpec().GetResource().SetNamespace(instance.GetNamespace())
+	}
+}
+
+// Validate verifies the spec attributes for the given KogitoInfra instance
+func Validate(context operator.Context, instance api.KogitoInfraInterface) field.ErrorList {
+	var errs field.ErrorList
+	specPath := field.NewPath("spec")
+	if !instance.GetSpec().IsResourceEmpty() {
+		resourcePath := specPath.Child("resource")
+		resource := instance.GetSpec().GetResource()
+		if len(resource.GetKind()) == 0 {
+			errs = append(errs, field.Required(resourcePath.Child("kind"), ""))
+		}
+		if len(resource.GetAPIVersion()) == 0 {
+			errs = append(errs, field.Required(resourcePath.Child("apiVersion"), ""))
+		}
+		if len(errs) == 0 {
+			infraContext := infraContext{Context: context, instance: instance}
+			if _, ok := getSupportedInfraResources(infraContext)[resourceClassForInstance(resource)]; !ok {
+				supported := getSupportedResources(infraContext)
+				sort.Strings(supported)
+				errs = append(errs, field.Invalid(resourcePath, fmt.Sprintf("%s.%s", resource.GetKind(), resource.GetAPIVersion()),
+					fmt.Sprintf("API %s is not supported for kind %s. Supported APIs are: %v", resource.GetAPIVersion(), resource.GetKind(), supported)))
+			}
+		}
+	}
+	for key, supported := range map[string][]string{
+		KafkaAuthenticationInfraPropertyKey:   {v1beta2.KafkaUserTLSAuthentication, v1beta2.KafkaUserScramSha512Authentication},
+		KafkaSecurityProtocolInfraPropertyKey: kafkaSecurityProtocols,
+		KafkaSaslMechanismInfraPropertyKey:    kafkaSaslMechanisms,
+	} {
+		if value, ok := instance.GetSpec().GetInfraProperties()[key]; ok && !util.Contains(value, supported) {
+			errs = append(errs, field.NotSupported(specPath.Child("infraProperties").Key(key), value, supported))
+		}
+	}
+	for i, env := range instance.GetSpec().GetEnvs() {
+		for _, msg := range validation.IsEnvVarName(env.Name) {
+			errs = append(errs, field.Invalid(specPath.Child("envs").Index(i).Child("name"), env.Name, msg))
+		}
+	}
+	return errs
+}
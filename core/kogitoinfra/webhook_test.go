@@ -0,0 +1,62 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoinfra
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetDefaults(t *testing.T) {
+	instance := test.CreateFakeKogitoKafka(t.Name())
+	instance.GetSpec().GetResource().SetNamespace("")
+	SetDefaults(instance)
+	assert.Equal(t, t.Name(), instance.GetSpec().GetResource().GetNamespace())
+}
+
+func TestValidate(t *testing.T) {
+	context := operator.Context{Log: test.TestLogger}
+	instance := test.CreateFakeKogitoKafka(t.Name())
+	assert.Empty(t, Validate(context, instance))
+
+	instance.GetSpec().GetResource().SetKind("Unknown")
+	errs := Validate(context, instance)
+	assert.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), "is not supported for kind Unknown")
+
+	instance.GetSpec().GetResource().SetKind("")
+	assert.Len(t, Validate(context, instance), 1)
+
+	instance = test.CreateFakeKogitoKafka(t.Name())
+	instance.GetSpec().AddInfraProperties(map[string]string{KafkaAuthenticationInfraPropertyKey: "plain"})
+	errs = Validate(context, instance)
+	assert.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), "spec.infraProperties[authentication]")
+
+	instance = test.CreateFakeKogitoKafka(t.Name())
+	instance.GetSpec().AddInfraProperties(map[string]string{KafkaSecurityProtocolInfraPropertyKey: "TLS", KafkaSaslMechanismInfraPropertyKey: "PLAIN"})
+	errs = Validate(context, instance)
+	assert.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), "spec.infraProperties[security-protocol]")
+
+	assert.Empty(t, Validate(context, &v1beta1.KogitoInfra{}))
+}
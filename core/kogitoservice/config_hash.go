@@ -0,0 +1,156 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoservice
+
+import (
+	"sort"
+
+	"github.com/kiegroup/kogito-operator/core/framework/util"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// configHashAnnotation is the pod template annotation holding the hash of the ConfigMaps and Secrets consumed by the pods.
+	// Whenever one of them changes, the new hash triggers a rollout of the Deployment.
+	configHashAnnotation = "kogito.kie.org/config-hash"
+)
+
+// ConfigHashHandler computes the hash of the configuration consumed by a Deployment
+type ConfigHashHandler interface {
+	AddConfigHash(deployment *appsv1.Deployment) error
+}
+
+type configHashHandler struct {
+	operator.Context
+	configMapHandler infrastructure.ConfigMapHandler
+	secretHandler    infrastructure.SecretHandler
+}
+
+// NewConfigHashHandler ...
+func NewConfigHashHandler(context operator.Context) ConfigHashHandler {
+	return &configHashHandler{
+		Context:          context,
+		configMapHandler: infrastructure.NewConfigMapHandler(context),
+		secretHandler:    infrastructure.NewSecretHandler(context),
+	}
+}
+
+// AddConfigHash annotates the pod template of the given Deployment with the hash of the ConfigMaps and Secrets it references.
+// References to missing objects are hashed by name only, so that their creation rolls the pods out as well.
+func (c *configHashHandler) AddConfigHash(deployment *appsv1.Deployment) error {
+	configMaps, secrets := GetPodConfigReferences(&deployment.Spec.Template.Spec)
+	if len(configMaps) == 0 && len(secrets) == 0 {
+		return nil
+	}
+	entries := map[string]string{}
+	for _, name := range configMaps {
+		configMap, err := c.configMapHandler.FetchConfigMap(types.NamespacedName{Name: name, Namespace: deployment.Namespace})
+		if err != nil {
+			return err
+		}
+		entries["configmap/"+name] = ""
+		if configMap != nil {
+			for key, value := range configMap.Data {
+				entries["configmap/"+name+"/data/"+key] = value
+			}
+			for key, value := range configMap.BinaryData {
+				entries["configmap/"+name+"/binaryData/"+key] = string(value)
+			}
+		}
+	}
+	for _, name := range secrets {
+		secret, err := c.secretHandler.FetchSecret(types.NamespacedName{Name: name, Namespace: deployment.Namespace})
+		if err != nil {
+			return err
+		}
+		entries["secret/"+name] = ""
+		if secret != nil {
+			for key, value := range secret.Data {
+				entries["secret/"+name+"/data/"+key] = string(value)
+			}
+		}
+	}
+	if deployment.Spec.Template.Annotations == nil {
+		deployment.Spec.Template.Annotations = map[string]string{}
+	}
+	deployment.Spec.Template.Annotations[configHashAnnotation] = util.GenerateMD5Hash(entries)
+	return nil
+}
+
+// GetPodConfigReferences lists the names of the ConfigMaps and Secrets consumed by the given PodSpec
+// through volumes, envFrom and env sources. Both lists are sorted and free of duplicates.
+func GetPodConfigReferences(pod *corev1.PodSpec) (configMaps []string, secrets []string) {
+	configMapSet := map[string]bool{}
+	secretSet := map[string]bool{}
+	for _, volume := range pod.Volumes {
+		if volume.ConfigMap != nil {
+			configMapSet[volume.ConfigMap.Name] = true
+		}
+		if volume.Secret != nil {
+			secretSet[volume.Secret.SecretName] = true
+		}
+		if volume.Projected != nil {
+			for _, source := range volume.Projected.Sources {
+				if source.ConfigMap != nil {
+					configMapSet[source.ConfigMap.Name] = true
+				}
+				if source.Secret != nil {
+					secretSet[source.Secret.Name] = true
+				}
+			}
+		}
+	}
+	containers := append(append([]corev1.Container{}, pod.InitContainers...), pod.Containers...)
+	for _, container := range containers {
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.ConfigMapRef != nil {
+				configMapSet[envFrom.ConfigMapRef.Name] = true
+			}
+			if envFrom.SecretRef != nil {
+				secretSet[envFrom.SecretRef.Name] = true
+			}
+		}
+		for _, env := range container.Env {
+			if env.ValueFrom == nil {
+				continue
+			}
+			if env.ValueFrom.ConfigMapKeyRef != nil {
+				configMapSet[env.ValueFrom.ConfigMapKeyRef.Name] = true
+			}
+			if env.ValueFrom.SecretKeyRef != nil {
+				secretSet[env.ValueFrom.SecretKeyRef.Name] = true
+			}
+		}
+	}
+	return sortedKeys(configMapSet), sortedKeys(secretSet)
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		if len(key) > 0 {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
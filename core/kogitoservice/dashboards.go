@@ -21,7 +21,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
-	"k8s.io/apimachinery/pkg/types"
 	"net/http"
 	"regexp"
 	"strings"
@@ -90,29 +89,16 @@ func (d *grafanaDashboardManager) isGrafanaAvailable() bool {
 }
 
 func (d *grafanaDashboardManager) fetchGrafanaDashboards(instance api.KogitoService) ([]GrafanaDashboard, error) {
-	deploymentHandler := infrastructure.NewDeploymentHandler(d.Context)
-	available, err := deploymentHandler.IsDeploymentAvailable(types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
-	if err != nil {
-		return nil, err
-	}
-	if !available {
-		d.Log.Debug("Deployment not yet available")
-		return nil, nil
-	}
-
-	kogitoServiceHandler := NewKogitoServiceHandler(d.Context)
-	svcURL := kogitoServiceHandler.GetKogitoServiceURL(instance)
-	dashboardNames, err := d.fetchGrafanaDashboardNamesForURL(svcURL)
-	if err != nil {
+	metadata, err := newServiceIntrospector(d.Context).getMetadata(instance)
+	if err != nil || metadata == nil {
 		return nil, err
 	}
-
-	return d.fetchDashboards(svcURL, dashboardNames)
+	return metadata.dashboards, metadata.dashboardsErr
 }
 
 func (d *grafanaDashboardManager) fetchGrafanaDashboardNamesForURL(serverURL string) ([]string, error) {
 	dashboardsURL := fmt.Sprintf("%s%s%s", serverURL, dashboardsPath, "list.json")
-	resp, err := http.Get(dashboardsURL)
+	resp, err := introspectionHTTPClient.Get(dashboardsURL)
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +133,7 @@ func (d *grafanaDashboardManager) fetchDashboards(serverURL string, dashboardNam
 
 // we create a separate function to be able to `defer` the HTTP response after the function call.
 func (d *grafanaDashboardManager) fetchDashboard(name, dashboardURL string) (*GrafanaDashboard, error) {
-	resp, err := http.Get(dashboardURL)
+	resp, err := introspectionHTTPClient.Get(dashboardURL)
 	if err != nil {
 		return nil, err
 	}
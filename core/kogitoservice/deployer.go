@@ -56,10 +56,6 @@ type ServiceDefinition struct {
 	Envs                       []v1.EnvVar
 }
 
-const (
-	defaultReplicas = int32(1)
-)
-
 // ServiceDeployer is the API to handle a Kogito Service deployment by Operator SDK controllers
 type ServiceDeployer interface {
 	// Deploy deploys the Kogito Service in the Kubernetes cluster according to a given ServiceDefinition
@@ -95,9 +91,7 @@ func newRecorder(scheme *runtime.Scheme, eventSourceName string) record.EventRec
 }
 
 func (s *serviceDeployer) Deploy() error {
-	if s.instance.GetSpec().GetReplicas() == nil {
-		s.instance.GetSpec().SetReplicas(defaultReplicas)
-	}
+	SetDefaults(s.instance)
 	if len(s.definition.DefaultImageName) == 0 {
 		s.definition.DefaultImageName = s.definition.Request.Name
 	}
@@ -130,24 +124,31 @@ func (s *serviceDeployer) Deploy() error {
 		return err
 	}
 
-	imageHandler := s.newImageHandler()
-	if err = imageHandler.ReconcileImageStream(s.instance); err != nil {
+	kafkaUserReconciler := newKafkaUserReconciler(s.Context, s.instance, &s.definition, s.infraHandler)
+	if err = kafkaUserReconciler.Reconcile(); err != nil {
 		return err
 	}
 
-	deploymentReconciler := newDeploymentReconciler(s.Context, s.instance, s.definition, imageHandler)
-	if err = deploymentReconciler.Reconcile(); err != nil {
+	serviceAccountReconciler := newServiceAccountReconciler(s.Context, s.instance)
+	if err = serviceAccountReconciler.Reconcile(); err != nil {
 		return err
 	}
 
-	serviceReconciler := newServiceReconciler(s.Context, s.instance)
-	if err = serviceReconciler.Reconcile(); err != nil {
+	imageHandler := s.newImageHandler()
+	if err = imageHandler.ReconcileImageStream(s.instance); err != nil {
 		return err
 	}
 
-	routeReconciler := newRouteReconciler(s.Context, s.instance)
-	if err = routeReconciler.Reconcile(); err != nil {
-		s.Log.Info("Error occurs while reconciling route", "err", err)
+	knativeServiceReconciler := newKnativeServiceReconciler(s.Context, s.instance, s.definition, imageHandler)
+	if err = knativeServiceReconciler.Reconcile(); err != nil {
+		return err
+	}
+
+	// Knative Services are exposed by Knative itself
+	if !isKnativeServing(s.instance) {
+		if err = s.deployWorkload(imageHandler); err != nil {
+			return err
+		}
 	}
 
 	err = s.configureMonitoring()
@@ -160,15 +161,56 @@ func (s *serviceDeployer) Deploy() error {
 	return err
 }
 
+// deployWorkload deploys the service with a Deployment exposed by a Service, and a Route or an Ingress
+func (s *serviceDeployer) deployWorkload(imageHandler infrastructure.ImageHandler) error {
+	hpaReconciler := newHorizontalPodAutoscalerReconciler(s.Context, s.instance, s.definition)
+	if err := hpaReconciler.Reconcile(); err != nil {
+		return err
+	}
+
+	deploymentReconciler := newDeploymentReconciler(s.Context, s.instance, s.definition, imageHandler)
+	if err := deploymentReconciler.Reconcile(); err != nil {
+		return err
+	}
+
+	serviceReconciler := newServiceReconciler(s.Context, s.instance)
+	if err := serviceReconciler.Reconcile(); err != nil {
+		return err
+	}
+
+	pdbReconciler := newPodDisruptionBudgetReconciler(s.Context, s.instance)
+	if err := pdbReconciler.Reconcile(); err != nil {
+		return err
+	}
+
+	routeReconciler := newRouteReconciler(s.Context, s.instance)
+	if err := routeReconciler.Reconcile(); err != nil {
+		s.Log.Info("Error occurs while reconciling route", "err", err)
+	}
+
+	ingressReconciler := newIngressReconciler(s.Context, s.instance)
+	if err := ingressReconciler.Reconcile(); err != nil {
+		s.Log.Info("Error occurs while reconciling ingress", "err", err)
+	}
+	return nil
+}
+
 func (s *serviceDeployer) configureMessaging() error {
 	s.Log.Debug("Going to configuring messaging")
 	kafkaMessagingDeployer := NewKafkaMessagingDeployer(s.Context, s.definition, s.infraHandler)
 	if err := kafkaMessagingDeployer.CreateRequiredResources(s.instance); err != nil {
+		// the Kafka namespace must be added to the watched ones, it's reported as is to point to the fix
+		if s.isIntrospectionInProgress(err) || s.errorHandler.GetReasonForError(err) == infrastructure.NamespaceNotWatchedReason {
+			return err
+		}
 		return infrastructure.ErrorForMessaging(err)
 	}
 
 	knativeMessagingDeployer := NewKnativeMessagingDeployer(s.Context, s.definition, s.infraHandler)
 	if err := knativeMessagingDeployer.CreateRequiredResources(s.instance); err != nil {
+		if s.isIntrospectionInProgress(err) {
+			return err
+		}
 		return infrastructure.ErrorForMessaging(err)
 	}
 	return nil
@@ -178,12 +220,18 @@ func (s *serviceDeployer) configureMonitoring() error {
 	s.Log.Debug("Going to configuring monitoring")
 	prometheusManager := NewPrometheusManager(s.Context)
 	if err := prometheusManager.ConfigurePrometheus(s.instance); err != nil {
+		if s.isIntrospectionInProgress(err) {
+			return err
+		}
 		s.Log.Error(err, "Could not deploy prometheus monitoring")
 		return infrastructure.ErrorForMonitoring(err)
 	}
 
 	grafanaDashboardManager := NewGrafanaDashboardManager(s.Context)
 	if err := grafanaDashboardManager.ConfigureGrafanaDashboards(s.instance); err != nil {
+		if s.isIntrospectionInProgress(err) {
+			return err
+		}
 		s.Log.Error(err, "Could not deploy grafana dashboards")
 		return infrastructure.ErrorForDashboards(err)
 	}
@@ -191,6 +239,11 @@ func (s *serviceDeployer) configureMonitoring() error {
 	return nil
 }
 
+// isIntrospectionInProgress checks if the error is only due to the service metadata not fetched yet, it's reported as is to requeue the reconciliation
+func (s *serviceDeployer) isIntrospectionInProgress(err error) bool {
+	return s.errorHandler.GetReasonForError(err) == infrastructure.IntrospectionInProgressReason
+}
+
 func (s *serviceDeployer) newImageHandler() infrastructure.ImageHandler {
 	addDockerImageReference := len(s.instance.GetSpec().GetImage()) != 0 || !s.definition.CustomService
 	image := s.resolveImage()
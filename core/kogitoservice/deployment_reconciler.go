@@ -42,10 +42,15 @@ type deploymentReconciler struct {
 	imageHandler            infrastructure.ImageHandler
 	kogitoDeploymentHandler KogitoDeploymentHandler
 	deploymentHandler       infrastructure.DeploymentHandler
+	configHashHandler       ConfigHashHandler
 	deltaProcessor          infrastructure.DeltaProcessor
 }
 
 func newDeploymentReconciler(context operator.Context, instance api.KogitoService, definition ServiceDefinition, imageHandler infrastructure.ImageHandler) DeploymentReconciler {
+	return newKogitoDeploymentReconciler(context, instance, definition, imageHandler)
+}
+
+func newKogitoDeploymentReconciler(context operator.Context, instance api.KogitoService, definition ServiceDefinition, imageHandler infrastructure.ImageHandler) *deploymentReconciler {
 	return &deploymentReconciler{
 		Context:                 context,
 		instance:                instance,
@@ -53,6 +58,7 @@ func newDeploymentReconciler(context operator.Context, instance api.KogitoServic
 		definition:              definition,
 		kogitoDeploymentHandler: NewKogitoDeploymentHandler(context),
 		deploymentHandler:       infrastructure.NewDeploymentHandler(context),
+		configHashHandler:       NewConfigHashHandler(context),
 		deltaProcessor:          infrastructure.NewDeltaProcessor(context),
 	}
 }
@@ -79,31 +85,58 @@ func (d *deploymentReconciler) Reconcile() error {
 		return err
 	}
 
+	d.keepAutoscaledReplicas(requestedResources, deployedResources)
+	d.keepConfigHash(requestedResources, deployedResources)
+
+	if runtime, ok := d.instance.(api.KogitoRuntimeInterface); ok {
+		rolloutReconciler := newRolloutReconciler(d.Context, runtime)
+		if err = rolloutReconciler.Reconcile(requestedResources, deployedResources); err != nil {
+			return err
+		}
+	}
+
 	// Process Delta
 	return d.processDelta(requestedResources, deployedResources)
 }
 
 func (d *deploymentReconciler) createRequiredResources(imageName string) (map[reflect.Type][]client.Object, error) {
 	resources := make(map[reflect.Type][]client.Object)
-	deployment := d.kogitoDeploymentHandler.CreateDeployment(d.instance, imageName, d.definition)
-	if err := d.onDeploymentCreate(deployment); err != nil {
+	deployment, err := d.createDeployment(imageName)
+	if err != nil {
 		return resources, err
 	}
+	if err := framework.SetOwner(d.instance, d.Scheme, deployment); err != nil {
+		return nil, err
+	}
+	resources[reflect.TypeOf(appsv1.Deployment{})] = []client.Object{deployment}
+	return resources, nil
+}
+
+// createDeployment creates the Deployment of the service with its envs, config references and labels
+func (d *deploymentReconciler) createDeployment(imageName string) (*appsv1.Deployment, error) {
+	deployment, err := d.kogitoDeploymentHandler.CreateDeployment(d.instance, imageName, d.definition)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.onDeploymentCreate(deployment); err != nil {
+		return nil, err
+	}
 
 	d.addDeploymentIdentifier(deployment)
 	d.mountEnvsOnDeployment(deployment)
 	if err := d.mountConfigMapReferencesOnDeployment(deployment); err != nil {
-		return resources, err
+		return nil, err
 	}
 	if err := d.mountSecretReferencesOnDeployment(deployment); err != nil {
-		return resources, err
+		return nil, err
 	}
 	d.mountMeteringLabelsOnDeployment(deployment)
-	if err := framework.SetOwner(d.instance, d.Scheme, deployment); err != nil {
-		return nil, err
+	if !d.instance.GetSpec().IsConfigRolloutDisabled() {
+		if err := d.configHashHandler.AddConfigHash(deployment); err != nil {
+			return nil, err
+		}
 	}
-	resources[reflect.TypeOf(appsv1.Deployment{})] = []client.Object{deployment}
-	return resources, nil
+	return deployment, nil
 }
 
 func (d *deploymentReconciler) getDeployedResources() (map[reflect.Type][]client.Object, error) {
@@ -118,6 +151,40 @@ func (d *deploymentReconciler) getDeployedResources() (map[reflect.Type][]client
 	return resources, nil
 }
 
+// keepAutoscaledReplicas leaves the replicas of the deployed Deployment to the HorizontalPodAutoscaler
+func (d *deploymentReconciler) keepAutoscaledReplicas(requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) {
+	if !d.instance.GetSpec().GetAutoscaling().IsEnabled() || d.definition.SingleReplica {
+		return
+	}
+	deployed := deployedResources[reflect.TypeOf(appsv1.Deployment{})]
+	if len(deployed) == 0 {
+		return
+	}
+	requested := requestedResources[reflect.TypeOf(appsv1.Deployment{})][0].(*appsv1.Deployment)
+	requested.Spec.Replicas = deployed[0].(*appsv1.Deployment).Spec.Replicas
+}
+
+// keepConfigHash leaves the configuration hash of the deployed Deployment untouched when the config rollout is disabled,
+// so that opting out doesn't restart the pods
+func (d *deploymentReconciler) keepConfigHash(requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) {
+	if !d.instance.GetSpec().IsConfigRolloutDisabled() {
+		return
+	}
+	deployed := deployedResources[reflect.TypeOf(appsv1.Deployment{})]
+	if len(deployed) == 0 {
+		return
+	}
+	hash, exists := deployed[0].(*appsv1.Deployment).Spec.Template.Annotations[configHashAnnotation]
+	if !exists {
+		return
+	}
+	requested := requestedResources[reflect.TypeOf(appsv1.Deployment{})][0].(*appsv1.Deployment)
+	if requested.Spec.Template.Annotations == nil {
+		requested.Spec.Template.Annotations = map[string]string{}
+	}
+	requested.Spec.Template.Annotations[configHashAnnotation] = hash
+}
+
 func (d *deploymentReconciler) processDelta(requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) (err error) {
 	comparator := d.deploymentHandler.GetComparator()
 	_, err = d.deltaProcessor.ProcessDelta(comparator, requestedResources, deployedResources)
@@ -125,7 +192,9 @@ func (d *deploymentReconciler) processDelta(requestedResources map[reflect.Type]
 }
 
 func (d *deploymentReconciler) onDeploymentCreate(deployment *appsv1.Deployment) error {
-	if d.Client.IsOpenshift() {
+	// image changes are rolled out by the operator with the BlueGreen and Canary strategies, not by the image trigger,
+	// the trigger doesn't support Knative Services either
+	if d.Client.IsOpenshift() && !hasRolloutStrategy(d.instance) && !isKnativeServing(d.instance) {
 		key, value := d.imageHandler.ResolveImageStreamTriggerAnnotation(d.instance.GetName())
 		deployment.Annotations = map[string]string{key: value}
 	}
@@ -26,6 +26,7 @@ import (
 	"github.com/kiegroup/kogito-operator/meta"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	v13 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"testing"
 )
@@ -54,3 +55,102 @@ func TestDeploymentReconciler(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, exists)
 }
+
+func TestDeploymentReconciler_ConfigHash(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	configMap := &corev1.ConfigMap{ObjectMeta: v13.ObjectMeta{Name: "app-properties", Namespace: ns}, Data: map[string]string{"application.properties": "a=1"}}
+	secret := &corev1.Secret{ObjectMeta: v13.ObjectMeta{Name: "app-credentials", Namespace: ns}, Data: map[string][]byte{"password": []byte("secret")}}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, configMap, secret).Build()
+	serviceDefinition := ServiceDefinition{
+		ConfigMapVolumeReferences: []api.VolumeReferenceInterface{&VolumeReference{Name: configMap.Name, MountPath: "/home/kogito/config"}},
+		SecretEnvFromReferences:   []string{secret.Name},
+	}
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	imageHandler := infrastructure.NewImageHandler(context, &api.Image{Name: "test-image", Tag: "1.0"}, "default-image", "image-stream", ns, false, false)
+	deployment := &v1.Deployment{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+
+	assert.NoError(t, newDeploymentReconciler(context, instance, serviceDefinition, imageHandler).Reconcile())
+	_, err := kubernetes.ResourceC(cli).Fetch(deployment)
+	assert.NoError(t, err)
+	firstHash := deployment.Spec.Template.Annotations[configHashAnnotation]
+	assert.NotEmpty(t, firstHash)
+
+	// a change in the Secret rolls the pods out
+	secret.Data["password"] = []byte("changed")
+	assert.NoError(t, kubernetes.ResourceC(cli).Update(secret))
+	assert.NoError(t, newDeploymentReconciler(context, instance, serviceDefinition, imageHandler).Reconcile())
+	_, err = kubernetes.ResourceC(cli).Fetch(deployment)
+	assert.NoError(t, err)
+	secondHash := deployment.Spec.Template.Annotations[configHashAnnotation]
+	assert.NotEmpty(t, secondHash)
+	assert.NotEqual(t, firstHash, secondHash)
+
+	// opting out keeps the pods untouched
+	instance.Spec.DisableConfigRollout = true
+	configMap.Data["application.properties"] = "a=2"
+	assert.NoError(t, kubernetes.ResourceC(cli).Update(configMap))
+	assert.NoError(t, newDeploymentReconciler(context, instance, serviceDefinition, imageHandler).Reconcile())
+	_, err = kubernetes.ResourceC(cli).Fetch(deployment)
+	assert.NoError(t, err)
+	assert.Equal(t, secondHash, deployment.Spec.Template.Annotations[configHashAnnotation])
+}
+
+func TestDeploymentReconciler_Scheduling(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.NodeSelector = map[string]string{"topology.kubernetes.io/zone": "eu-west-1a"}
+	instance.Spec.Tolerations = []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "kogito", Effect: corev1.TaintEffectNoSchedule}}
+	instance.Spec.TopologySpreadConstraints = []corev1.TopologySpreadConstraint{{MaxSkew: 1, TopologyKey: "topology.kubernetes.io/zone", WhenUnsatisfiable: corev1.DoNotSchedule}}
+	instance.Spec.PriorityClassName = "high-priority"
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	imageHandler := infrastructure.NewImageHandler(context, &api.Image{Name: "test-image", Tag: "1.0"}, "default-image", "image-stream", ns, false, false)
+	assert.NoError(t, newDeploymentReconciler(context, instance, ServiceDefinition{}, imageHandler).Reconcile())
+
+	deployment := &v1.Deployment{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: ns}}
+	_, err := kubernetes.ResourceC(cli).Fetch(deployment)
+	assert.NoError(t, err)
+	podSpec := deployment.Spec.Template.Spec
+	assert.Equal(t, instance.Spec.NodeSelector, podSpec.NodeSelector)
+	assert.Equal(t, instance.Spec.Tolerations, podSpec.Tolerations)
+	assert.Equal(t, "high-priority", podSpec.PriorityClassName)
+	assert.Len(t, podSpec.TopologySpreadConstraints, 1)
+	// defaults to the pods of the service
+	assert.Equal(t, map[string]string{"app": instance.Name}, podSpec.TopologySpreadConstraints[0].LabelSelector.MatchLabels)
+
+	// changes made out of the operator are reverted
+	deployment.Spec.Template.Spec.NodeSelector = map[string]string{"topology.kubernetes.io/zone": "eu-west-1b"}
+	assert.NoError(t, kubernetes.ResourceC(cli).Update(deployment))
+	assert.NoError(t, newDeploymentReconciler(context, instance, ServiceDefinition{}, imageHandler).Reconcile())
+	_, err = kubernetes.ResourceC(cli).Fetch(deployment)
+	assert.NoError(t, err)
+	assert.Equal(t, instance.Spec.NodeSelector, deployment.Spec.Template.Spec.NodeSelector)
+}
+
+func TestGetPodConfigReferences(t *testing.T) {
+	pod := &corev1.PodSpec{
+		Volumes: []corev1.Volume{
+			{Name: "config", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "cm-volume"}}}},
+			{Name: "truststore", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "truststore"}}},
+		},
+		Containers: []corev1.Container{{
+			EnvFrom: []corev1.EnvFromSource{{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "cm-env"}}}},
+			Env: []corev1.EnvVar{
+				{Name: "PASSWORD", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "truststore"}, Key: "password"}}},
+				{Name: "PLAIN", Value: "value"},
+			},
+		}},
+	}
+	configMaps, secrets := GetPodConfigReferences(pod)
+	assert.Equal(t, []string{"cm-env", "cm-volume"}, configMaps)
+	assert.Equal(t, []string{"truststore"}, secrets)
+}
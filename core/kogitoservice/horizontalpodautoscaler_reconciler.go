@@ -0,0 +1,114 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoservice
+
+import (
+	"reflect"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// HorizontalPodAutoscalerReconciler ...
+type HorizontalPodAutoscalerReconciler interface {
+	Reconcile() error
+}
+
+type horizontalPodAutoscalerReconciler struct {
+	operator.Context
+	instance       api.KogitoService
+	definition     ServiceDefinition
+	hpaHandler     infrastructure.HorizontalPodAutoscalerHandler
+	deltaProcessor infrastructure.DeltaProcessor
+}
+
+func newHorizontalPodAutoscalerReconciler(context operator.Context, instance api.KogitoService, definition ServiceDefinition) HorizontalPodAutoscalerReconciler {
+	return &horizontalPodAutoscalerReconciler{
+		Context:        context,
+		instance:       instance,
+		definition:     definition,
+		hpaHandler:     infrastructure.NewHorizontalPodAutoscalerHandler(context),
+		deltaProcessor: infrastructure.NewDeltaProcessor(context),
+	}
+}
+
+func (h *horizontalPodAutoscalerReconciler) Reconcile() error {
+	if h.definition.SingleReplica && h.instance.GetSpec().GetAutoscaling().IsEnabled() {
+		return infrastructure.ErrorForAutoscalingNotSupported(h.instance.GetName())
+	}
+	// without the API, there can't be any HorizontalPodAutoscaler to reconcile
+	if !h.Client.HasServerGroupVersion(autoscalingv2.SchemeGroupVersion) {
+		if h.instance.GetSpec().GetAutoscaling().IsEnabled() {
+			return infrastructure.ErrorForAPINotAvailable(h.instance.GetName(), autoscalingv2.SchemeGroupVersion.String())
+		}
+		return nil
+	}
+
+	// Create Required resource
+	requestedResources, err := h.createRequiredResources()
+	if err != nil {
+		return err
+	}
+
+	// Get Deployed resource
+	deployedResources, err := h.getDeployedResources()
+	if err != nil {
+		return err
+	}
+
+	// Process Delta
+	return h.processDelta(requestedResources, deployedResources)
+}
+
+func (h *horizontalPodAutoscalerReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	if !h.instance.GetSpec().GetAutoscaling().IsEnabled() {
+		h.Log.Debug("Skipping HorizontalPodAutoscaler creation. Autoscaling is not enabled.")
+		return resources, nil
+	}
+	hpa := h.hpaHandler.CreateHorizontalPodAutoscaler(h.instance)
+	if err := framework.SetOwner(h.instance, h.Scheme, hpa); err != nil {
+		return nil, err
+	}
+	resources[reflect.TypeOf(autoscalingv2.HorizontalPodAutoscaler{})] = []client.Object{hpa}
+	return resources, nil
+}
+
+func (h *horizontalPodAutoscalerReconciler) getDeployedResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	hpa, err := h.hpaHandler.FetchHorizontalPodAutoscaler(types.NamespacedName{Name: h.instance.GetName(), Namespace: h.instance.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	// only handles the HorizontalPodAutoscaler created by the operator
+	if hpa != nil && framework.IsOwner(hpa, h.instance) {
+		resources[reflect.TypeOf(autoscalingv2.HorizontalPodAutoscaler{})] = []client.Object{hpa}
+	}
+	return resources, nil
+}
+
+func (h *horizontalPodAutoscalerReconciler) processDelta(requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) (err error) {
+	comparator := h.hpaHandler.GetComparator()
+	_, err = h.deltaProcessor.ProcessDelta(comparator, requestedResources, deployedResources)
+	return
+}
@@ -0,0 +1,168 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoservice
+
+import (
+	"testing"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v13 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	discfake "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestHorizontalPodAutoscalerReconciler(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	memory := int32(70)
+	instance.Spec.Autoscaling = v1beta1.Autoscaling{
+		MaxReplicas:                       5,
+		TargetMemoryUtilizationPercentage: &memory,
+		Metrics:                           []v1beta1.AutoscalingMetric{{Name: "kogito_process_instance_running_total", TargetAverageValue: resource.MustParse("100")}},
+	}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	err := newHorizontalPodAutoscalerReconciler(context, instance, ServiceDefinition{}).Reconcile()
+	assert.NoError(t, err)
+
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(hpa)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, instance.Name, hpa.Spec.ScaleTargetRef.Name)
+	assert.Equal(t, "Deployment", hpa.Spec.ScaleTargetRef.Kind)
+	assert.Equal(t, int32(1), *hpa.Spec.MinReplicas)
+	assert.Equal(t, int32(5), hpa.Spec.MaxReplicas)
+	assert.Len(t, hpa.Spec.Metrics, 2)
+	assert.Equal(t, corev1.ResourceMemory, hpa.Spec.Metrics[0].Resource.Name)
+	assert.Equal(t, memory, *hpa.Spec.Metrics[0].Resource.Target.AverageUtilization)
+	assert.Equal(t, autoscalingv2.PodsMetricSourceType, hpa.Spec.Metrics[1].Type)
+	assert.Equal(t, "kogito_process_instance_running_total", hpa.Spec.Metrics[1].Pods.Metric.Name)
+
+	// disabling autoscaling removes the HorizontalPodAutoscaler
+	instance.Spec.Autoscaling = v1beta1.Autoscaling{}
+	err = newHorizontalPodAutoscalerReconciler(context, instance, ServiceDefinition{}).Reconcile()
+	assert.NoError(t, err)
+	exists, err = kubernetes.ResourceC(cli).Fetch(hpa)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestHorizontalPodAutoscalerReconciler_DefaultTarget(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.Autoscaling = v1beta1.Autoscaling{MaxReplicas: 3}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	err := newHorizontalPodAutoscalerReconciler(context, instance, ServiceDefinition{}).Reconcile()
+	assert.NoError(t, err)
+
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	_, err = kubernetes.ResourceC(cli).Fetch(hpa)
+	assert.NoError(t, err)
+	assert.Len(t, hpa.Spec.Metrics, 1)
+	assert.Equal(t, corev1.ResourceCPU, hpa.Spec.Metrics[0].Resource.Name)
+	assert.Equal(t, int32(80), *hpa.Spec.Metrics[0].Resource.Target.AverageUtilization)
+}
+
+func TestHorizontalPodAutoscalerReconciler_SingleReplica(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeJobsService(ns)
+	instance.Spec.Autoscaling = v1beta1.Autoscaling{MaxReplicas: 3}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	err := newHorizontalPodAutoscalerReconciler(context, instance, ServiceDefinition{SingleReplica: true}).Reconcile()
+	assert.Error(t, err)
+	assert.Equal(t, infrastructure.AutoscalingNotSupportedReason, infrastructure.NewReconciliationErrorHandler(context).GetReasonForError(err))
+
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(hpa)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestHorizontalPodAutoscalerReconciler_APINotAvailable(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	// a cluster that doesn't serve autoscaling/v2 yet
+	cli.Discovery = &discfake.FakeDiscovery{Fake: &clienttesting.Fake{}}
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	err := newHorizontalPodAutoscalerReconciler(context, instance, ServiceDefinition{}).Reconcile()
+	assert.NoError(t, err)
+
+	instance.Spec.Autoscaling = v1beta1.Autoscaling{MaxReplicas: 3}
+	err = newHorizontalPodAutoscalerReconciler(context, instance, ServiceDefinition{}).Reconcile()
+	assert.Error(t, err)
+	assert.Equal(t, infrastructure.APINotAvailableReason, infrastructure.NewReconciliationErrorHandler(context).GetReasonForError(err))
+}
+
+func TestDeploymentReconciler_AutoscaledReplicas(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.Autoscaling = v1beta1.Autoscaling{MaxReplicas: 5}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	image := &api.Image{Name: "test-image", Tag: "1.0"}
+	imageHandler := infrastructure.NewImageHandler(context, image, "default-image", "image-stream", ns, false, false)
+	assert.NoError(t, newDeploymentReconciler(context, instance, ServiceDefinition{}, imageHandler).Reconcile())
+
+	// scaled by the HorizontalPodAutoscaler
+	deployment := &appsv1.Deployment{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	_, err := kubernetes.ResourceC(cli).Fetch(deployment)
+	assert.NoError(t, err)
+	scaledReplicas := int32(4)
+	deployment.Spec.Replicas = &scaledReplicas
+	assert.NoError(t, kubernetes.ResourceC(cli).Update(deployment))
+
+	assert.NoError(t, newDeploymentReconciler(context, instance, ServiceDefinition{}, imageHandler).Reconcile())
+	_, err = kubernetes.ResourceC(cli).Fetch(deployment)
+	assert.NoError(t, err)
+	assert.Equal(t, scaledReplicas, *deployment.Spec.Replicas)
+}
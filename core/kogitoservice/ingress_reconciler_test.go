@@ -0,0 +1,110 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoservice
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	networkingv1 "k8s.io/api/networking/v1"
+	v13 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIngressReconciler_Openshift(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.Ingress.Host = "example.com"
+	cli := test.NewFakeClientBuilder().OnOpenShift().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	err := newIngressReconciler(context, instance).Reconcile()
+	assert.NoError(t, err)
+
+	ingress := &networkingv1.Ingress{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(ingress)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestIngressReconciler_K8sNoHost(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	err := newIngressReconciler(context, instance).Reconcile()
+	assert.NoError(t, err)
+
+	ingress := &networkingv1.Ingress{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(ingress)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestIngressReconciler_K8s(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.Ingress.Host = "example.com"
+	instance.Spec.Ingress.IngressClassName = "nginx"
+	instance.Spec.Ingress.TLSSecret = "example-tls"
+	instance.Spec.Ingress.Path = "/example"
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	err := newIngressReconciler(context, instance).Reconcile()
+	assert.NoError(t, err)
+
+	ingress := &networkingv1.Ingress{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(ingress)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, "nginx", *ingress.Spec.IngressClassName)
+	assert.Equal(t, "example-tls", ingress.Spec.TLS[0].SecretName)
+	assert.Equal(t, "example.com", ingress.Spec.Rules[0].Host)
+	assert.Equal(t, "/example", ingress.Spec.Rules[0].HTTP.Paths[0].Path)
+	assert.Equal(t, instance.Name, ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service.Name)
+
+	// host is updated
+	instance.Spec.Ingress.Host = "another.example.com"
+	err = newIngressReconciler(context, instance).Reconcile()
+	assert.NoError(t, err)
+	_, err = kubernetes.ResourceC(cli).Fetch(ingress)
+	assert.NoError(t, err)
+	assert.Equal(t, "another.example.com", ingress.Spec.Rules[0].Host)
+
+	// ingress is removed once routes are disabled
+	instance.Spec.DisableRoute = true
+	err = newIngressReconciler(context, instance).Reconcile()
+	assert.NoError(t, err)
+	exists, err = kubernetes.ResourceC(cli).Fetch(ingress)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
@@ -0,0 +1,191 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoservice
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// introspectionTimeout bounds every call made to the services, slow services can't block the reconciliation
+	introspectionTimeout = 10 * time.Second
+	// introspectionRetryInterval is the time after which metadata fetched with errors are fetched again
+	introspectionRetryInterval = 30 * time.Second
+	// introspectionWorkers is the maximum number of services introspected at the same time
+	introspectionWorkers = 10
+)
+
+var (
+	introspectionHTTPClient = &http.Client{Timeout: introspectionTimeout}
+	introspectionCache      = &serviceMetadataCache{entries: map[types.NamespacedName]*serviceMetadataEntry{}}
+	introspectionSemaphore  = make(chan struct{}, introspectionWorkers)
+)
+
+// serviceMetadata holds the metadata a Kogito service exposes about itself
+type serviceMetadata struct {
+	topics              []messagingTopic
+	topicsErr           error
+	monitoringAvailable bool
+	monitoringErr       error
+	dashboards          []GrafanaDashboard
+	dashboardsErr       error
+	fetchedAt           time.Time
+}
+
+func (m *serviceMetadata) hasErrors() bool {
+	return m.topicsErr != nil || m.monitoringErr != nil || m.dashboardsErr != nil
+}
+
+// serviceMetadataEntry holds the metadata of a given revision of a service, metadata is nil while being fetched
+type serviceMetadataEntry struct {
+	revision string
+	metadata *serviceMetadata
+}
+
+// serviceMetadataCache holds the metadata of the latest revision of every service, shared by all the reconciliations
+type serviceMetadataCache struct {
+	mutex   sync.Mutex
+	entries map[types.NamespacedName]*serviceMetadataEntry
+}
+
+// ForgetServiceMetadata removes the cached metadata of the given service, to be called once the service is deleted
+func ForgetServiceMetadata(key types.NamespacedName) {
+	introspectionCache.forget(key)
+}
+
+func (c *serviceMetadataCache) forget(key types.NamespacedName) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.entries, key)
+}
+
+// serviceIntrospector fetches the metadata exposed by the Kogito services, e.g. their messaging topics or monitoring dashboards.
+// Metadata are fetched asynchronously, once per revision of the service, then served from the cache.
+type serviceIntrospector struct {
+	operator.Context
+	cache *serviceMetadataCache
+}
+
+func newServiceIntrospector(context operator.Context) *serviceIntrospector {
+	return &serviceIntrospector{
+		Context: context,
+		cache:   introspectionCache,
+	}
+}
+
+// getMetadata gets the metadata of the service, nil if the service isn't available yet.
+// Returns an ErrorForIntrospectionInProgress while the metadata of its current revision are being fetched.
+func (i *serviceIntrospector) getMetadata(service api.KogitoService) (*serviceMetadata, error) {
+	available, err := NewKogitoServiceHandler(i.Context).IsKogitoServiceAvailable(service)
+	if err != nil {
+		return nil, err
+	}
+	if !available {
+		i.Log.Debug("Service not available yet, skipping introspection", "KogitoService", service.GetName())
+		return nil, nil
+	}
+	revision, err := i.getServiceRevision(service)
+	if err != nil {
+		return nil, err
+	}
+
+	key := types.NamespacedName{Name: service.GetName(), Namespace: service.GetNamespace()}
+	i.cache.mutex.Lock()
+	defer i.cache.mutex.Unlock()
+	entry := i.cache.entries[key]
+	if entry != nil && entry.revision == revision {
+		if entry.metadata == nil {
+			return nil, infrastructure.ErrorForIntrospectionInProgress(service.GetName())
+		}
+		if !entry.metadata.hasErrors() || time.Since(entry.metadata.fetchedAt) < introspectionRetryInterval {
+			return entry.metadata, nil
+		}
+	}
+
+	i.Log.Debug("Introspecting service", "KogitoService", service.GetName(), "revision", revision)
+	entry = &serviceMetadataEntry{revision: revision}
+	i.cache.entries[key] = entry
+	serverURL := NewKogitoServiceHandler(i.Context).GetKogitoServiceURL(service)
+	monitoringPath := getMonitoringPath(service.GetSpec().GetMonitoring(), service)
+	go func() {
+		introspectionSemaphore <- struct{}{}
+		defer func() { <-introspectionSemaphore }()
+		metadata := i.fetchMetadata(serverURL, monitoringPath)
+		i.cache.mutex.Lock()
+		defer i.cache.mutex.Unlock()
+		// a newer revision might have been requested meanwhile
+		if i.cache.entries[key] == entry {
+			entry.metadata = metadata
+		}
+	}()
+	return nil, infrastructure.ErrorForIntrospectionInProgress(service.GetName())
+}
+
+// getServiceRevision identifies the running version of the service by the image digests of its ready pods,
+// falling back to the image in the service status when no pod reports its digest, e.g. scaled to zero.
+// The monitoring path is part of the revision since it changes the fetched metadata.
+func (i *serviceIntrospector) getServiceRevision(service api.KogitoService) (string, error) {
+	pods := &corev1.PodList{}
+	if err := kubernetes.ResourceC(i.Client).ListWithNamespaceAndLabel(service.GetNamespace(), pods, map[string]string{framework.LabelAppKey: service.GetName()}); err != nil {
+		return "", err
+	}
+	imageIDs := map[string]bool{}
+	for _, pod := range pods.Items {
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Name == service.GetName() && status.Ready && len(status.ImageID) > 0 {
+				imageIDs[status.ImageID] = true
+			}
+		}
+	}
+	var images []string
+	for imageID := range imageIDs {
+		images = append(images, imageID)
+	}
+	sort.Strings(images)
+	if len(images) == 0 {
+		images = append(images, service.GetStatus().GetImage())
+	}
+	return strings.Join(images, ",") + "|" + getMonitoringPath(service.GetSpec().GetMonitoring(), service), nil
+}
+
+// fetchMetadata calls the service endpoints exposing its metadata, errors are kept to be reported by the consumers of each metadata
+func (i *serviceIntrospector) fetchMetadata(serverURL, monitoringPath string) *serviceMetadata {
+	metadata := &serviceMetadata{}
+	metadata.topics, metadata.topicsErr = fetchRequiredTopicsForURL(serverURL)
+	metadata.monitoringAvailable, metadata.monitoringErr = isMonitoringAddOnAvailable(serverURL + monitoringPath)
+	dashboardManager := grafanaDashboardManager{Context: i.Context}
+	dashboardNames, err := dashboardManager.fetchGrafanaDashboardNamesForURL(serverURL)
+	if err == nil {
+		metadata.dashboards, err = dashboardManager.fetchDashboards(serverURL, dashboardNames)
+	}
+	metadata.dashboardsErr = err
+	metadata.fetchedAt = time.Now()
+	return metadata
+}
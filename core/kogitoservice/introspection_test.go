@@ -0,0 +1,107 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoservice
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// waitForIntrospection blocks until the metadata of the current revision of the service are cached
+func waitForIntrospection(t *testing.T, context operator.Context, service api.KogitoService) {
+	introspector := newServiceIntrospector(context)
+	assert.Eventually(t, func() bool {
+		_, err := introspector.getMetadata(service)
+		return infrastructure.NewReconciliationErrorHandler(context).GetReasonForError(err) != infrastructure.IntrospectionInProgressReason
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func Test_serviceIntrospector_CachesMetadataPerRevision(t *testing.T) {
+	var topicsCalls int32
+	mux := http.NewServeMux()
+	mux.HandleFunc(topicInfoPath, func(writer http.ResponseWriter, request *http.Request) {
+		atomic.AddInt32(&topicsCalls, 1)
+		_, err := writer.Write([]byte(travellersTopicsResponse))
+		assert.NoError(t, err)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	deferFn := test.SetSharedEnv(EnvVarKogitoServiceURL, server.URL)
+	defer deferFn()
+
+	instance := createServiceInstance(t)
+	instance.GetStatus().SetImage("quay.io/kiegroup/data-index:1.0")
+	cli := test.NewFakeClientBuilder().AddK8sObjects(createAvailableDeployment(instance)).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	introspector := newServiceIntrospector(context)
+
+	// metadata are fetched in the background
+	_, err := introspector.getMetadata(instance)
+	assert.Error(t, err)
+	assert.Equal(t, infrastructure.IntrospectionInProgressReason, infrastructure.NewReconciliationErrorHandler(context).GetReasonForError(err))
+	waitForIntrospection(t, context, instance)
+	metadata, err := introspector.getMetadata(instance)
+	assert.NoError(t, err)
+	assert.Len(t, metadata.topics, 1)
+	assert.NoError(t, metadata.topicsErr)
+	assert.False(t, metadata.monitoringAvailable)
+	assert.Empty(t, metadata.dashboards)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&topicsCalls))
+
+	// a new image is introspected again
+	instance.GetStatus().SetImage("quay.io/kiegroup/data-index:2.0")
+	waitForIntrospection(t, context, instance)
+	_, err = introspector.getMetadata(instance)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&topicsCalls))
+
+	// the metadata of deleted services are evicted
+	key := types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()}
+	ForgetServiceMetadata(key)
+	introspectionCache.mutex.Lock()
+	assert.NotContains(t, introspectionCache.entries, key)
+	introspectionCache.mutex.Unlock()
+}
+
+func Test_serviceIntrospector_ServiceNotAvailable(t *testing.T) {
+	instance := createServiceInstance(t)
+	cli := test.NewFakeClientBuilder().Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	metadata, err := newServiceIntrospector(context).getMetadata(instance)
+	assert.NoError(t, err)
+	assert.Nil(t, metadata)
+}
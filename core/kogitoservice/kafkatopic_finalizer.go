@@ -0,0 +1,175 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoservice
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// KafkaTopicsFinalizer is set on the services that must delete their provisioned Kafka topics before being removed
+	KafkaTopicsFinalizer = "kogito.kie.org/kafka-topics"
+	// kafkaTopicServicesAnnotation lists the services, as namespace/name, using a Kafka topic provisioned by the operator.
+	// Topics without it have been created outside the operator.
+	kafkaTopicServicesAnnotation = "kogito.kie.org/kafka-topic-services"
+)
+
+// KafkaTopicsFinalizerHandler handles the deletion policy of the Kafka topics provisioned for a service
+type KafkaTopicsFinalizerHandler interface {
+	// Ensure adds the finalizer to the service when its provisioned topics must be deleted with it, or removes it otherwise
+	Ensure(service api.KogitoService) error
+	// Finalize deletes the provisioned topics no longer used by other services and releases the service
+	Finalize(service api.KogitoService) error
+}
+
+type kafkaTopicsFinalizerHandler struct {
+	operator.Context
+	kafkaHandler infrastructure.KafkaHandler
+}
+
+// NewKafkaTopicsFinalizerHandler ...
+func NewKafkaTopicsFinalizerHandler(context operator.Context) KafkaTopicsFinalizerHandler {
+	return &kafkaTopicsFinalizerHandler{
+		Context:      context,
+		kafkaHandler: infrastructure.NewKafkaHandler(context),
+	}
+}
+
+func (k *kafkaTopicsFinalizerHandler) Ensure(service api.KogitoService) error {
+	deleteTopics := service.GetSpec().GetKafkaTopics().GetDeletionPolicy() == api.DeleteKafkaTopicDeletionPolicy && hasProvisionedKafkaTopics(service)
+	if deleteTopics == controllerutil.ContainsFinalizer(service, KafkaTopicsFinalizer) {
+		return nil
+	}
+	// the status of the given instance is persisted at the end of the reconciliation, so it must not be overridden by the update
+	updated := service.DeepCopyObject().(api.KogitoService)
+	if deleteTopics {
+		controllerutil.AddFinalizer(updated, KafkaTopicsFinalizer)
+	} else {
+		controllerutil.RemoveFinalizer(updated, KafkaTopicsFinalizer)
+	}
+	if err := kubernetes.ResourceC(k.Client).Update(updated); err != nil {
+		return err
+	}
+	service.SetFinalizers(updated.GetFinalizers())
+	service.SetResourceVersion(updated.GetResourceVersion())
+	return nil
+}
+
+func (k *kafkaTopicsFinalizerHandler) Finalize(service api.KogitoService) error {
+	if !controllerutil.ContainsFinalizer(service, KafkaTopicsFinalizer) {
+		return nil
+	}
+	if k.kafkaHandler.IsStrimziAvailable() {
+		serviceKey := getKafkaTopicServiceKey(service)
+		for _, topic := range service.GetStatus().GetCloudEvents().GetTopics() {
+			// topics without namespace aren't Strimzi topics
+			if topic.GetState() != api.ProvisionedKafkaTopicState || len(topic.GetNamespace()) == 0 {
+				continue
+			}
+			if err := k.releaseKafkaTopic(types.NamespacedName{Name: topic.GetName(), Namespace: topic.GetNamespace()}, serviceKey); err != nil {
+				return err
+			}
+		}
+	}
+	controllerutil.RemoveFinalizer(service, KafkaTopicsFinalizer)
+	return kubernetes.ResourceC(k.Client).Update(service)
+}
+
+// releaseKafkaTopic removes the service from the users of the topic, and deletes the topic when nobody else uses it
+func (k *kafkaTopicsFinalizerHandler) releaseKafkaTopic(key types.NamespacedName, serviceKey string) error {
+	kafkaTopic, err := k.kafkaHandler.FetchKafkaTopic(key)
+	if err != nil || kafkaTopic == nil {
+		return err
+	}
+	services, provisioned := kafkaTopic.Annotations[kafkaTopicServicesAnnotation]
+	if !provisioned {
+		return nil
+	}
+	services = removeKafkaTopicService(services, serviceKey)
+	if len(services) == 0 {
+		k.Log.Info("Deleting kafka topic no longer used", "topicName", key.Name)
+		return k.kafkaHandler.DeleteKafkaTopic(kafkaTopic)
+	}
+	kafkaTopic.Annotations[kafkaTopicServicesAnnotation] = services
+	return k.kafkaHandler.UpdateKafkaTopic(kafkaTopic)
+}
+
+func hasProvisionedKafkaTopics(service api.KogitoService) bool {
+	for _, topic := range service.GetStatus().GetCloudEvents().GetTopics() {
+		if topic.GetState() == api.ProvisionedKafkaTopicState {
+			return true
+		}
+	}
+	return false
+}
+
+func getKafkaTopicServiceKey(service api.KogitoService) string {
+	return fmt.Sprintf("%s/%s", service.GetNamespace(), service.GetName())
+}
+
+func addKafkaTopicService(services, serviceKey string) string {
+	keys := splitKafkaTopicServices(services)
+	for _, key := range keys {
+		if key == serviceKey {
+			return services
+		}
+	}
+	return strings.Join(append(keys, serviceKey), ",")
+}
+
+func removeKafkaTopicService(services, serviceKey string) string {
+	var keys []string
+	for _, key := range splitKafkaTopicServices(services) {
+		if key != serviceKey {
+			keys = append(keys, key)
+		}
+	}
+	return strings.Join(keys, ",")
+}
+
+// MapKafkaTopicToServices maps a Kafka topic provisioned by the operator to the services using it.
+// Topics are shared and may live in the Kafka namespace, so they can't be owned by the services.
+func MapKafkaTopicToServices(object client.Object) []reconcile.Request {
+	var requests []reconcile.Request
+	for _, key := range splitKafkaTopicServices(object.GetAnnotations()[kafkaTopicServicesAnnotation]) {
+		if keyParts := strings.SplitN(key, "/", 2); len(keyParts) == 2 {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: keyParts[0], Name: keyParts[1]}})
+		}
+	}
+	return requests
+}
+
+func splitKafkaTopicServices(services string) []string {
+	var keys []string
+	for _, key := range strings.Split(services, ",") {
+		if len(key) > 0 {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
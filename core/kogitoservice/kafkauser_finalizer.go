@@ -0,0 +1,126 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoservice
+
+import (
+	"strings"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// KafkaUserFinalizer is set on the services whose KafkaUser lives in another namespace,
+	// since owner references can't cross namespaces the user isn't garbage collected with the service
+	KafkaUserFinalizer = "kogito.kie.org/kafka-user"
+	// kafkaUserAnnotation holds the KafkaUser, as namespace/name, created for the service in another namespace
+	kafkaUserAnnotation = "kogito.kie.org/kafka-user"
+)
+
+// KafkaUserFinalizerHandler handles the deletion of the KafkaUsers created for a service in another namespace
+type KafkaUserFinalizerHandler interface {
+	// Ensure adds the finalizer to the service when the given KafkaUser is in another namespace, or removes it otherwise.
+	// The KafkaUser previously created in another namespace is deleted if the service doesn't use it anymore.
+	Ensure(service api.KogitoService, kafkaUserKey *types.NamespacedName) error
+	// Finalize deletes the KafkaUser created in another namespace and releases the service
+	Finalize(service api.KogitoService) error
+}
+
+type kafkaUserFinalizerHandler struct {
+	operator.Context
+	kafkaHandler infrastructure.KafkaHandler
+}
+
+// NewKafkaUserFinalizerHandler ...
+func NewKafkaUserFinalizerHandler(context operator.Context) KafkaUserFinalizerHandler {
+	return &kafkaUserFinalizerHandler{
+		Context:      context,
+		kafkaHandler: infrastructure.NewKafkaHandler(context),
+	}
+}
+
+func (k *kafkaUserFinalizerHandler) Ensure(service api.KogitoService, kafkaUserKey *types.NamespacedName) error {
+	var crossNamespaceUser string
+	if kafkaUserKey != nil && kafkaUserKey.Namespace != service.GetNamespace() {
+		crossNamespaceUser = kafkaUserKey.String()
+	}
+	previousUser := service.GetAnnotations()[kafkaUserAnnotation]
+	if previousUser == crossNamespaceUser && (len(crossNamespaceUser) > 0) == controllerutil.ContainsFinalizer(service, KafkaUserFinalizer) {
+		return nil
+	}
+	if len(previousUser) > 0 && previousUser != crossNamespaceUser {
+		if err := k.deleteKafkaUser(previousUser); err != nil {
+			return err
+		}
+	}
+	// the status of the given instance is persisted at the end of the reconciliation, so it must not be overridden by the update
+	updated := service.DeepCopyObject().(api.KogitoService)
+	annotations := updated.GetAnnotations()
+	if len(crossNamespaceUser) > 0 {
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[kafkaUserAnnotation] = crossNamespaceUser
+		controllerutil.AddFinalizer(updated, KafkaUserFinalizer)
+	} else {
+		delete(annotations, kafkaUserAnnotation)
+		controllerutil.RemoveFinalizer(updated, KafkaUserFinalizer)
+	}
+	updated.SetAnnotations(annotations)
+	if err := kubernetes.ResourceC(k.Client).Update(updated); err != nil {
+		return err
+	}
+	service.SetAnnotations(updated.GetAnnotations())
+	service.SetFinalizers(updated.GetFinalizers())
+	service.SetResourceVersion(updated.GetResourceVersion())
+	return nil
+}
+
+func (k *kafkaUserFinalizerHandler) Finalize(service api.KogitoService) error {
+	if !controllerutil.ContainsFinalizer(service, KafkaUserFinalizer) {
+		return nil
+	}
+	if kafkaUser := service.GetAnnotations()[kafkaUserAnnotation]; len(kafkaUser) > 0 {
+		if err := k.deleteKafkaUser(kafkaUser); err != nil {
+			return err
+		}
+	}
+	controllerutil.RemoveFinalizer(service, KafkaUserFinalizer)
+	return kubernetes.ResourceC(k.Client).Update(service)
+}
+
+// deleteKafkaUser deletes the KafkaUser given as namespace/name, if it still exists
+func (k *kafkaUserFinalizerHandler) deleteKafkaUser(kafkaUser string) error {
+	if !k.kafkaHandler.IsStrimziAvailable() {
+		return nil
+	}
+	keys := strings.SplitN(kafkaUser, "/", 2)
+	if len(keys) != 2 {
+		return nil
+	}
+	deployedUser, err := k.kafkaHandler.FetchKafkaUser(types.NamespacedName{Namespace: keys[0], Name: keys[1]})
+	if err != nil || deployedUser == nil {
+		return err
+	}
+	k.Log.Info("Deleting kafka user created in another namespace", "userName", deployedUser.Name, "namespace", deployedUser.Namespace)
+	return k.kafkaHandler.DeleteKafkaUser(deployedUser)
+}
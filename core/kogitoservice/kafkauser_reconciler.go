@@ -0,0 +1,247 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoservice
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/framework/util"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
+	infra2 "github.com/kiegroup/kogito-operator/core/kogitoinfra"
+	"github.com/kiegroup/kogito-operator/core/manager"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	kafkaUserSecretName        = "%s-kafka-user"
+	kafkaKeyStoreSecretName    = "%s-kafka-keystore"
+	kafkaKeyStoreMountPath     = operator.KogitoHomeDir + "/certs/kafka-user"
+	kafkaAclTopicResourceType  = "topic"
+	kafkaAclGroupResourceType  = "group"
+	kafkaAclLiteralPatternType = "literal"
+	kafkaAclPrefixPatternType  = "prefix"
+	kafkaAclReadOperation      = "Read"
+	kafkaAclWriteOperation     = "Write"
+	kafkaAclDescribeOperation  = "Describe"
+	kafkaUserNameFormat        = "%s-%s"
+)
+
+// KafkaUserReconciler authenticates the service against a Kafka KogitoInfra, creating a Strimzi KafkaUser
+// allowed to use the topics reported by the service and mounting its credentials
+type KafkaUserReconciler interface {
+	Reconcile() error
+}
+
+type kafkaUserReconciler struct {
+	operator.Context
+	instance          api.KogitoService
+	serviceDefinition *ServiceDefinition
+	infraManager      manager.KogitoInfraManager
+	kafkaHandler      infrastructure.KafkaHandler
+	secretHandler     infrastructure.SecretHandler
+	finalizerHandler  KafkaUserFinalizerHandler
+}
+
+func newKafkaUserReconciler(context operator.Context, instance api.KogitoService, serviceDefinition *ServiceDefinition, infraHandler manager.KogitoInfraHandler) KafkaUserReconciler {
+	context.Log = context.Log.WithValues("resource", "KafkaUser")
+	return &kafkaUserReconciler{
+		Context:           context,
+		instance:          instance,
+		serviceDefinition: serviceDefinition,
+		infraManager:      manager.NewKogitoInfraManager(context, infraHandler),
+		kafkaHandler:      infrastructure.NewKafkaHandler(context),
+		secretHandler:     infrastructure.NewSecretHandler(context),
+		finalizerHandler:  NewKafkaUserFinalizerHandler(context),
+	}
+}
+
+func (k *kafkaUserReconciler) Reconcile() error {
+	infra, err := k.fetchKafkaInfra()
+	if err != nil {
+		return err
+	}
+	// users of clusters not managed by Strimzi are given in the credentials of the KogitoInfra
+	if infra == nil || len(infra2.GetKafkaAuthentication(infra)) == 0 || infra2.IsExternalKafka(infra) {
+		return k.finalizerHandler.Ensure(k.instance, nil)
+	}
+	authentication := infra2.GetKafkaAuthentication(infra)
+	kafkaKey := getKafkaNamespacedName(infra)
+	// the KafkaUser and the credentials issued by Strimzi live in the Kafka namespace
+	if !util.IsNamespaceWatched(kafkaKey.Namespace) {
+		return infrastructure.ErrorForNamespaceNotWatched(k.instance.GetName(), infrastructure.KafkaKind, kafkaKey.Name, kafkaKey.Namespace)
+	}
+
+	userName := infra.GetSpec().GetInfraProperties()[infra2.KafkaUserInfraPropertyKey]
+	var groupID string
+	if len(userName) == 0 {
+		userName = fmt.Sprintf(kafkaUserNameFormat, k.instance.GetNamespace(), k.instance.GetName())
+		// the ACLs of the user only allow the consumer groups of the service
+		groupID = userName
+		if err = k.finalizerHandler.Ensure(k.instance, &types.NamespacedName{Name: userName, Namespace: kafkaKey.Namespace}); err != nil {
+			return err
+		}
+		if err = k.reconcileKafkaUser(userName, kafkaKey, authentication, groupID); err != nil {
+			return err
+		}
+	} else if err = k.finalizerHandler.Ensure(k.instance, nil); err != nil {
+		return err
+	}
+
+	// Strimzi issues the credentials in a secret named after the user
+	userSecret, err := k.secretHandler.FetchSecret(types.NamespacedName{Name: userName, Namespace: kafkaKey.Namespace})
+	if err != nil {
+		return err
+	} else if userSecret == nil {
+		return infrastructure.ErrorForKafkaUserNotReady(k.instance.GetName(), userName)
+	}
+	return k.reconcileCredentials(userSecret, authentication, groupID)
+}
+
+func (k *kafkaUserReconciler) fetchKafkaInfra() (api.KogitoInfraInterface, error) {
+	for _, infraName := range k.instance.GetSpec().GetInfra() {
+		infra, err := k.infraManager.MustFetchKogitoInfraInstance(types.NamespacedName{Name: infraName, Namespace: k.instance.GetNamespace()})
+		if err != nil {
+			return nil, err
+		}
+		if IsKafkaResource(infra) {
+			return infra, nil
+		}
+	}
+	return nil, nil
+}
+
+// reconcileKafkaUser keeps the KafkaUser of the service, its ACLs follow the topics in the service status
+func (k *kafkaUserReconciler) reconcileKafkaUser(userName string, kafkaKey types.NamespacedName, authentication, groupID string) error {
+	kafkaUser := k.kafkaHandler.NewKafkaUser(userName, kafkaKey.Name, kafkaKey.Namespace, authentication, k.getAcls(groupID))
+	// owner references can't cross namespaces, the users in other namespaces are deleted by the KafkaUserFinalizer
+	if kafkaKey.Namespace == k.instance.GetNamespace() {
+		if err := framework.SetOwner(k.instance, k.Scheme, kafkaUser); err != nil {
+			return err
+		}
+	}
+	requestedResources := map[reflect.Type][]client.Object{reflect.TypeOf(v1beta2.KafkaUser{}): {kafkaUser}}
+
+	deployedResources := make(map[reflect.Type][]client.Object)
+	deployedUser, err := k.kafkaHandler.FetchKafkaUser(types.NamespacedName{Name: userName, Namespace: kafkaKey.Namespace})
+	if err != nil {
+		return err
+	}
+	if deployedUser != nil {
+		deployedResources[reflect.TypeOf(v1beta2.KafkaUser{})] = []client.Object{deployedUser}
+	}
+
+	deltaProcessor := infrastructure.NewDeltaProcessor(k.Context)
+	_, err = deltaProcessor.ProcessDelta(k.kafkaHandler.GetKafkaUserComparator(), requestedResources, deployedResources)
+	return err
+}
+
+// getAcls allows the topics of the service and the consumer groups prefixed by its group id
+func (k *kafkaUserReconciler) getAcls(groupID string) []v1beta2.AclRule {
+	var acls []v1beta2.AclRule
+	if cloudEvents := k.instance.GetStatus().GetCloudEvents(); cloudEvents != nil {
+		for _, topic := range cloudEvents.GetTopics() {
+			for _, operation := range []string{kafkaAclReadOperation, kafkaAclWriteOperation, kafkaAclDescribeOperation} {
+				acls = append(acls, v1beta2.AclRule{
+					Resource:  v1beta2.AclRuleResource{Type: kafkaAclTopicResourceType, Name: topic.GetName(), PatternType: kafkaAclLiteralPatternType},
+					Operation: operation,
+				})
+			}
+		}
+	}
+	return append(acls, v1beta2.AclRule{
+		Resource:  v1beta2.AclRuleResource{Type: kafkaAclGroupResourceType, Name: groupID, PatternType: kafkaAclPrefixPatternType},
+		Operation: kafkaAclReadOperation,
+	})
+}
+
+// reconcileCredentials copies the credentials issued by Strimzi next to the service, since the user may live in the Kafka namespace
+func (k *kafkaUserReconciler) reconcileCredentials(userSecret *v1.Secret, authentication, groupID string) error {
+	propsSecret := k.newSecret(fmt.Sprintf(kafkaUserSecretName, k.instance.GetName()),
+		infra2.GetKafkaUserAppProps(k.instance.GetSpec().GetRuntime(), authentication, userSecret, kafkaKeyStoreMountPath, groupID))
+	requestedSecrets := []client.Object{propsSecret}
+	var keyStoreSecret *v1.Secret
+	if authentication == v1beta2.KafkaUserTLSAuthentication {
+		keyStoreSecret = k.newSecret(fmt.Sprintf(kafkaKeyStoreSecretName, k.instance.GetName()),
+			map[string][]byte{infra2.KafkaUserKeyStoreKey: userSecret.Data[infra2.KafkaUserKeyStoreKey]})
+		requestedSecrets = append(requestedSecrets, keyStoreSecret)
+	}
+	for _, secret := range requestedSecrets {
+		if err := framework.SetOwner(k.instance, k.Scheme, secret); err != nil {
+			return err
+		}
+	}
+
+	var deployedSecrets []client.Object
+	for _, secret := range requestedSecrets {
+		deployedSecret, err := k.secretHandler.FetchSecret(types.NamespacedName{Name: secret.GetName(), Namespace: secret.GetNamespace()})
+		if err != nil {
+			return err
+		}
+		if deployedSecret != nil {
+			deployedSecrets = append(deployedSecrets, deployedSecret)
+		}
+	}
+
+	deltaProcessor := infrastructure.NewDeltaProcessor(k.Context)
+	if _, err := deltaProcessor.ProcessDelta(k.secretHandler.GetComparator(),
+		map[reflect.Type][]client.Object{reflect.TypeOf(v1.Secret{}): requestedSecrets},
+		map[reflect.Type][]client.Object{reflect.TypeOf(v1.Secret{}): deployedSecrets}); err != nil {
+		return err
+	}
+
+	k.serviceDefinition.SecretEnvFromReferences = append(k.serviceDefinition.SecretEnvFromReferences, propsSecret.Name)
+	if keyStoreSecret != nil {
+		k.serviceDefinition.SecretVolumeReferences = append(k.serviceDefinition.SecretVolumeReferences, &VolumeReference{
+			Name:      keyStoreSecret.Name,
+			MountPath: kafkaKeyStoreMountPath,
+			FileMode:  &framework.ModeForCertificates,
+		})
+	}
+	return nil
+}
+
+func (k *kafkaUserReconciler) newSecret(name string, data map[string][]byte) *v1.Secret {
+	return &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: k.instance.GetNamespace(),
+			Labels: map[string]string{
+				framework.LabelAppKey: k.instance.GetName(),
+			},
+		},
+		Type: v1.SecretTypeOpaque,
+		Data: data,
+	}
+}
+
+// getKafkaNamespacedName gets the Kafka cluster referenced by the KogitoInfra, defaulting to its namespace
+func getKafkaNamespacedName(infra api.KogitoInfraInterface) types.NamespacedName {
+	namespace := infra.GetSpec().GetResource().GetNamespace()
+	if len(namespace) == 0 {
+		namespace = infra.GetNamespace()
+	}
+	return types.NamespacedName{Name: infra.GetSpec().GetResource().GetName(), Namespace: namespace}
+}
@@ -0,0 +1,210 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoservice
+
+import (
+	"testing"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework/util"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
+	infra2 "github.com/kiegroup/kogito-operator/core/kogitoinfra"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/internal/app"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	v13 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func TestKafkaUserReconciler_TLSAuthentication(t *testing.T) {
+	ns := t.Name()
+	kogitoKafka := test.CreateFakeKogitoKafka(ns)
+	kogitoKafka.GetSpec().AddInfraProperties(map[string]string{infra2.KafkaAuthenticationInfraPropertyKey: v1beta2.KafkaUserTLSAuthentication})
+	runtime := test.CreateFakeKogitoRuntime(ns)
+	runtime.Spec.Infra = []string{kogitoKafka.GetName()}
+	runtime.Status.CloudEvents.SetTopic("orders", ns, api.ProvisionedKafkaTopicState)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kogitoKafka, runtime).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	definition := &ServiceDefinition{}
+	reconciler := newKafkaUserReconciler(context, runtime, definition, app.NewKogitoInfraHandler(context))
+
+	// Strimzi hasn't issued the credentials yet
+	err := reconciler.Reconcile()
+	assert.Error(t, err)
+	assert.Equal(t, infrastructure.KafkaUserNotReadyReason, infrastructure.NewReconciliationErrorHandler(context).GetReasonForError(err))
+
+	userName := ns + "-" + runtime.Name
+	kafkaUser := &v1beta2.KafkaUser{ObjectMeta: v13.ObjectMeta{Name: userName, Namespace: ns}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(kafkaUser)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, v1beta2.KafkaUserTLSAuthentication, kafkaUser.Spec.Authentication.Type)
+	assert.Equal(t, "kogito-kafka", kafkaUser.Labels["strimzi.io/cluster"])
+	assert.Len(t, kafkaUser.Spec.Authorization.Acls, 4)
+	assert.Equal(t, "orders", kafkaUser.Spec.Authorization.Acls[0].Resource.Name)
+	// only the consumer groups of the service can be read
+	assert.Equal(t, v1beta2.AclRuleResource{Type: "group", Name: userName, PatternType: "prefix"}, kafkaUser.Spec.Authorization.Acls[3].Resource)
+	// owned by the service in the same namespace
+	assert.False(t, controllerutil.ContainsFinalizer(runtime, KafkaUserFinalizer))
+
+	userSecret := &v1.Secret{
+		ObjectMeta: v13.ObjectMeta{Name: userName, Namespace: ns},
+		Data: map[string][]byte{
+			infra2.KafkaUserKeyStoreKey:         []byte("keystore"),
+			infra2.KafkaUserKeyStorePasswordKey: []byte("secret"),
+		},
+	}
+	assert.NoError(t, kubernetes.ResourceC(cli).Create(userSecret))
+	assert.NoError(t, reconciler.Reconcile())
+	assert.Equal(t, []string{runtime.Name + "-kafka-user"}, definition.SecretEnvFromReferences)
+	assert.Len(t, definition.SecretVolumeReferences, 1)
+	assert.Equal(t, kafkaKeyStoreMountPath, definition.SecretVolumeReferences[0].GetMountPath())
+
+	propsSecret := &v1.Secret{ObjectMeta: v13.ObjectMeta{Name: runtime.Name + "-kafka-user", Namespace: ns}}
+	_, err = kubernetes.ResourceC(cli).Fetch(propsSecret)
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", string(propsSecret.Data["kafka.ssl.keystore.password"]))
+	assert.Equal(t, kafkaKeyStoreMountPath+"/user.p12", string(propsSecret.Data["kafka.ssl.keystore.location"]))
+	assert.Equal(t, userName, string(propsSecret.Data["kafka.group.id"]))
+	keyStoreSecret := &v1.Secret{ObjectMeta: v13.ObjectMeta{Name: runtime.Name + "-kafka-keystore", Namespace: ns}}
+	_, err = kubernetes.ResourceC(cli).Fetch(keyStoreSecret)
+	assert.NoError(t, err)
+	assert.Equal(t, "keystore", string(keyStoreSecret.Data[infra2.KafkaUserKeyStoreKey]))
+}
+
+func TestKafkaUserReconciler_ExistingUserWithScramAuthentication(t *testing.T) {
+	ns := t.Name()
+	kogitoKafka := test.CreateFakeKogitoKafka(ns)
+	kogitoKafka.GetSpec().AddInfraProperties(map[string]string{
+		infra2.KafkaAuthenticationInfraPropertyKey: v1beta2.KafkaUserScramSha512Authentication,
+		infra2.KafkaUserInfraPropertyKey:           "kogito",
+	})
+	runtime := test.CreateFakeKogitoRuntime(ns)
+	runtime.Spec.Infra = []string{kogitoKafka.GetName()}
+	userSecret := &v1.Secret{
+		ObjectMeta: v13.ObjectMeta{Name: "kogito", Namespace: ns},
+		Data:       map[string][]byte{infra2.KafkaUserSaslJaasConfigKey: []byte("jaas")},
+	}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kogitoKafka, runtime, userSecret).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	definition := &ServiceDefinition{}
+	assert.NoError(t, newKafkaUserReconciler(context, runtime, definition, app.NewKogitoInfraHandler(context)).Reconcile())
+	assert.Empty(t, definition.SecretVolumeReferences)
+
+	// the referenced user is not managed by the operator
+	exists, err := kubernetes.ResourceC(cli).Fetch(&v1beta2.KafkaUser{ObjectMeta: v13.ObjectMeta{Name: ns + "-" + runtime.Name, Namespace: ns}})
+	assert.NoError(t, err)
+	assert.False(t, exists)
+	propsSecret := &v1.Secret{ObjectMeta: v13.ObjectMeta{Name: runtime.Name + "-kafka-user", Namespace: ns}}
+	_, err = kubernetes.ResourceC(cli).Fetch(propsSecret)
+	assert.NoError(t, err)
+	assert.Equal(t, "jaas", string(propsSecret.Data["kafka.sasl.jaas.config"]))
+	assert.NotContains(t, propsSecret.Data, "kafka.group.id")
+}
+
+func TestKafkaUserReconciler_CrossNamespaceUser(t *testing.T) {
+	ns := t.Name()
+	kafkaNamespace := "kafka"
+	kogitoKafka := test.CreateFakeKogitoKafka(ns)
+	kogitoKafka.GetSpec().GetResource().SetNamespace(kafkaNamespace)
+	kogitoKafka.GetSpec().AddInfraProperties(map[string]string{infra2.KafkaAuthenticationInfraPropertyKey: v1beta2.KafkaUserScramSha512Authentication})
+	runtime := test.CreateFakeKogitoRuntime(ns)
+	runtime.Spec.Infra = []string{kogitoKafka.GetName()}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kogitoKafka, runtime).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	err := newKafkaUserReconciler(context, runtime, &ServiceDefinition{}, app.NewKogitoInfraHandler(context)).Reconcile()
+	assert.Equal(t, infrastructure.KafkaUserNotReadyReason, infrastructure.NewReconciliationErrorHandler(context).GetReasonForError(err))
+
+	// owner references can't cross namespaces, the user is deleted by the finalizer
+	kafkaUser := &v1beta2.KafkaUser{ObjectMeta: v13.ObjectMeta{Name: ns + "-" + runtime.Name, Namespace: kafkaNamespace}}
+	test.AssertFetchMustExist(t, cli, kafkaUser)
+	assert.Empty(t, kafkaUser.OwnerReferences)
+	assert.True(t, controllerutil.ContainsFinalizer(runtime, KafkaUserFinalizer))
+	assert.Equal(t, kafkaNamespace+"/"+kafkaUser.Name, runtime.Annotations[kafkaUserAnnotation])
+
+	assert.NoError(t, NewKafkaUserFinalizerHandler(context).Finalize(runtime))
+	assert.False(t, controllerutil.ContainsFinalizer(runtime, KafkaUserFinalizer))
+	exists, err := kubernetes.ResourceC(cli).Fetch(kafkaUser)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestKafkaUserReconciler_NamespaceNotWatched(t *testing.T) {
+	ns := t.Name()
+	deferFn := test.SetSharedEnv(util.WatchNamespaceEnv, ns)
+	defer deferFn()
+	kogitoKafka := test.CreateFakeKogitoKafka(ns)
+	kogitoKafka.GetSpec().GetResource().SetNamespace("kafka")
+	kogitoKafka.GetSpec().AddInfraProperties(map[string]string{infra2.KafkaAuthenticationInfraPropertyKey: v1beta2.KafkaUserScramSha512Authentication})
+	runtime := test.CreateFakeKogitoRuntime(ns)
+	runtime.Spec.Infra = []string{kogitoKafka.GetName()}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kogitoKafka, runtime).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	err := newKafkaUserReconciler(context, runtime, &ServiceDefinition{}, app.NewKogitoInfraHandler(context)).Reconcile()
+	assert.Equal(t, infrastructure.NamespaceNotWatchedReason, infrastructure.NewReconciliationErrorHandler(context).GetReasonForError(err))
+	assert.False(t, controllerutil.ContainsFinalizer(runtime, KafkaUserFinalizer))
+	kafkaUser := &v1beta2.KafkaUser{ObjectMeta: v13.ObjectMeta{Name: ns + "-" + runtime.Name, Namespace: "kafka"}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(kafkaUser)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestKafkaUserFinalizerHandler_Unbound(t *testing.T) {
+	ns := t.Name()
+	runtime := test.CreateFakeKogitoRuntime(ns)
+	kafkaUser := &v1beta2.KafkaUser{ObjectMeta: v13.ObjectMeta{Name: ns + "-" + runtime.Name, Namespace: "kafka"}}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(runtime, kafkaUser).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	finalizerHandler := NewKafkaUserFinalizerHandler(context)
+	assert.NoError(t, finalizerHandler.Ensure(runtime, &types.NamespacedName{Name: kafkaUser.Name, Namespace: kafkaUser.Namespace}))
+	assert.True(t, controllerutil.ContainsFinalizer(runtime, KafkaUserFinalizer))
+
+	// the service no longer uses the KafkaUser
+	assert.NoError(t, finalizerHandler.Ensure(runtime, nil))
+	assert.False(t, controllerutil.ContainsFinalizer(runtime, KafkaUserFinalizer))
+	assert.NotContains(t, runtime.Annotations, kafkaUserAnnotation)
+	exists, err := kubernetes.ResourceC(cli).Fetch(kafkaUser)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
@@ -0,0 +1,240 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoservice
+
+import (
+	"reflect"
+	"strconv"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	serving "github.com/kiegroup/kogito-operator/core/infrastructure/serving/v1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	routev1 "github.com/openshift/api/route/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	knativeMinScaleAnnotation = "autoscaling.knative.dev/min-scale"
+	knativeMaxScaleAnnotation = "autoscaling.knative.dev/max-scale"
+	// knativeHTTPPortName is the port name used by Knative to route HTTP/1 requests to the container
+	knativeHTTPPortName = "http1"
+)
+
+// KnativeServiceReconciler ...
+type KnativeServiceReconciler interface {
+	Reconcile() error
+}
+
+type knativeServiceReconciler struct {
+	operator.Context
+	instance              api.KogitoService
+	definition            ServiceDefinition
+	imageHandler          infrastructure.ImageHandler
+	knativeServingHandler infrastructure.KnativeServingHandler
+	deltaProcessor        infrastructure.DeltaProcessor
+}
+
+func newKnativeServiceReconciler(context operator.Context, instance api.KogitoService, definition ServiceDefinition, imageHandler infrastructure.ImageHandler) KnativeServiceReconciler {
+	return &knativeServiceReconciler{
+		Context:               context,
+		instance:              instance,
+		definition:            definition,
+		imageHandler:          imageHandler,
+		knativeServingHandler: infrastructure.NewKnativeServingHandler(context),
+		deltaProcessor:        infrastructure.NewDeltaProcessor(context),
+	}
+}
+
+// Reconcile deploys the service as a Knative Service with the KnativeServing deployment mode.
+// Switching between the deployment modes removes the resources of the previous one.
+func (k *knativeServiceReconciler) Reconcile() error {
+	if !isKnativeServing(k.instance) {
+		return k.deleteKnativeService()
+	}
+	if !k.knativeServingHandler.IsKnativeServingAvailable() {
+		return infrastructure.ErrorForKnativeServingNotAvailable(k.instance.GetName())
+	}
+	// Knative creates its own Service named after the service, the one of the Deployment mode must be removed first
+	if err := k.deleteDeploymentResources(); err != nil {
+		return err
+	}
+
+	imageName, err := k.imageHandler.ResolveImage()
+	if err != nil {
+		return err
+	} else if len(imageName) == 0 {
+		return infrastructure.ErrorForImageNotFound()
+	}
+
+	requestedResources, err := k.createRequiredResources(imageName)
+	if err != nil {
+		return err
+	}
+	deployedResources, err := k.getDeployedResources()
+	if err != nil {
+		return err
+	}
+	k.keepConfigHash(requestedResources, deployedResources)
+
+	comparator := k.knativeServingHandler.GetComparator()
+	_, err = k.deltaProcessor.ProcessDelta(comparator, requestedResources, deployedResources)
+	return err
+}
+
+func (k *knativeServiceReconciler) createRequiredResources(imageName string) (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	// the revisions carry the same pod template as the Deployment mode
+	deployment, err := newKogitoDeploymentReconciler(k.Context, k.instance, k.definition, k.imageHandler).createDeployment(imageName)
+	if err != nil {
+		return nil, err
+	}
+	knativeService := k.createKnativeService(deployment)
+	if err := framework.SetOwner(k.instance, k.Scheme, knativeService); err != nil {
+		return nil, err
+	}
+	resources[reflect.TypeOf(serving.Service{})] = []client.Object{knativeService}
+	return resources, nil
+}
+
+func (k *knativeServiceReconciler) createKnativeService(deployment *appsv1.Deployment) *serving.Service {
+	template := deployment.Spec.Template.DeepCopy()
+	// Knative routes the requests to a single port and doesn't support startup probes
+	container := &template.Spec.Containers[0]
+	container.Ports = []corev1.ContainerPort{{Name: knativeHTTPPortName, ContainerPort: int32(framework.DefaultExposedPort)}}
+	container.StartupProbe = nil
+
+	annotations := make(map[string]string)
+	for key, value := range template.Annotations {
+		annotations[key] = value
+	}
+	knativeServing := k.instance.(api.KogitoRuntimeInterface).GetRuntimeSpec().GetKnativeServing()
+	if minScale := knativeServing.GetMinScale(); minScale != nil {
+		annotations[knativeMinScaleAnnotation] = strconv.Itoa(int(*minScale))
+	}
+	if maxScale := knativeServing.GetMaxScale(); maxScale != nil {
+		annotations[knativeMaxScaleAnnotation] = strconv.Itoa(int(*maxScale))
+	}
+
+	return &serving.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        k.instance.GetName(),
+			Namespace:   k.instance.GetNamespace(),
+			Labels:      deployment.Labels,
+			Annotations: deployment.Annotations,
+		},
+		Spec: serving.ServiceSpec{
+			Template: serving.RevisionTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: template.Labels, Annotations: annotations},
+				Spec: serving.RevisionSpec{
+					PodSpec:              template.Spec,
+					ContainerConcurrency: knativeServing.GetContainerConcurrency(),
+				},
+			},
+		},
+	}
+}
+
+func (k *knativeServiceReconciler) getDeployedResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	knativeService, err := k.knativeServingHandler.FetchKnativeService(types.NamespacedName{Name: k.instance.GetName(), Namespace: k.instance.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	if knativeService != nil {
+		resources[reflect.TypeOf(serving.Service{})] = []client.Object{knativeService}
+	}
+	return resources, nil
+}
+
+// keepConfigHash leaves the configuration hash of the deployed revision template untouched when the config rollout is disabled,
+// so that opting out doesn't create a new revision
+func (k *knativeServiceReconciler) keepConfigHash(requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) {
+	if !k.instance.GetSpec().IsConfigRolloutDisabled() {
+		return
+	}
+	deployed := deployedResources[reflect.TypeOf(serving.Service{})]
+	if len(deployed) == 0 {
+		return
+	}
+	hash, exists := deployed[0].(*serving.Service).Spec.Template.Annotations[configHashAnnotation]
+	if !exists {
+		return
+	}
+	requested := requestedResources[reflect.TypeOf(serving.Service{})][0].(*serving.Service)
+	requested.Spec.Template.Annotations[configHashAnnotation] = hash
+}
+
+// deleteDeploymentResources removes the resources created for the service with the Deployment mode
+func (k *knativeServiceReconciler) deleteDeploymentResources() error {
+	objectMeta := metav1.ObjectMeta{Name: k.instance.GetName(), Namespace: k.instance.GetNamespace()}
+	resources := []client.Object{
+		&appsv1.Deployment{ObjectMeta: objectMeta},
+		&corev1.Service{ObjectMeta: objectMeta},
+		&autoscalingv2.HorizontalPodAutoscaler{ObjectMeta: objectMeta},
+		&policyv1.PodDisruptionBudget{ObjectMeta: objectMeta},
+	}
+	if k.Client.IsOpenshift() {
+		resources = append(resources, &routev1.Route{ObjectMeta: objectMeta})
+	} else {
+		resources = append(resources, &networkingv1.Ingress{ObjectMeta: objectMeta})
+	}
+	for _, resource := range resources {
+		if err := k.deleteOwnedResource(resource); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteKnativeService removes the Knative Service created for the service with the KnativeServing mode
+func (k *knativeServiceReconciler) deleteKnativeService() error {
+	if _, ok := k.instance.(api.KogitoRuntimeInterface); !ok || !k.knativeServingHandler.IsKnativeServingAvailable() {
+		return nil
+	}
+	return k.deleteOwnedResource(&serving.Service{ObjectMeta: metav1.ObjectMeta{Name: k.instance.GetName(), Namespace: k.instance.GetNamespace()}})
+}
+
+func (k *knativeServiceReconciler) deleteOwnedResource(resource client.Object) error {
+	if exists, err := kubernetes.ResourceC(k.Client).Fetch(resource); err != nil || !exists {
+		return err
+	}
+	// the Service named after the service is owned by the Knative Route in the KnativeServing mode
+	if !framework.IsOwner(resource, k.instance) {
+		return nil
+	}
+	k.Log.Info("Deleting resource of the previous deployment mode", "kind", reflect.TypeOf(resource).Elem().Name(), "name", resource.GetName())
+	return kubernetes.ResourceC(k.Client).Delete(resource)
+}
+
+// isKnativeServing checks if the given service is a KogitoRuntime deployed as a Knative Service
+func isKnativeServing(instance api.KogitoService) bool {
+	if runtime, ok := instance.(api.KogitoRuntimeInterface); ok {
+		return runtime.GetRuntimeSpec().GetDeploymentMode() == api.KnativeServingDeploymentMode
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoservice
+
+import (
+	"testing"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	serving "github.com/kiegroup/kogito-operator/core/infrastructure/serving/v1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	v13 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestKnativeServiceReconciler(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.SetUID(types.UID(t.Name()))
+	instance.Spec.Env = []corev1.EnvVar{{Name: "DEBUG", Value: "true"}}
+	instance.Spec.SetDeploymentMode(api.KnativeServingDeploymentMode)
+	minScale := int32(0)
+	maxScale := int32(5)
+	containerConcurrency := int64(10)
+	instance.Spec.KnativeServing.MinScale = &minScale
+	instance.Spec.KnativeServing.MaxScale = &maxScale
+	instance.Spec.KnativeServing.ContainerConcurrency = &containerConcurrency
+
+	// resources of the Deployment mode
+	deployment := &v1.Deployment{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: ns}}
+	service := &corev1.Service{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: ns}}
+	assert.NoError(t, framework.SetOwner(instance, meta.GetRegisteredSchema(), deployment, service))
+
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, deployment, service).SupportKnativeServing().Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	serviceDefinition := ServiceDefinition{Envs: instance.Spec.Env, SecretEnvFromReferences: []string{"kafka-credentials"}}
+	imageHandler := infrastructure.NewImageHandler(context, &api.Image{Name: "test-image", Tag: "1.0"}, "default-image", "image-stream", ns, false, false)
+	err := newKnativeServiceReconciler(context, instance, serviceDefinition, imageHandler).Reconcile()
+	assert.NoError(t, err)
+
+	knativeService := &serving.Service{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: ns}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(knativeService)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.True(t, framework.IsOwner(knativeService, instance))
+	template := knativeService.Spec.Template
+	assert.Equal(t, "0", template.Annotations[knativeMinScaleAnnotation])
+	assert.Equal(t, "5", template.Annotations[knativeMaxScaleAnnotation])
+	assert.NotEmpty(t, template.Annotations[configHashAnnotation])
+	assert.Equal(t, &containerConcurrency, template.Spec.ContainerConcurrency)
+	container := template.Spec.Containers[0]
+	assert.Equal(t, "test-image:1.0", container.Image[len(container.Image)-len("test-image:1.0"):])
+	assert.Contains(t, container.Env, corev1.EnvVar{Name: "DEBUG", Value: "true"})
+	assert.Equal(t, "kafka-credentials", container.EnvFrom[0].SecretRef.Name)
+	assert.NotNil(t, container.ReadinessProbe)
+	assert.Nil(t, container.StartupProbe)
+	assert.Len(t, container.Ports, 1)
+	assert.Equal(t, knativeHTTPPortName, container.Ports[0].Name)
+
+	exists, err = kubernetes.ResourceC(cli).Fetch(deployment)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+	exists, err = kubernetes.ResourceC(cli).Fetch(service)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	// back to the Deployment mode
+	instance.Spec.SetDeploymentMode(api.DeploymentDeploymentMode)
+	err = newKnativeServiceReconciler(context, instance, serviceDefinition, imageHandler).Reconcile()
+	assert.NoError(t, err)
+	exists, err = kubernetes.ResourceC(cli).Fetch(knativeService)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestKnativeServiceReconciler_KeepsKnativeOwnedService(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.SetUID(types.UID(t.Name()))
+	instance.Spec.SetDeploymentMode(api.KnativeServingDeploymentMode)
+	// created by Knative for its Route
+	service := &corev1.Service{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: ns}}
+
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, service).SupportKnativeServing().Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	imageHandler := infrastructure.NewImageHandler(context, &api.Image{Name: "test-image", Tag: "1.0"}, "default-image", "image-stream", ns, false, false)
+	err := newKnativeServiceReconciler(context, instance, ServiceDefinition{}, imageHandler).Reconcile()
+	assert.NoError(t, err)
+
+	exists, err := kubernetes.ResourceC(cli).Fetch(service)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestKnativeServiceReconciler_KnativeServingNotAvailable(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.SetDeploymentMode(api.KnativeServingDeploymentMode)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	imageHandler := infrastructure.NewImageHandler(context, &api.Image{Name: "test-image", Tag: "1.0"}, "default-image", "image-stream", ns, false, false)
+	err := newKnativeServiceReconciler(context, instance, ServiceDefinition{}, imageHandler).Reconcile()
+	assert.Error(t, err)
+	assert.Equal(t, infrastructure.KnativeServingNotAvailableReason, infrastructure.NewReconciliationErrorHandler(context).GetReasonForError(err))
+}
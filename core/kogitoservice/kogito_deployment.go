@@ -35,7 +35,7 @@ const (
 
 // KogitoDeploymentHandler ...
 type KogitoDeploymentHandler interface {
-	CreateDeployment(service api.KogitoService, resolvedImage string, definition ServiceDefinition) *appsv1.Deployment
+	CreateDeployment(service api.KogitoService, resolvedImage string, definition ServiceDefinition) (*appsv1.Deployment, error)
 }
 
 type kogitoDeploymentHandler struct {
@@ -49,7 +49,7 @@ func NewKogitoDeploymentHandler(context operator.Context) KogitoDeploymentHandle
 	}
 }
 
-func (d *kogitoDeploymentHandler) CreateDeployment(service api.KogitoService, resolvedImage string, definition ServiceDefinition) *appsv1.Deployment {
+func (d *kogitoDeploymentHandler) CreateDeployment(service api.KogitoService, resolvedImage string, definition ServiceDefinition) (*appsv1.Deployment, error) {
 	if definition.SingleReplica && *service.GetSpec().GetReplicas() > singleReplica {
 		service.GetSpec().SetReplicas(singleReplica)
 		d.Log.Warn("Service can't scale vertically, only one replica is allowed.", "service", service.GetName())
@@ -90,14 +90,24 @@ func (d *kogitoDeploymentHandler) CreateDeployment(service api.KogitoService, re
 							Image:           resolvedImage,
 						},
 					},
+					NodeSelector:              service.GetSpec().GetNodeSelector(),
+					Tolerations:               service.GetSpec().GetTolerations(),
+					Affinity:                  service.GetSpec().GetAffinity(),
+					TopologySpreadConstraints: getTopologySpreadConstraints(service),
+					PriorityClassName:         service.GetSpec().GetPriorityClassName(),
+					ServiceAccountName:        getServiceAccountName(service),
+					ImagePullSecrets:          service.GetSpec().GetImagePullSecrets(),
 				},
 			},
 			Strategy: appsv1.DeploymentStrategy{Type: appsv1.RollingUpdateDeploymentStrategyType},
 		},
 	}
 	addStartupProbe(d, deployment, probes.startup)
+	if err := applyPodTemplateOverlay(&deployment.Spec.Template, service.GetSpec().GetPodTemplate()); err != nil {
+		return nil, err
+	}
 
-	return deployment
+	return deployment, nil
 }
 
 // addStartupProbe adds a startup probe to deployment if the Kubernetes version is >= 1.18 when the feature is enabled by default
@@ -114,3 +124,16 @@ func addStartupProbe(d *kogitoDeploymentHandler, deployment *appsv1.Deployment,
 		deployment.Spec.Template.Spec.Containers[0].StartupProbe = startupProbe
 	}
 }
+
+// getTopologySpreadConstraints gets the topology spread constraints of the service, selecting its own pods when no label selector is given
+func getTopologySpreadConstraints(service api.KogitoService) []corev1.TopologySpreadConstraint {
+	var constraints []corev1.TopologySpreadConstraint
+	for _, constraint := range service.GetSpec().GetTopologySpreadConstraints() {
+		constraint = *constraint.DeepCopy()
+		if constraint.LabelSelector == nil {
+			constraint.LabelSelector = &metav1.LabelSelector{MatchLabels: map[string]string{framework.LabelAppKey: service.GetName()}}
+		}
+		constraints = append(constraints, constraint)
+	}
+	return constraints
+}
@@ -26,6 +26,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var defaultKogitoImageFullTag = infrastructure.GetKogitoImageVersion(app.Version) + ":latest"
@@ -40,7 +42,8 @@ func Test_createRequiredDeployment_CheckQuarkusProbe(t *testing.T) {
 		Scheme: meta.GetRegisteredSchema(),
 	}
 	deploymentHandler := NewKogitoDeploymentHandler(context)
-	deployment := deploymentHandler.CreateDeployment(dataIndex, defaultKogitoImageFullTag, serviceDef)
+	deployment, err := deploymentHandler.CreateDeployment(dataIndex, defaultKogitoImageFullTag, serviceDef)
+	assert.NoError(t, err)
 	assert.NotNil(t, deployment)
 	assert.NotNil(t, deployment.Spec.Template.Spec.Containers[0].ReadinessProbe)
 	assert.NotNil(t, deployment.Spec.Template.Spec.Containers[0].ReadinessProbe.HTTPGet)
@@ -60,7 +63,8 @@ func Test_createRequiredDeployment_CheckDefaultProbe(t *testing.T) {
 		Scheme: meta.GetRegisteredSchema(),
 	}
 	deploymentHandler := NewKogitoDeploymentHandler(context)
-	deployment := deploymentHandler.CreateDeployment(dataIndex, defaultKogitoImageFullTag, serviceDef)
+	deployment, err := deploymentHandler.CreateDeployment(dataIndex, defaultKogitoImageFullTag, serviceDef)
+	assert.NoError(t, err)
 	assert.NotNil(t, deployment)
 	assert.NotNil(t, deployment.Spec.Template.Spec.Containers[0].ReadinessProbe)
 	assert.NotNil(t, deployment.Spec.Template.Spec.Containers[0].LivenessProbe)
@@ -80,7 +84,64 @@ func Test_createRequiredDeployment_CheckNilEnvs(t *testing.T) {
 		Scheme: meta.GetRegisteredSchema(),
 	}
 	deploymentHandler := NewKogitoDeploymentHandler(context)
-	deployment := deploymentHandler.CreateDeployment(dataIndex, defaultKogitoImageFullTag, serviceDef)
+	deployment, err := deploymentHandler.CreateDeployment(dataIndex, defaultKogitoImageFullTag, serviceDef)
+	assert.NoError(t, err)
 	assert.NotNil(t, deployment)
 	assert.Nil(t, deployment.Spec.Template.Spec.Containers[0].Env)
 }
+
+func Test_createRequiredDeployment_PodTemplateOverlay(t *testing.T) {
+	dataIndex := test.CreateFakeDataIndex(t.Name())
+	dataIndex.Spec.PodTemplate = &corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"sidecar.istio.io/inject": "false"}},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "wait-for-schema", Image: "busybox:1.35"}},
+			Containers: []corev1.Container{
+				{Name: dataIndex.Name, Env: []corev1.EnvVar{{Name: "LOG_DIR", Value: "/var/log/kogito"}}, VolumeMounts: []corev1.VolumeMount{{Name: "logs", MountPath: "/var/log/kogito"}}},
+				{Name: "log-shipper", Image: "fluent-bit:1.9", VolumeMounts: []corev1.VolumeMount{{Name: "logs", MountPath: "/logs"}}},
+			},
+			Volumes: []corev1.Volume{{Name: "logs", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}},
+		},
+	}
+	cli := test.NewFakeClientBuilder().Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	deploymentHandler := NewKogitoDeploymentHandler(context)
+	deployment, err := deploymentHandler.CreateDeployment(dataIndex, defaultKogitoImageFullTag, ServiceDefinition{})
+	assert.NoError(t, err)
+	template := deployment.Spec.Template
+	assert.Equal(t, "false", template.Annotations["sidecar.istio.io/inject"])
+	assert.Equal(t, dataIndex.Name, template.Labels["app"])
+	assert.Len(t, template.Spec.InitContainers, 1)
+	assert.Len(t, template.Spec.Containers, 2)
+	assert.Len(t, template.Spec.Volumes, 1)
+	// the main container keeps the fields managed by the operator
+	main := template.Spec.Containers[0]
+	assert.Equal(t, dataIndex.Name, main.Name)
+	assert.Equal(t, defaultKogitoImageFullTag, main.Image)
+	assert.NotNil(t, main.ReadinessProbe)
+	assert.Len(t, main.Ports, 1)
+	assert.Equal(t, []corev1.EnvVar{{Name: "LOG_DIR", Value: "/var/log/kogito"}}, main.Env)
+	assert.Len(t, main.VolumeMounts, 1)
+	assert.Equal(t, "log-shipper", template.Spec.Containers[1].Name)
+}
+
+func Test_createRequiredDeployment_ServiceAccountAndPullSecrets(t *testing.T) {
+	dataIndex := test.CreateFakeDataIndex(t.Name())
+	dataIndex.Spec.CreateServiceAccount = true
+	dataIndex.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: "private-registry"}}
+	cli := test.NewFakeClientBuilder().Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	deploymentHandler := NewKogitoDeploymentHandler(context)
+	deployment, err := deploymentHandler.CreateDeployment(dataIndex, defaultKogitoImageFullTag, ServiceDefinition{})
+	assert.NoError(t, err)
+	assert.Equal(t, dataIndex.Name, deployment.Spec.Template.Spec.ServiceAccountName)
+	assert.Equal(t, []corev1.LocalObjectReference{{Name: "private-registry"}}, deployment.Spec.Template.Spec.ImagePullSecrets)
+}
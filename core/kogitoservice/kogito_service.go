@@ -20,7 +20,9 @@ package kogitoservice
 import (
 	"fmt"
 	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
 	"github.com/kiegroup/kogito-operator/core/operator"
+	"k8s.io/apimachinery/pkg/types"
 	"net/url"
 	"os"
 )
@@ -37,6 +39,7 @@ const (
 type ServiceHandler interface {
 	GetKogitoServiceURL(kogitoService api.KogitoService) string
 	GetKogitoServiceEndpoints(instance api.KogitoService, serviceHTTPRouteEnv string, serviceWSRouteEnv string) (endpoints *ServiceEndpoints, err error)
+	IsKogitoServiceAvailable(kogitoService api.KogitoService) (bool, error)
 }
 
 type kogitoServiceHandler struct {
@@ -72,6 +75,16 @@ func (k *kogitoServiceHandler) getKogitoServiceURL(service api.KogitoService) st
 	return serviceURL
 }
 
+// IsKogitoServiceAvailable checks if the service can handle requests.
+// With the KnativeServing deployment mode, Knative starts the pods on demand once the service is ready.
+func (k *kogitoServiceHandler) IsKogitoServiceAvailable(kogitoService api.KogitoService) (bool, error) {
+	key := types.NamespacedName{Name: kogitoService.GetName(), Namespace: kogitoService.GetNamespace()}
+	if isKnativeServing(kogitoService) {
+		return infrastructure.NewKnativeServingHandler(k.Context).IsKnativeServiceReady(key)
+	}
+	return infrastructure.NewDeploymentHandler(k.Context).IsDeploymentAvailable(key)
+}
+
 // GetKogitoServiceEndpoints ...
 func (k *kogitoServiceHandler) GetKogitoServiceEndpoints(instance api.KogitoService, serviceHTTPRouteEnv string, serviceWSRouteEnv string) (endpoints *ServiceEndpoints, err error) {
 	srvEndpoint := k.GetKogitoServiceURL(instance)
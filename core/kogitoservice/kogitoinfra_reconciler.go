@@ -23,6 +23,7 @@ import (
 	"github.com/kiegroup/kogito-operator/core/framework"
 	"github.com/kiegroup/kogito-operator/core/infrastructure"
 	"github.com/kiegroup/kogito-operator/core/manager"
+	"github.com/kiegroup/kogito-operator/core/metrics"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -52,6 +53,7 @@ func newKogitoInfraReconciler(context operator.Context, instance api.KogitoServi
 
 func (k *kogitoInfraReconciler) Reconcile() error {
 	infraNames := k.instance.GetSpec().GetInfra()
+	k.forgetUnboundInfraWaits(infraNames)
 	for _, infraName := range infraNames {
 
 		infra, err := k.infraHandler.FetchKogitoInfraInstance(types.NamespacedName{Name: infraName, Namespace: k.instance.GetNamespace()})
@@ -77,15 +79,33 @@ func (k *kogitoInfraReconciler) Reconcile() error {
 }
 
 // checkInfraDependencies verifies if every KogitoInfra resource have an ok status.
+// The time spent waiting for the KogitoInfra to be ready is exposed in the operator metrics.
 func (k *kogitoInfraReconciler) checkInfraDependencies(infra api.KogitoInfraInterface) error {
-	if isReady, err := k.infraManager.IsKogitoInfraReady(types.NamespacedName{Name: infra.GetName(), Namespace: infra.GetNamespace()}); err != nil {
+	serviceKey := types.NamespacedName{Name: k.instance.GetName(), Namespace: k.instance.GetNamespace()}.String()
+	infraKey := types.NamespacedName{Name: infra.GetName(), Namespace: infra.GetNamespace()}
+	if isReady, err := k.infraManager.IsKogitoInfraReady(infraKey); err != nil {
 		return err
 	} else if !isReady {
-		conditionReason, err := k.infraManager.GetKogitoInfraFailureConditionReason(types.NamespacedName{Name: infra.GetName(), Namespace: infra.GetNamespace()})
+		conditionReason, err := k.infraManager.GetKogitoInfraFailureConditionReason(infraKey)
 		if err != nil {
 			return err
 		}
+		metrics.StartInfraWait(serviceKey, infraKey.String())
 		return infrastructure.ErrorForInfraNotReady(k.instance.GetName(), infra.GetName(), conditionReason)
 	}
+	var resourceKind string
+	if !infra.GetSpec().IsResourceEmpty() {
+		resourceKind = infra.GetSpec().GetResource().GetKind()
+	}
+	metrics.EndInfraWait(serviceKey, infraKey.String(), resourceKind)
 	return nil
 }
+
+// forgetUnboundInfraWaits stops tracking the waits for the KogitoInfra removed from the service
+func (k *kogitoInfraReconciler) forgetUnboundInfraWaits(infraNames []string) {
+	infraKeys := make([]string, len(infraNames))
+	for i, infraName := range infraNames {
+		infraKeys[i] = types.NamespacedName{Name: infraName, Namespace: k.instance.GetNamespace()}.String()
+	}
+	metrics.ForgetInfraWaits(types.NamespacedName{Name: k.instance.GetName(), Namespace: k.instance.GetNamespace()}.String(), infraKeys...)
+}
@@ -92,11 +92,17 @@ func NewKafkaMessagingDeployer(context operator.Context, definition ServiceDefin
 }
 
 func (m *messagingDeployer) fetchTopicsAndSetCloudEventsStatus(instance api.KogitoService) ([]messagingTopic, error) {
-	kogitoServiceHandler := NewKogitoServiceHandler(m.Context)
-	topics, err := m.fetchRequiredTopicsForURL(instance, kogitoServiceHandler.GetKogitoServiceURL(instance))
+	metadata, err := newServiceIntrospector(m.Context).getMetadata(instance)
 	if err != nil {
 		return nil, err
 	}
+	var topics []messagingTopic
+	if metadata != nil {
+		if metadata.topicsErr != nil {
+			return nil, metadata.topicsErr
+		}
+		topics = metadata.topics
+	}
 	m.setCloudEventsStatus(instance, topics)
 	return topics, nil
 }
@@ -114,24 +120,20 @@ func (m *messagingDeployer) setCloudEventsStatus(instance api.KogitoService, top
 			}
 		}
 	}
-	instance.GetStatus().SetCloudEvents(&v1beta1.KogitoCloudEventsStatus{
+	// the topics bound to the service are kept, they are required to apply its Kafka topics deletion policy
+	cloudEvents := &v1beta1.KogitoCloudEventsStatus{
 		Consumes: eventsConsumed,
 		Produces: eventsProduced,
-	})
-}
-
-func (m *messagingDeployer) fetchRequiredTopicsForURL(instance api.KogitoService, serverURL string) ([]messagingTopic, error) {
-	deploymentHandler := infrastructure.NewDeploymentHandler(m.Context)
-	available, err := deploymentHandler.IsDeploymentAvailable(types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
-	if err != nil {
-		return nil, err
 	}
-	if !available {
-		m.Log.Debug("Deployment not available yet for KogitoService", "KogitoService", instance.GetName())
-		return nil, nil
+	for _, topic := range instance.GetStatus().GetCloudEvents().GetTopics() {
+		cloudEvents.SetTopic(topic.GetName(), topic.GetNamespace(), topic.GetState())
 	}
+	instance.GetStatus().SetCloudEvents(cloudEvents)
+}
+
+func fetchRequiredTopicsForURL(serverURL string) ([]messagingTopic, error) {
 	topicsURL := fmt.Sprintf("%s%s", serverURL, topicInfoPath)
-	resp, err := http.Get(topicsURL)
+	resp, err := introspectionHTTPClient.Get(topicsURL)
 	if err != nil {
 		return nil, err
 	}
@@ -19,7 +19,12 @@ package kogitoservice
 
 import (
 	"fmt"
+	"path"
+	"reflect"
+
 	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kafka"
+	"github.com/kiegroup/kogito-operator/core/framework/util"
 	"github.com/kiegroup/kogito-operator/core/infrastructure"
 	infra2 "github.com/kiegroup/kogito-operator/core/kogitoinfra"
 	"k8s.io/apimachinery/pkg/types"
@@ -57,39 +62,146 @@ func (k *kafkaMessagingDeployer) createRequiredKafkaTopics(infra api.KogitoInfra
 	if err != nil {
 		return err
 	}
-	for _, topic := range topics {
-		if err := k.createKafkaTopicIfNotExists(topic.Name, infra); err != nil {
+	if infra2.IsExternalKafka(infra) {
+		return k.createExternalKafkaTopics(infra, topics, service)
+	}
+	if len(topics) > 0 {
+		kafkaNamespaceName, err := k.getKafkaInstanceNamespaceName(infra, service)
+		if err != nil {
 			return err
 		}
+		k.Log.Debug("Resolved kafka instance", "name", kafkaNamespaceName.Name, "namespace", kafkaNamespaceName.Namespace)
+		for _, topic := range topics {
+			state, err := k.reconcileKafkaTopic(topic.Name, kafkaNamespaceName, service)
+			if err != nil {
+				return err
+			}
+			service.GetStatus().GetCloudEvents().SetTopic(topic.Name, kafkaNamespaceName.Namespace, state)
+		}
 	}
-	return nil
+	return NewKafkaTopicsFinalizerHandler(k.Context).Ensure(service)
 }
 
-func (k *kafkaMessagingDeployer) createKafkaTopicIfNotExists(topicName string, instance api.KogitoInfraInterface) error {
-	k.Log.Debug("Going to create kafka topic it is not exists", "topicName", topicName)
+// reconcileKafkaTopic creates the topic with the configuration given in the service spec, or updates it if it was provisioned by the operator.
+// Topics that already existed in the cluster are adopted as they are.
+func (k *kafkaMessagingDeployer) reconcileKafkaTopic(topicName string, kafkaNamespaceName *types.NamespacedName, service api.KogitoService) (api.KafkaTopicState, error) {
+	kafkaHandler := infrastructure.NewKafkaHandler(k.Context)
+	topicConfig := getKafkaTopicConfig(service, topicName)
+	requested := kafkaHandler.NewKafkaTopic(topicName, kafkaNamespaceName.Name, kafkaNamespaceName.Namespace, topicConfig)
+	deployed, err := kafkaHandler.FetchKafkaTopic(types.NamespacedName{Name: topicName, Namespace: kafkaNamespaceName.Namespace})
+	if err != nil {
+		return "", err
+	}
+	serviceKey := getKafkaTopicServiceKey(service)
+	if deployed == nil {
+		requested.Annotations = map[string]string{kafkaTopicServicesAnnotation: serviceKey}
+		if err = kafkaHandler.CreateKafkaTopic(requested); err != nil {
+			return "", err
+		}
+		return api.ProvisionedKafkaTopicState, nil
+	}
+	services, provisioned := deployed.Annotations[kafkaTopicServicesAnnotation]
+	if !provisioned {
+		k.Log.Debug("Adopting existing kafka topic", "topicName", topicName)
+		return api.AdoptedKafkaTopicState, nil
+	}
+	servicesAnnotation := addKafkaTopicService(services, serviceKey)
+	// services sharing the topic without configuring it don't reset it to the defaults
+	configChanged := topicConfig != nil && !reflect.DeepEqual(deployed.Spec, requested.Spec)
+	if servicesAnnotation != services || configChanged {
+		deployed.Annotations[kafkaTopicServicesAnnotation] = servicesAnnotation
+		if configChanged {
+			deployed.Spec = requested.Spec
+		}
+		if err = kafkaHandler.UpdateKafkaTopic(deployed); err != nil {
+			return "", err
+		}
+	}
+	return api.ProvisionedKafkaTopicState, nil
+}
 
-	kafkaNamespaceName, err := k.getKafkaInstanceNamespaceName(instance)
+// createExternalKafkaTopics creates the topics through the Kafka Admin API when the cluster isn't managed by Strimzi.
+// These topics are neither updated nor deleted afterwards, the deletion policy only applies to Strimzi topics.
+func (k *kafkaMessagingDeployer) createExternalKafkaTopics(infra api.KogitoInfraInterface, topics []messagingTopic, service api.KogitoService) error {
+	if len(topics) == 0 {
+		return nil
+	}
+	config, err := infra2.NewExternalKafkaAdminConfig(k.Context, infra)
 	if err != nil {
 		return err
 	}
-	k.Log.Debug("Resolved kafka instance", "name", kafkaNamespaceName.Name, "namespace", kafkaNamespaceName.Namespace)
-
-	kafkaHandler := infrastructure.NewKafkaHandler(k.Context)
-	kafkaTopic, err := kafkaHandler.FetchKafkaTopic(types.NamespacedName{Name: topicName, Namespace: kafkaNamespaceName.Namespace})
+	adminClient, err := kafka.NewAdminClient(*config)
 	if err != nil {
 		return err
 	}
-
-	if kafkaTopic == nil {
-		_, err := kafkaHandler.CreateKafkaTopic(topicName, kafkaNamespaceName.Name, kafkaNamespaceName.Namespace)
+	defer func() {
+		if err := adminClient.Close(); err != nil {
+			k.Log.Debug("Failed to close the kafka admin connection", "error", err)
+		}
+	}()
+	existingTopics, err := adminClient.ListTopics()
+	if err != nil {
+		return err
+	}
+	for _, topic := range topics {
+		state, err := k.reconcileExternalKafkaTopic(adminClient, existingTopics, topic.Name, service)
 		if err != nil {
 			return err
 		}
+		service.GetStatus().GetCloudEvents().SetTopic(topic.Name, "", state)
 	}
 	return nil
 }
 
-func (k *kafkaMessagingDeployer) getKafkaInstanceNamespaceName(instance api.KogitoInfraInterface) (*types.NamespacedName, error) {
+// reconcileExternalKafkaTopic creates the topic with the configuration given in the service spec if it's not one of the existing topics.
+// Topics that already existed are adopted.
+func (k *kafkaMessagingDeployer) reconcileExternalKafkaTopic(adminClient kafka.AdminClient, existingTopics []string, topicName string, service api.KogitoService) (api.KafkaTopicState, error) {
+	if !util.Contains(topicName, existingTopics) {
+		requested := kafka.TopicConfig{Name: topicName}
+		if topicConfig := getKafkaTopicConfig(service, topicName); topicConfig != nil {
+			requested.Partitions = topicConfig.GetPartitions()
+			requested.ReplicationFactor = topicConfig.GetReplicationFactor()
+			requested.Config = topicConfig.GetConfig()
+		}
+		created, err := adminClient.CreateTopic(requested)
+		if err != nil {
+			return "", err
+		}
+		if created {
+			k.Log.Info("Created kafka topic", "topicName", topicName)
+			return api.ProvisionedKafkaTopicState, nil
+		}
+	}
+	// the topic may have been created by a previous reconciliation
+	for _, topic := range service.GetStatus().GetCloudEvents().GetTopics() {
+		if topic.GetName() == topicName {
+			return topic.GetState(), nil
+		}
+	}
+	return api.AdoptedKafkaTopicState, nil
+}
+
+// getKafkaTopicConfig gets the configuration of the given topic from the service spec. The exact name wins over the first matching pattern.
+func getKafkaTopicConfig(service api.KogitoService, topicName string) api.KafkaTopicInterface {
+	var patternConfig api.KafkaTopicInterface
+	for _, topicConfig := range service.GetSpec().GetKafkaTopics().GetTopics() {
+		if topicConfig.GetName() == topicName {
+			return topicConfig
+		}
+		if matched, _ := path.Match(topicConfig.GetName(), topicName); matched && patternConfig == nil {
+			patternConfig = topicConfig
+		}
+	}
+	return patternConfig
+}
+
+// isValidKafkaTopicPattern checks that the given topic name or pattern can be matched against the topics required by the service
+func isValidKafkaTopicPattern(pattern string) bool {
+	_, err := path.Match(pattern, "")
+	return err == nil
+}
+
+func (k *kafkaMessagingDeployer) getKafkaInstanceNamespaceName(instance api.KogitoInfraInterface, service api.KogitoService) (*types.NamespacedName, error) {
 	if len(instance.GetSpec().GetResource().GetName()) > 0 {
 		k.Log.Debug("Custom kafka instance reference is provided")
 		namespace := instance.GetSpec().GetResource().GetNamespace()
@@ -97,6 +209,10 @@ func (k *kafkaMessagingDeployer) getKafkaInstanceNamespaceName(instance api.Kogi
 			namespace = instance.GetNamespace()
 			k.Log.Debug("Namespace is not provided for custom resource, taking instance namespace as default", "instance namespace", namespace)
 		}
+		// the topics are created next to the Kafka instance
+		if !util.IsNamespaceWatched(namespace) {
+			return nil, infrastructure.ErrorForNamespaceNotWatched(service.GetName(), instance.GetSpec().GetResource().GetKind(), instance.GetSpec().GetResource().GetName(), namespace)
+		}
 		return &types.NamespacedName{Namespace: namespace, Name: instance.GetSpec().GetResource().GetName()}, nil
 	}
 	k.Log.Debug("Custom kafka instance reference is not provided")
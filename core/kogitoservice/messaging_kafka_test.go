@@ -0,0 +1,192 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoservice
+
+import (
+	"testing"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/client/kafka"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	v13 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func Test_reconcileKafkaTopic(t *testing.T) {
+	ns := t.Name()
+	partitions := int32(3)
+	replicas := int32(2)
+	orders := test.CreateFakeKogitoRuntime(ns)
+	orders.Name = "orders"
+	orders.Spec.KafkaTopics = v1beta1.KafkaTopics{
+		DeletionPolicy: api.DeleteKafkaTopicDeletionPolicy,
+		Topics: []v1beta1.KafkaTopic{
+			{Name: "orders-*", Partitions: &partitions},
+			{Name: "orders-events", Partitions: &partitions, ReplicationFactor: &replicas, Config: map[string]string{"retention.ms": "604800000"}},
+		},
+	}
+	payments := test.CreateFakeKogitoRuntime(ns)
+	payments.Name = "payments"
+	adopted := &v1beta2.KafkaTopic{ObjectMeta: v13.ObjectMeta{Name: "legacy-events", Namespace: ns}, Spec: v1beta2.KafkaTopicSpec{Partitions: 12}}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(orders, payments, adopted).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	deployer := NewKafkaMessagingDeployer(context, ServiceDefinition{}, nil).(*kafkaMessagingDeployer)
+	kafka := &types.NamespacedName{Name: "kogito-kafka", Namespace: ns}
+
+	// the exact name wins over the pattern
+	state, err := deployer.reconcileKafkaTopic("orders-events", kafka, orders)
+	assert.NoError(t, err)
+	assert.Equal(t, api.ProvisionedKafkaTopicState, state)
+	topic := &v1beta2.KafkaTopic{ObjectMeta: v13.ObjectMeta{Name: "orders-events", Namespace: ns}}
+	_, err = kubernetes.ResourceC(cli).Fetch(topic)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), topic.Spec.Partitions)
+	assert.Equal(t, int32(2), topic.Spec.Replicas)
+	assert.Equal(t, "604800000", topic.Spec.Config["retention.ms"])
+
+	state, err = deployer.reconcileKafkaTopic("orders-audit", kafka, orders)
+	assert.NoError(t, err)
+	assert.Equal(t, api.ProvisionedKafkaTopicState, state)
+	audit := &v1beta2.KafkaTopic{ObjectMeta: v13.ObjectMeta{Name: "orders-audit", Namespace: ns}}
+	_, err = kubernetes.ResourceC(cli).Fetch(audit)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), audit.Spec.Partitions)
+	assert.Equal(t, int32(1), audit.Spec.Replicas)
+
+	// topics created outside the operator are left untouched
+	state, err = deployer.reconcileKafkaTopic("legacy-events", kafka, orders)
+	assert.NoError(t, err)
+	assert.Equal(t, api.AdoptedKafkaTopicState, state)
+	_, err = kubernetes.ResourceC(cli).Fetch(adopted)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(12), adopted.Spec.Partitions)
+
+	// the topic shared with another service is deleted once both are gone
+	state, err = deployer.reconcileKafkaTopic("orders-events", kafka, payments)
+	assert.NoError(t, err)
+	assert.Equal(t, api.ProvisionedKafkaTopicState, state)
+	_, err = kubernetes.ResourceC(cli).Fetch(topic)
+	assert.NoError(t, err)
+	assert.Equal(t, ns+"/orders,"+ns+"/payments", topic.Annotations[kafkaTopicServicesAnnotation])
+	// changes on the topic reconcile both services
+	assert.Equal(t, []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: ns, Name: "orders"}},
+		{NamespacedName: types.NamespacedName{Namespace: ns, Name: "payments"}},
+	}, MapKafkaTopicToServices(topic))
+	// the configuration is not overridden by a service without it
+	assert.Equal(t, int32(3), topic.Spec.Partitions)
+
+	orders.Status.CloudEvents.SetTopic("orders-events", ns, api.ProvisionedKafkaTopicState)
+	orders.Status.CloudEvents.SetTopic("orders-audit", ns, api.ProvisionedKafkaTopicState)
+	orders.Status.CloudEvents.SetTopic("legacy-events", ns, api.AdoptedKafkaTopicState)
+	finalizerHandler := NewKafkaTopicsFinalizerHandler(context)
+	assert.NoError(t, finalizerHandler.Ensure(orders))
+	assert.True(t, controllerutil.ContainsFinalizer(orders, KafkaTopicsFinalizer))
+	assert.NoError(t, finalizerHandler.Finalize(orders))
+	assert.False(t, controllerutil.ContainsFinalizer(orders, KafkaTopicsFinalizer))
+
+	exists, err := kubernetes.ResourceC(cli).Fetch(audit)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+	exists, err = kubernetes.ResourceC(cli).Fetch(adopted)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	exists, err = kubernetes.ResourceC(cli).Fetch(topic)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, ns+"/payments", topic.Annotations[kafkaTopicServicesAnnotation])
+}
+
+type fakeKafkaAdminClient struct {
+	topics map[string]kafka.TopicConfig
+	// created are the topics requested to be created
+	created []string
+}
+
+func (f *fakeKafkaAdminClient) ListTopics() ([]string, error) {
+	var topics []string
+	for name := range f.topics {
+		topics = append(topics, name)
+	}
+	return topics, nil
+}
+
+func (f *fakeKafkaAdminClient) CreateTopic(topic kafka.TopicConfig) (bool, error) {
+	f.created = append(f.created, topic.Name)
+	if _, exists := f.topics[topic.Name]; exists {
+		return false, nil
+	}
+	f.topics[topic.Name] = topic
+	return true, nil
+}
+
+func (f *fakeKafkaAdminClient) Close() error {
+	return nil
+}
+
+func Test_reconcileExternalKafkaTopic(t *testing.T) {
+	ns := t.Name()
+	partitions := int32(6)
+	orders := test.CreateFakeKogitoRuntime(ns)
+	orders.Spec.KafkaTopics = v1beta1.KafkaTopics{
+		Topics: []v1beta1.KafkaTopic{{Name: "orders-*", Partitions: &partitions}},
+	}
+	context := operator.Context{
+		Client: test.NewFakeClientBuilder().Build(),
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	deployer := NewKafkaMessagingDeployer(context, ServiceDefinition{}, nil).(*kafkaMessagingDeployer)
+	adminClient := &fakeKafkaAdminClient{topics: map[string]kafka.TopicConfig{"legacy-events": {Name: "legacy-events"}}}
+
+	existingTopics, err := adminClient.ListTopics()
+	assert.NoError(t, err)
+
+	state, err := deployer.reconcileExternalKafkaTopic(adminClient, existingTopics, "orders-events", orders)
+	assert.NoError(t, err)
+	assert.Equal(t, api.ProvisionedKafkaTopicState, state)
+	assert.Equal(t, &partitions, adminClient.topics["orders-events"].Partitions)
+	assert.Nil(t, adminClient.topics["orders-events"].ReplicationFactor)
+
+	// existing topics are adopted without being created
+	state, err = deployer.reconcileExternalKafkaTopic(adminClient, existingTopics, "legacy-events", orders)
+	assert.NoError(t, err)
+	assert.Equal(t, api.AdoptedKafkaTopicState, state)
+	assert.Equal(t, []string{"orders-events"}, adminClient.created)
+
+	// the topic created by a previous reconciliation stays provisioned
+	orders.Status.CloudEvents.SetTopic("orders-events", "", api.ProvisionedKafkaTopicState)
+	existingTopics, err = adminClient.ListTopics()
+	assert.NoError(t, err)
+	state, err = deployer.reconcileExternalKafkaTopic(adminClient, existingTopics, "orders-events", orders)
+	assert.NoError(t, err)
+	assert.Equal(t, api.ProvisionedKafkaTopicState, state)
+	assert.Equal(t, []string{"orders-events"}, adminClient.created)
+}
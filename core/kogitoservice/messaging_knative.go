@@ -24,9 +24,13 @@ import (
 	"github.com/kiegroup/kogito-operator/core/framework"
 	"github.com/kiegroup/kogito-operator/core/framework/util"
 	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	serving "github.com/kiegroup/kogito-operator/core/infrastructure/serving/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+	knativeapis "knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/pkg/tracker"
 )
@@ -43,13 +47,20 @@ type knativeMessagingDeployer struct {
 
 func (k *knativeMessagingDeployer) CreateRequiredResources(service api.KogitoService) error {
 	infra, err := k.fetchInfraDependency(service, isKnativeEventingResource)
-	if err != nil || infra == nil {
+	if err != nil {
 		return err
 	}
+	if infra == nil {
+		return k.deleteKnativeResources(service)
+	}
 
 	// since we depend on Knative, let's bind a SinkBinding object to our deployment
-	sinkBinding := k.newSinkBinding(service, infra)
-	if err := kubernetes.ResourceC(k.Client).CreateIfNotExistsForOwner(sinkBinding, service, k.Scheme); err != nil {
+	if err := k.reconcileSinkBinding(service, infra); err != nil {
+		return err
+	}
+
+	// triggers are reconciled against the topics exposed by the service, keep them untouched until it's available
+	if available, err := NewKogitoServiceHandler(k.Context).IsKogitoServiceAvailable(service); err != nil || !available {
 		return err
 	}
 
@@ -58,33 +69,160 @@ func (k *knativeMessagingDeployer) CreateRequiredResources(service api.KogitoSer
 	if err != nil {
 		return err
 	}
-	for _, topic := range topics {
-		if err := k.createKnativeTriggersIfNotExists(topic, service, infra); err != nil {
+	return k.reconcileTriggers(topics, service, infra)
+}
+
+// reconcileSinkBinding creates the SinkBinding of the service, or updates it when the bound broker has changed
+func (k *knativeMessagingDeployer) reconcileSinkBinding(service api.KogitoService, infra api.KogitoInfraInterface) error {
+	requested := k.newSinkBinding(service, infra)
+	deployed := &sourcesv1.SinkBinding{ObjectMeta: metav1.ObjectMeta{Name: requested.Name, Namespace: requested.Namespace}}
+	if exists, err := kubernetes.ResourceC(k.Client).Fetch(deployed); err != nil {
+		return err
+	} else if !exists {
+		return kubernetes.ResourceC(k.Client).CreateForOwner(requested, service, k.Scheme)
+	}
+	if equality.Semantic.DeepEqual(requested.Spec.Sink, deployed.Spec.Sink) && equality.Semantic.DeepEqual(requested.Spec.Subject, deployed.Spec.Subject) {
+		return nil
+	}
+	k.Log.Info("Updating SinkBinding", "SinkBinding", deployed.Name)
+	deployed.Spec.Sink = requested.Spec.Sink
+	deployed.Spec.Subject = requested.Spec.Subject
+	return kubernetes.ResourceC(k.Client).Update(deployed)
+}
+
+// reconcileTriggers makes the Triggers owned by the service match the events it consumes.
+// Triggers are identified by the event type label, the ones for events no longer consumed are deleted.
+func (k *knativeMessagingDeployer) reconcileTriggers(topics []messagingTopic, service api.KogitoService, infra api.KogitoInfraInterface) error {
+	deployedTriggers, err := k.fetchOwnedTriggers(service)
+	if err != nil {
+		return err
+	}
+	for _, eventType := range getConsumedEventTypes(topics) {
+		requested, err := k.newTrigger(messagingEventMeta{Type: eventType}, service, infra)
+		if err != nil {
+			return err
+		}
+		deployed := deployedTriggers[eventType]
+		delete(deployedTriggers, eventType)
+		if len(deployed) == 0 {
+			k.Log.Info("Creating Trigger", "event type", eventType)
+			if err := kubernetes.ResourceC(k.Client).CreateForOwner(requested, service, k.Scheme); err != nil {
+				return err
+			}
+			continue
+		}
+		// duplicated triggers would deliver the same events many times to the service
+		if err := k.deleteTriggers(deployed[1:]); err != nil {
+			return err
+		}
+		if err := k.updateTrigger(&deployed[0], requested, service); err != nil {
+			return err
+		}
+	}
+	for _, staleTriggers := range deployedTriggers {
+		if err := k.deleteTriggers(staleTriggers); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (k *knativeMessagingDeployer) createKnativeTriggersIfNotExists(topic messagingTopic, service api.KogitoService, infra api.KogitoInfraInterface) error {
-	if topic.Kind == incoming {
+// updateTrigger updates the deployed Trigger with the requested spec.
+// The broker of a Trigger is immutable, the Trigger is replaced when it has changed.
+func (k *knativeMessagingDeployer) updateTrigger(deployed, requested *eventingv1.Trigger, service api.KogitoService) error {
+	if deployed.Spec.Broker != requested.Spec.Broker {
+		k.Log.Info("Replacing Trigger bound to a different broker", "Trigger", deployed.Name, "broker", requested.Spec.Broker)
+		if err := kubernetes.ResourceC(k.Client).Delete(deployed); err != nil {
+			return err
+		}
+		return kubernetes.ResourceC(k.Client).CreateForOwner(requested, service, k.Scheme)
+	}
+	if equality.Semantic.DeepEqual(deployed.Spec.Filter, requested.Spec.Filter) &&
+		equality.Semantic.DeepEqual(deployed.Spec.Subscriber, requested.Spec.Subscriber) &&
+		equality.Semantic.DeepEqual(deployed.Spec.Delivery, requested.Spec.Delivery) {
+		return nil
+	}
+	k.Log.Info("Updating Trigger", "Trigger", deployed.Name)
+	deployed.Spec.Filter = requested.Spec.Filter
+	deployed.Spec.Subscriber = requested.Spec.Subscriber
+	deployed.Spec.Delivery = requested.Spec.Delivery
+	return kubernetes.ResourceC(k.Client).Update(deployed)
+}
+
+func (k *knativeMessagingDeployer) deleteTriggers(triggers []eventingv1.Trigger) error {
+	for i := range triggers {
+		k.Log.Info("Deleting Trigger", "Trigger", triggers[i].Name, "event type", triggers[i].Labels[topicIdentifier])
+		if err := kubernetes.ResourceC(k.Client).Delete(&triggers[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteKnativeResources removes the Triggers and the SinkBinding of a service no longer bound to a Knative Eventing KogitoInfra
+func (k *knativeMessagingDeployer) deleteKnativeResources(service api.KogitoService) error {
+	if !infrastructure.NewKnativeHandler(k.Context).IsKnativeEventingAvailable() {
+		return nil
+	}
+	deployedTriggers, err := k.fetchOwnedTriggers(service)
+	if err != nil {
+		return err
+	}
+	for _, triggers := range deployedTriggers {
+		if err := k.deleteTriggers(triggers); err != nil {
+			return err
+		}
+	}
+	sinkBinding := &sourcesv1.SinkBinding{ObjectMeta: metav1.ObjectMeta{Name: getSinkBindingName(service), Namespace: service.GetNamespace()}}
+	if exists, err := kubernetes.ResourceC(k.Client).Fetch(sinkBinding); err != nil || !exists {
+		return err
+	}
+	if !framework.IsOwner(sinkBinding, service) {
+		return nil
+	}
+	k.Log.Info("Deleting SinkBinding", "SinkBinding", sinkBinding.Name)
+	return kubernetes.ResourceC(k.Client).Delete(sinkBinding)
+}
+
+// fetchOwnedTriggers fetches the Triggers owned by the service, grouped by event type
+func (k *knativeMessagingDeployer) fetchOwnedTriggers(service api.KogitoService) (map[string][]eventingv1.Trigger, error) {
+	triggers := &eventingv1.TriggerList{}
+	if err := kubernetes.ResourceC(k.Client).ListWithNamespaceAndLabel(service.GetNamespace(), triggers, map[string]string{framework.LabelAppKey: service.GetName()}); err != nil {
+		return nil, err
+	}
+	ownedTriggers := make(map[string][]eventingv1.Trigger)
+	for _, trigger := range triggers.Items {
+		if framework.IsOwner(&trigger, service) {
+			eventType := trigger.Labels[topicIdentifier]
+			ownedTriggers[eventType] = append(ownedTriggers[eventType], trigger)
+		}
+	}
+	return ownedTriggers, nil
+}
+
+// getConsumedEventTypes lists the event types consumed through the incoming topics, without duplicates
+func getConsumedEventTypes(topics []messagingTopic) []string {
+	var eventTypes []string
+	for _, topic := range topics {
+		if topic.Kind != incoming {
+			continue
+		}
 		for _, event := range topic.EventsMeta {
-			if exists, err := k.triggerExists(event, service); err != nil {
-				return err
-			} else if !exists {
-				trigger := k.newTrigger(event, service, infra)
-				if err := kubernetes.ResourceC(k.Client).CreateForOwner(trigger, service, k.Scheme); err != nil {
-					return err
-				}
+			if !util.Contains(event.Type, eventTypes) {
+				eventTypes = append(eventTypes, event.Type)
 			}
 		}
 	}
-	return nil
+	return eventTypes
 }
 
 // newTrigger creates a new Knative Eventing Trigger reference for the given Event
 // See: https://knative.dev/docs/eventing/broker/triggers/#trigger-filtering
-func (k *knativeMessagingDeployer) newTrigger(e messagingEventMeta, service api.KogitoService, infra api.KogitoInfraInterface) *eventingv1.Trigger {
+func (k *knativeMessagingDeployer) newTrigger(e messagingEventMeta, service api.KogitoService, infra api.KogitoInfraInterface) (*eventingv1.Trigger, error) {
+	delivery, err := newTriggerDelivery(service)
+	if err != nil {
+		return nil, err
+	}
 	return &eventingv1.Trigger{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      fmt.Sprintf("%s-listener-%s", service.GetName(), util.RandomSuffix()),
@@ -98,15 +236,51 @@ func (k *knativeMessagingDeployer) newTrigger(e messagingEventMeta, service api.
 			Broker: infra.GetSpec().GetResource().GetName(),
 			Filter: &eventingv1.TriggerFilter{Attributes: eventingv1.TriggerFilterAttributes{triggerFilterAttribute: e.Type}},
 			Subscriber: duckv1.Destination{
-				Ref: &duckv1.KReference{
-					Name:       service.GetName(),
-					Namespace:  service.GetNamespace(),
-					Kind:       infrastructure.KindService.Name,
-					APIVersion: infrastructure.KindService.GroupVersion.Version,
-				},
+				Ref: newKnativeServiceReference(service, infrastructure.KindService.Name, infrastructure.KindService.GroupVersion.Version),
 			},
+			Delivery: delivery,
 		},
+	}, nil
+}
+
+// newTriggerDelivery creates the Trigger delivery options configured for the service, nil when none is given
+func newTriggerDelivery(service api.KogitoService) (*eventingduckv1.DeliverySpec, error) {
+	delivery := service.GetSpec().GetKnativeTriggerDelivery()
+	if delivery.IsEmpty() {
+		return nil, nil
+	}
+	deliverySpec := &eventingduckv1.DeliverySpec{Retry: delivery.GetRetry()}
+	if len(delivery.GetBackoffPolicy()) > 0 {
+		backoffPolicy := eventingduckv1.BackoffPolicyType(delivery.GetBackoffPolicy())
+		deliverySpec.BackoffPolicy = &backoffPolicy
+	}
+	if len(delivery.GetBackoffDelay()) > 0 {
+		backoffDelay := delivery.GetBackoffDelay()
+		deliverySpec.BackoffDelay = &backoffDelay
 	}
+	if sink := delivery.GetDeadLetterSink(); !sink.IsEmpty() {
+		deliverySpec.DeadLetterSink = &duckv1.Destination{}
+		if len(sink.GetName()) > 0 {
+			namespace := sink.GetNamespace()
+			if len(namespace) == 0 {
+				namespace = service.GetNamespace()
+			}
+			deliverySpec.DeadLetterSink.Ref = &duckv1.KReference{
+				Name:       sink.GetName(),
+				Namespace:  namespace,
+				Kind:       sink.GetKind(),
+				APIVersion: sink.GetAPIVersion(),
+			}
+		}
+		if len(sink.GetURI()) > 0 {
+			uri, err := knativeapis.ParseURL(sink.GetURI())
+			if err != nil {
+				return nil, fmt.Errorf("invalid dead letter sink URI %s: %v", sink.GetURI(), err)
+			}
+			deliverySpec.DeadLetterSink.URI = uri
+		}
+	}
+	return deliverySpec, nil
 }
 
 // newSinkBinding creates a new SinkBinding object targeting the given KogitoInfra resource and binding the
@@ -122,7 +296,7 @@ func (k *knativeMessagingDeployer) newSinkBinding(service api.KogitoService, inf
 	}
 	return &sourcesv1.SinkBinding{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      fmt.Sprintf("%s-publisher", service.GetName()),
+			Name:      getSinkBindingName(service),
 			Namespace: service.GetNamespace(),
 			Labels: map[string]string{
 				framework.LabelAppKey: service.GetName(),
@@ -140,32 +314,40 @@ func (k *knativeMessagingDeployer) newSinkBinding(service api.KogitoService, inf
 				},
 			},
 			BindingSpec: duckv1.BindingSpec{
-				Subject: tracker.Reference{
-					APIVersion: infrastructure.KindDeployment.GroupVersion.String(),
-					Kind:       infrastructure.KindDeployment.Name,
-					Namespace:  service.GetNamespace(),
-					Name:       service.GetName(),
-				},
+				Subject: newSinkBindingSubject(service),
 			},
 		},
 	}
 }
 
-func (k *knativeMessagingDeployer) triggerExists(e messagingEventMeta, service api.KogitoService) (bool, error) {
-	triggers := &eventingv1.TriggerList{}
-	labels := map[string]string{
-		framework.LabelAppKey: service.GetName(),
-		topicIdentifier:       e.Type,
+// newKnativeServiceReference references the Knative Service of a service deployed with the KnativeServing mode,
+// the resource of the given kind otherwise
+func newKnativeServiceReference(service api.KogitoService, kind, apiVersion string) *duckv1.KReference {
+	if isKnativeServing(service) {
+		kind = infrastructure.KnativeServingServiceKind
+		apiVersion = serving.GroupVersion.String()
 	}
-	if err := kubernetes.ResourceC(k.Client).ListWithNamespaceAndLabel(service.GetNamespace(), triggers, labels); err != nil {
-		return false, err
+	return &duckv1.KReference{
+		Name:       service.GetName(),
+		Namespace:  service.GetNamespace(),
+		Kind:       kind,
+		APIVersion: apiVersion,
 	}
-	for _, trigger := range triggers.Items {
-		if framework.IsOwner(&trigger, service) {
-			return true, nil
-		}
+}
+
+// newSinkBindingSubject binds the Deployment of the service, or its Knative Service with the KnativeServing mode
+func newSinkBindingSubject(service api.KogitoService) tracker.Reference {
+	ref := newKnativeServiceReference(service, infrastructure.KindDeployment.Name, infrastructure.KindDeployment.GroupVersion.String())
+	return tracker.Reference{
+		APIVersion: ref.APIVersion,
+		Kind:       ref.Kind,
+		Namespace:  ref.Namespace,
+		Name:       ref.Name,
 	}
-	return false, nil
+}
+
+func getSinkBindingName(service api.KogitoService) string {
+	return fmt.Sprintf("%s-publisher", service.GetName())
 }
 
 // IsKnativeEventingResource checks if provided KogitoInfra instance is for Knative eventing resource
@@ -18,6 +18,8 @@
 package kogitoservice
 
 import (
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
 	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
 	"github.com/kiegroup/kogito-operator/core/framework"
 	"github.com/kiegroup/kogito-operator/core/operator"
@@ -25,10 +27,27 @@ import (
 	"github.com/kiegroup/kogito-operator/internal/app"
 	"github.com/kiegroup/kogito-operator/meta"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
 	"testing"
 )
 
+const travellersTopicsResponse = `[
+   {
+      "name":"kogito_incoming_stream",
+      "type":"INCOMING",
+      "eventsMeta":[
+         {
+            "type":"travellers",
+            "source":"",
+            "kind":"CONSUMED"
+         }
+      ]
+   }
+]`
+
 func Test_knativeMessagingDeployer_CreateRequiredResources(t *testing.T) {
 	responseWithTopics := `[
    {
@@ -73,6 +92,7 @@ func Test_knativeMessagingDeployer_CreateRequiredResources(t *testing.T) {
 	}
 	infraHandler := app.NewKogitoInfraHandler(context)
 	knativeDeployer := NewKnativeMessagingDeployer(context, ServiceDefinition{Request: request}, infraHandler)
+	waitForIntrospection(t, context, kogitoSvc)
 
 	err := knativeDeployer.CreateRequiredResources(kogitoSvc)
 	assert.NoError(t, err)
@@ -87,3 +107,109 @@ func Test_knativeMessagingDeployer_CreateRequiredResources(t *testing.T) {
 	assert.Len(t, triggers.Items, 1)
 	assert.Equal(t, "travellers", triggers.Items[0].Spec.Filter.Attributes["type"])
 }
+
+func Test_knativeMessagingDeployer_ReconcileTriggers(t *testing.T) {
+	server := test.MockKogitoSvcReplies(t, test.ServerHandler{Path: topicInfoPath, JSONResponse: travellersTopicsResponse})
+	defer server.Close()
+	deferFn := test.SetSharedEnv(EnvVarKogitoServiceURL, server.URL)
+	defer deferFn()
+
+	kogitoSvc := createServiceInstance(t)
+	kogitoSvc.SetUID(types.UID(t.Name()))
+	request := newReconcileRequest(kogitoSvc.GetNamespace())
+	request.Name = kogitoSvc.GetName()
+	knativeInfra := test.CreateFakeKogitoKnative(t.Name())
+	kogitoSvc.GetSpec().AddInfra(knativeInfra.GetName())
+	retry := int32(3)
+	kogitoSvc.GetSpec().SetKnativeTriggerDelivery(&v1beta1.KnativeTriggerDelivery{
+		Retry:          &retry,
+		BackoffPolicy:  api.ExponentialKnativeBackoffPolicy,
+		DeadLetterSink: v1beta1.KnativeDeadLetterSink{URI: "http://event-display.svc.cluster.local"},
+	})
+
+	// bound to a previous broker, then the event types no longer consumed by the service
+	travellersTrigger := newOwnedTrigger(t, kogitoSvc, "travellers-trigger", "travellers")
+	travellersTrigger.Spec.Broker = "previous-broker"
+	staleTrigger := newOwnedTrigger(t, kogitoSvc, "stale-trigger", "stale.type")
+	duplicatedTrigger := newOwnedTrigger(t, kogitoSvc, "duplicated-trigger", "travellers")
+	duplicatedTrigger.Spec.Broker = "previous-broker"
+
+	client := test.NewFakeClientBuilder().AddK8sObjects(kogitoSvc, knativeInfra, createAvailableDeployment(kogitoSvc), travellersTrigger, staleTrigger, duplicatedTrigger).Build()
+	context := operator.Context{
+		Client: client,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	infraHandler := app.NewKogitoInfraHandler(context)
+	knativeDeployer := NewKnativeMessagingDeployer(context, ServiceDefinition{Request: request}, infraHandler)
+	waitForIntrospection(t, context, kogitoSvc)
+
+	err := knativeDeployer.CreateRequiredResources(kogitoSvc)
+	assert.NoError(t, err)
+	triggers := &eventingv1.TriggerList{}
+	err = kubernetes.ResourceC(client).ListWithNamespaceAndLabel(kogitoSvc.GetNamespace(), triggers, map[string]string{framework.LabelAppKey: kogitoSvc.GetName()})
+	assert.NoError(t, err)
+	assert.Len(t, triggers.Items, 1)
+	trigger := triggers.Items[0]
+	assert.Equal(t, "travellers", trigger.Labels[topicIdentifier])
+	assert.Equal(t, knativeInfra.GetSpec().GetResource().GetName(), trigger.Spec.Broker)
+	assert.NotNil(t, trigger.Spec.Delivery)
+	assert.Equal(t, retry, *trigger.Spec.Delivery.Retry)
+	assert.Equal(t, "exponential", string(*trigger.Spec.Delivery.BackoffPolicy))
+	assert.Equal(t, "http://event-display.svc.cluster.local", trigger.Spec.Delivery.DeadLetterSink.URI.String())
+
+	// delivery options removed from the spec
+	kogitoSvc.GetSpec().SetKnativeTriggerDelivery(&v1beta1.KnativeTriggerDelivery{})
+	err = knativeDeployer.CreateRequiredResources(kogitoSvc)
+	assert.NoError(t, err)
+	exists, err := kubernetes.ResourceC(client).Fetch(&trigger)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Nil(t, trigger.Spec.Delivery)
+}
+
+func Test_knativeMessagingDeployer_InfraUnbound(t *testing.T) {
+	kogitoSvc := createServiceInstance(t)
+	kogitoSvc.SetUID(types.UID(t.Name()))
+	request := newReconcileRequest(kogitoSvc.GetNamespace())
+	request.Name = kogitoSvc.GetName()
+	trigger := newOwnedTrigger(t, kogitoSvc, "travellers-trigger", "travellers")
+	sinkBinding := &sourcesv1.SinkBinding{ObjectMeta: metav1.ObjectMeta{Name: getSinkBindingName(kogitoSvc), Namespace: kogitoSvc.GetNamespace()}}
+	assert.NoError(t, framework.SetOwner(kogitoSvc, meta.GetRegisteredSchema(), sinkBinding))
+
+	client := test.NewFakeClientBuilder().AddK8sObjects(kogitoSvc, trigger, sinkBinding).SupportKnativeEventing().Build()
+	context := operator.Context{
+		Client: client,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	infraHandler := app.NewKogitoInfraHandler(context)
+	knativeDeployer := NewKnativeMessagingDeployer(context, ServiceDefinition{Request: request}, infraHandler)
+
+	err := knativeDeployer.CreateRequiredResources(kogitoSvc)
+	assert.NoError(t, err)
+	exists, err := kubernetes.ResourceC(client).Fetch(trigger)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+	exists, err = kubernetes.ResourceC(client).Fetch(sinkBinding)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func newOwnedTrigger(t *testing.T, service api.KogitoService, name, eventType string) *eventingv1.Trigger {
+	trigger := &eventingv1.Trigger{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: service.GetNamespace(),
+			Labels: map[string]string{
+				framework.LabelAppKey: service.GetName(),
+				topicIdentifier:       eventType,
+			},
+		},
+		Spec: eventingv1.TriggerSpec{
+			Filter: &eventingv1.TriggerFilter{Attributes: eventingv1.TriggerFilterAttributes{triggerFilterAttribute: eventType}},
+		},
+	}
+	assert.NoError(t, framework.SetOwner(service, meta.GetRegisteredSchema(), trigger))
+	return trigger
+}
@@ -66,7 +66,7 @@ func Test_fetchRequiredTopics(t *testing.T) {
 		Scheme: meta.GetRegisteredSchema(),
 	}
 	m := messagingDeployer{Context: context}
-	topics, err := m.fetchRequiredTopicsForURL(instance, server.URL)
+	topics, err := fetchRequiredTopicsForURL(server.URL)
 	assert.NoError(t, err)
 	assert.NotEmpty(t, topics)
 	m.setCloudEventsStatus(instance, topics)
@@ -75,18 +75,10 @@ func Test_fetchRequiredTopics(t *testing.T) {
 
 func Test_fetchRequiredTopicsWithEmptyReply(t *testing.T) {
 	emptyResponse := "[]"
-	instance := createServiceInstance(t)
 
 	server := test.MockKogitoSvcReplies(t, test.ServerHandler{Path: topicInfoPath, JSONResponse: emptyResponse})
 	defer server.Close()
-	cli := test.NewFakeClientBuilder().AddK8sObjects(createAvailableDeployment(instance)).Build()
-	context := operator.Context{
-		Client: cli,
-		Log:    test.TestLogger,
-		Scheme: meta.GetRegisteredSchema(),
-	}
-	m := messagingDeployer{Context: context}
-	topics, err := m.fetchRequiredTopicsForURL(instance, server.URL)
+	topics, err := fetchRequiredTopicsForURL(server.URL)
 	assert.NoError(t, err)
 	assert.Empty(t, topics)
 }
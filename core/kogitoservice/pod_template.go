@@ -0,0 +1,68 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoservice
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+)
+
+// applyPodTemplateOverlay merges the pod template overlay of the service into the given pod template with a strategic merge patch
+func applyPodTemplateOverlay(template *corev1.PodTemplateSpec, overlay *corev1.PodTemplateSpec) error {
+	if overlay == nil {
+		return nil
+	}
+	original, err := runtime.DefaultUnstructuredConverter.ToUnstructured(template)
+	if err != nil {
+		return err
+	}
+	patch, err := runtime.DefaultUnstructuredConverter.ToUnstructured(overlay)
+	if err != nil {
+		return err
+	}
+	// fields not set in the overlay, such as the containers, are serialized as null, which deletes them in a merge patch
+	removeNullValues(patch)
+	merged, err := strategicpatch.StrategicMergeMapPatch(original, patch, corev1.PodTemplateSpec{})
+	if err != nil {
+		return err
+	}
+	mergedTemplate := corev1.PodTemplateSpec{}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(merged, &mergedTemplate); err != nil {
+		return err
+	}
+	*template = mergedTemplate
+	return nil
+}
+
+func removeNullValues(object map[string]interface{}) {
+	for key, value := range object {
+		switch v := value.(type) {
+		case nil:
+			delete(object, key)
+		case map[string]interface{}:
+			removeNullValues(v)
+		case []interface{}:
+			for _, item := range v {
+				if itemMap, ok := item.(map[string]interface{}); ok {
+					removeNullValues(itemMap)
+				}
+			}
+		}
+	}
+}
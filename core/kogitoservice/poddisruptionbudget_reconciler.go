@@ -0,0 +1,109 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoservice
+
+import (
+	"reflect"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PodDisruptionBudgetReconciler ...
+type PodDisruptionBudgetReconciler interface {
+	Reconcile() error
+}
+
+type podDisruptionBudgetReconciler struct {
+	operator.Context
+	instance       api.KogitoService
+	pdbHandler     infrastructure.PodDisruptionBudgetHandler
+	deltaProcessor infrastructure.DeltaProcessor
+}
+
+func newPodDisruptionBudgetReconciler(context operator.Context, instance api.KogitoService) PodDisruptionBudgetReconciler {
+	return &podDisruptionBudgetReconciler{
+		Context:        context,
+		instance:       instance,
+		pdbHandler:     infrastructure.NewPodDisruptionBudgetHandler(context),
+		deltaProcessor: infrastructure.NewDeltaProcessor(context),
+	}
+}
+
+func (p *podDisruptionBudgetReconciler) Reconcile() error {
+	// without the API, there can't be any PodDisruptionBudget to reconcile
+	if !p.Client.HasServerGroupVersion(policyv1.SchemeGroupVersion) {
+		if p.instance.GetSpec().GetPodDisruptionBudget().IsEnabled() {
+			return infrastructure.ErrorForAPINotAvailable(p.instance.GetName(), policyv1.SchemeGroupVersion.String())
+		}
+		return nil
+	}
+
+	// Create Required resource
+	requestedResources, err := p.createRequiredResources()
+	if err != nil {
+		return err
+	}
+
+	// Get Deployed resource
+	deployedResources, err := p.getDeployedResources()
+	if err != nil {
+		return err
+	}
+
+	// Process Delta
+	return p.processDelta(requestedResources, deployedResources)
+}
+
+func (p *podDisruptionBudgetReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	if !p.instance.GetSpec().GetPodDisruptionBudget().IsEnabled() {
+		p.Log.Debug("Skipping PodDisruptionBudget creation. PodDisruptionBudget is not enabled.")
+		return resources, nil
+	}
+	pdb := p.pdbHandler.CreatePodDisruptionBudget(p.instance)
+	if err := framework.SetOwner(p.instance, p.Scheme, pdb); err != nil {
+		return nil, err
+	}
+	resources[reflect.TypeOf(policyv1.PodDisruptionBudget{})] = []client.Object{pdb}
+	return resources, nil
+}
+
+func (p *podDisruptionBudgetReconciler) getDeployedResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	pdb, err := p.pdbHandler.FetchPodDisruptionBudget(types.NamespacedName{Name: p.instance.GetName(), Namespace: p.instance.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	// only handles the PodDisruptionBudget created by the operator
+	if pdb != nil && framework.IsOwner(pdb, p.instance) {
+		resources[reflect.TypeOf(policyv1.PodDisruptionBudget{})] = []client.Object{pdb}
+	}
+	return resources, nil
+}
+
+func (p *podDisruptionBudgetReconciler) processDelta(requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) (err error) {
+	comparator := p.pdbHandler.GetComparator()
+	_, err = p.deltaProcessor.ProcessDelta(comparator, requestedResources, deployedResources)
+	return
+}
@@ -0,0 +1,97 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoservice
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	policyv1 "k8s.io/api/policy/v1"
+	v13 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	discfake "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestPodDisruptionBudgetReconciler(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	minAvailable := intstr.FromInt(1)
+	instance.Spec.PodDisruptionBudget = v1beta1.PodDisruptionBudget{MinAvailable: &minAvailable}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	err := newPodDisruptionBudgetReconciler(context, instance).Reconcile()
+	assert.NoError(t, err)
+
+	pdb := &policyv1.PodDisruptionBudget{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(pdb)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, map[string]string{"app": instance.Name}, pdb.Spec.Selector.MatchLabels)
+	assert.Equal(t, minAvailable, *pdb.Spec.MinAvailable)
+	assert.Nil(t, pdb.Spec.MaxUnavailable)
+
+	// switching to maxUnavailable updates the PodDisruptionBudget
+	maxUnavailable := intstr.FromString("25%")
+	instance.Spec.PodDisruptionBudget = v1beta1.PodDisruptionBudget{MaxUnavailable: &maxUnavailable}
+	err = newPodDisruptionBudgetReconciler(context, instance).Reconcile()
+	assert.NoError(t, err)
+	_, err = kubernetes.ResourceC(cli).Fetch(pdb)
+	assert.NoError(t, err)
+	assert.Nil(t, pdb.Spec.MinAvailable)
+	assert.Equal(t, maxUnavailable, *pdb.Spec.MaxUnavailable)
+
+	// disabling it removes the PodDisruptionBudget
+	instance.Spec.PodDisruptionBudget = v1beta1.PodDisruptionBudget{}
+	err = newPodDisruptionBudgetReconciler(context, instance).Reconcile()
+	assert.NoError(t, err)
+	exists, err = kubernetes.ResourceC(cli).Fetch(pdb)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestPodDisruptionBudgetReconciler_APINotAvailable(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	// a cluster that doesn't serve policy/v1 yet
+	cli.Discovery = &discfake.FakeDiscovery{Fake: &clienttesting.Fake{}}
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	err := newPodDisruptionBudgetReconciler(context, instance).Reconcile()
+	assert.NoError(t, err)
+
+	minAvailable := intstr.FromInt(1)
+	instance.Spec.PodDisruptionBudget = v1beta1.PodDisruptionBudget{MinAvailable: &minAvailable}
+	err = newPodDisruptionBudgetReconciler(context, instance).Reconcile()
+	assert.Error(t, err)
+	assert.Equal(t, infrastructure.APINotAvailableReason, infrastructure.NewReconciliationErrorHandler(context).GetReasonForError(err))
+}
@@ -85,16 +85,21 @@ func (m *prometheusManager) isPrometheusAvailable() bool {
 }
 
 func (m *prometheusManager) isPrometheusAddOnAvailable(kogitoService api.KogitoService) (bool, error) {
-	kogitoServiceHandler := NewKogitoServiceHandler(m.Context)
-	url := kogitoServiceHandler.GetKogitoServiceURL(kogitoService)
-	url = url + getMonitoringPath(kogitoService.GetSpec().GetMonitoring(), kogitoService)
-	if resp, err := http.Head(url); err != nil {
+	metadata, err := newServiceIntrospector(m.Context).getMetadata(kogitoService)
+	if err != nil || metadata == nil {
 		return false, err
-	} else if resp.StatusCode == http.StatusOK {
-		return true, nil
 	}
-	m.Log.Debug("Non-OK Http Status received")
-	return false, nil
+	return metadata.monitoringAvailable, metadata.monitoringErr
+}
+
+// isMonitoringAddOnAvailable checks if the monitoring endpoint of the service answers
+func isMonitoringAddOnAvailable(url string) (bool, error) {
+	resp, err := introspectionHTTPClient.Head(url)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	return resp.StatusCode == http.StatusOK, nil
 }
 
 func (m *prometheusManager) createPrometheusServiceMonitorIfNotExists(kogitoService api.KogitoService) error {
@@ -0,0 +1,377 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoservice
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/framework/util"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	istio "github.com/kiegroup/kogito-operator/core/infrastructure/istio/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// rolloutRevisionAnnotation holds the hash of the pod template deployed by a Deployment
+	rolloutRevisionAnnotation = "kogito.kie.org/rollout-revision"
+	rolloutCandidateSuffix    = "-candidate"
+)
+
+// RolloutReconciler ...
+type RolloutReconciler interface {
+	// Reconcile adapts the requested Deployments of the service to the rollout in progress and updates the rollout status
+	Reconcile(requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) error
+}
+
+type rolloutReconciler struct {
+	operator.Context
+	instance              api.KogitoRuntimeInterface
+	deploymentHandler     infrastructure.DeploymentHandler
+	serviceHandler        infrastructure.ServiceHandler
+	virtualServiceHandler infrastructure.VirtualServiceHandler
+	deltaProcessor        infrastructure.DeltaProcessor
+}
+
+func newRolloutReconciler(context operator.Context, instance api.KogitoRuntimeInterface) RolloutReconciler {
+	return &rolloutReconciler{
+		Context:               context,
+		instance:              instance,
+		deploymentHandler:     infrastructure.NewDeploymentHandler(context),
+		serviceHandler:        infrastructure.NewServiceHandler(context),
+		virtualServiceHandler: infrastructure.NewVirtualServiceHandler(context),
+		deltaProcessor:        infrastructure.NewDeltaProcessor(context),
+	}
+}
+
+func (r *rolloutReconciler) Reconcile(requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) error {
+	deploymentType := reflect.TypeOf(appsv1.Deployment{})
+	requestedStable := requestedResources[deploymentType][0].(*appsv1.Deployment)
+	revision, err := getPodTemplateRevision(requestedStable.Spec.Template)
+	if err != nil {
+		return err
+	}
+	if requestedStable.Annotations == nil {
+		requestedStable.Annotations = map[string]string{}
+	}
+	requestedStable.Annotations[rolloutRevisionAnnotation] = revision
+	requestedCandidate := r.createCandidateDeployment(requestedStable)
+
+	var deployedStable *appsv1.Deployment
+	if deployed := deployedResources[deploymentType]; len(deployed) > 0 {
+		deployedStable = deployed[0].(*appsv1.Deployment)
+	}
+	deployedCandidate, err := r.deploymentHandler.FetchDeployment(types.NamespacedName{Name: getRolloutCandidateName(r.instance), Namespace: r.instance.GetNamespace()})
+	if err != nil {
+		return err
+	}
+	if deployedCandidate != nil {
+		deployedResources[deploymentType] = append(deployedResources[deploymentType], deployedCandidate)
+	}
+
+	withCandidate, err := r.reconcileRollout(requestedStable, deployedStable, deployedCandidate, revision)
+	if err != nil {
+		return err
+	}
+	if withCandidate {
+		requestedResources[deploymentType] = append(requestedResources[deploymentType], requestedCandidate)
+	}
+
+	if err = r.reconcileCandidateService(withCandidate); err != nil {
+		return err
+	}
+	return r.reconcileVirtualService()
+}
+
+// reconcileRollout moves the rollout to its next phase, keeping the stable Deployment on its revision until the candidate is promoted.
+// Returns true if the candidate Deployment is required.
+func (r *rolloutReconciler) reconcileRollout(requestedStable, deployedStable, deployedCandidate *appsv1.Deployment, revision string) (bool, error) {
+	rollout := r.instance.GetRuntimeSpec().GetRollout()
+	status := r.instance.GetRuntimeStatus().GetRollout()
+	if rollout.GetStrategy() == api.RollingUpdateRolloutStrategy {
+		resetRolloutStatus(status)
+		return false, nil
+	}
+
+	var stableRevision string
+	if deployedStable != nil {
+		stableRevision = deployedStable.Annotations[rolloutRevisionAnnotation]
+	}
+	// new services and services deployed before the strategy was set are updated in place
+	if len(stableRevision) == 0 || stableRevision == revision {
+		if status.GetPhase() == api.RolloutPromotingPhase && !isDeploymentReady(deployedStable) {
+			r.setPhase(status, api.RolloutPromotingPhase, "Candidate promoted, waiting for the stable Deployment to be updated")
+			return true, nil
+		}
+		status.SetStableRevision(revision)
+		status.SetCandidateRevision("")
+		status.SetCanaryWeight(0)
+		r.setPhase(status, api.RolloutCompletedPhase, fmt.Sprintf("Revision %s rolled out", revision))
+		// the candidate is removed once the stable Service selects the stable Deployment again
+		return r.isTrafficOnCandidate()
+	}
+
+	keepStableRevision := func() {
+		requestedStable.Spec.Template = *deployedStable.Spec.Template.DeepCopy()
+		requestedStable.Annotations[rolloutRevisionAnnotation] = stableRevision
+	}
+	action := r.instance.GetAnnotations()[api.RolloutActionAnnotation]
+	if revision == status.GetAbortedRevision() {
+		keepStableRevision()
+		r.setPhase(status, api.RolloutAbortedPhase, fmt.Sprintf("Rollout of revision %s aborted", revision))
+		return false, nil
+	}
+	if action == api.RolloutActionAbort {
+		keepStableRevision()
+		status.SetAbortedRevision(revision)
+		status.SetCandidateRevision("")
+		status.SetCanaryWeight(0)
+		r.setPhase(status, api.RolloutAbortedPhase, fmt.Sprintf("Rollout of revision %s aborted", revision))
+		return false, r.removeRolloutAction()
+	}
+
+	if status.GetCandidateRevision() != revision {
+		r.Log.Info("Rolling out new revision", "strategy", rollout.GetStrategy(), "stable", stableRevision, "candidate", revision)
+		status.SetStableRevision(stableRevision)
+		status.SetCandidateRevision(revision)
+		status.SetCanaryWeight(0)
+		r.setPhase(status, api.RolloutProgressingPhase, "")
+	}
+	if status.GetPhase() == api.RolloutPromotingPhase {
+		// the stable Deployment is only updated once the stable Service has been switched to the candidate
+		onCandidate, err := r.isTrafficOnCandidate()
+		if err != nil {
+			return true, err
+		}
+		if !onCandidate {
+			keepStableRevision()
+			return true, nil
+		}
+		r.setPhase(status, api.RolloutPromotingPhase, "Traffic switched to the candidate, updating the stable Deployment")
+		return true, nil
+	}
+	if deployedCandidate == nil || deployedCandidate.Annotations[rolloutRevisionAnnotation] != revision || !isDeploymentReady(deployedCandidate) {
+		keepStableRevision()
+		status.SetCanaryWeight(0)
+		r.setPhase(status, api.RolloutProgressingPhase, fmt.Sprintf("Waiting for revision %s to be ready in Deployment %s", revision, getRolloutCandidateName(r.instance)))
+		return true, nil
+	}
+	if action == api.RolloutActionPromote || (rollout.GetStrategy() == api.BlueGreenRolloutStrategy && rollout.IsAutoPromote()) {
+		status.SetCanaryWeight(0)
+		// the stable Service is switched to the candidate first, the stable Deployment keeps serving the traffic until then
+		keepStableRevision()
+		r.setPhase(status, api.RolloutPromotingPhase, fmt.Sprintf("Candidate promoted, switching the traffic to Deployment %s", getRolloutCandidateName(r.instance)))
+		return true, r.removeRolloutAction()
+	}
+
+	keepStableRevision()
+	if rollout.GetStrategy() == api.CanaryRolloutStrategy {
+		status.SetCanaryWeight(rollout.GetCanaryWeight())
+	} else {
+		status.SetCanaryWeight(0)
+	}
+	r.setPhase(status, api.RolloutAwaitingPromotionPhase,
+		fmt.Sprintf("Revision %s is ready, annotate the service with %s=%s or %s to continue", revision, api.RolloutActionAnnotation, api.RolloutActionPromote, api.RolloutActionAbort))
+	return true, nil
+}
+
+// isTrafficOnCandidate checks if the stable Service selects the pods of the candidate Deployment
+func (r *rolloutReconciler) isTrafficOnCandidate() (bool, error) {
+	service, err := r.serviceHandler.FetchService(types.NamespacedName{Name: r.instance.GetName(), Namespace: r.instance.GetNamespace()})
+	if err != nil || service == nil {
+		return false, err
+	}
+	return service.Spec.Selector[framework.LabelAppKey] == getRolloutCandidateName(r.instance), nil
+}
+
+// createCandidateDeployment creates the Deployment running the new revision side by side with the stable one
+func (r *rolloutReconciler) createCandidateDeployment(stable *appsv1.Deployment) *appsv1.Deployment {
+	candidateName := getRolloutCandidateName(r.instance)
+	candidate := stable.DeepCopy()
+	candidate.Name = candidateName
+	candidate.Labels[framework.LabelAppKey] = candidateName
+	candidate.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{framework.LabelAppKey: candidateName}}
+	candidate.Spec.Template.Labels[framework.LabelAppKey] = candidateName
+	return candidate
+}
+
+// reconcileCandidateService exposes the candidate Deployment with its own Service while the rollout is in progress
+func (r *rolloutReconciler) reconcileCandidateService(withCandidate bool) error {
+	serviceType := reflect.TypeOf(corev1.Service{})
+	candidateName := getRolloutCandidateName(r.instance)
+	requestedResources := make(map[reflect.Type][]client.Object)
+	if withCandidate {
+		service := r.serviceHandler.CreateService(r.instance)
+		service.Name = candidateName
+		service.Labels[framework.LabelAppKey] = candidateName
+		service.Spec.Selector = map[string]string{framework.LabelAppKey: candidateName}
+		if err := framework.SetOwner(r.instance, r.Scheme, service); err != nil {
+			return err
+		}
+		requestedResources[serviceType] = []client.Object{service}
+	}
+	deployedResources := make(map[reflect.Type][]client.Object)
+	service, err := r.serviceHandler.FetchService(types.NamespacedName{Name: candidateName, Namespace: r.instance.GetNamespace()})
+	if err != nil {
+		return err
+	}
+	if service != nil {
+		deployedResources[serviceType] = []client.Object{service}
+	}
+	_, err = r.deltaProcessor.ProcessDelta(r.serviceHandler.GetComparator(), requestedResources, deployedResources)
+	return err
+}
+
+// reconcileVirtualService splits the traffic between the stable and the candidate Services with an Istio VirtualService on Kubernetes.
+// On OpenShift, the Route does it.
+func (r *rolloutReconciler) reconcileVirtualService() error {
+	if r.Client.IsOpenshift() {
+		return nil
+	}
+	status := r.instance.GetRuntimeStatus().GetRollout()
+	if !r.virtualServiceHandler.IsVirtualServiceAvailable() {
+		if status.GetCanaryWeight() > 0 {
+			status.SetMessage("Istio VirtualService API not found in the cluster, the traffic can't be sent to the candidate")
+		}
+		return nil
+	}
+	virtualServiceType := reflect.TypeOf(istio.VirtualService{})
+	requestedResources := make(map[reflect.Type][]client.Object)
+	if status.GetCanaryWeight() > 0 {
+		virtualService := r.virtualServiceHandler.CreateWeightedVirtualService(r.instance, getRolloutCandidateName(r.instance), status.GetCanaryWeight())
+		if err := framework.SetOwner(r.instance, r.Scheme, virtualService); err != nil {
+			return err
+		}
+		requestedResources[virtualServiceType] = []client.Object{virtualService}
+	}
+	deployedResources := make(map[reflect.Type][]client.Object)
+	virtualService, err := r.virtualServiceHandler.FetchVirtualService(types.NamespacedName{Name: r.instance.GetName(), Namespace: r.instance.GetNamespace()})
+	if err != nil {
+		return err
+	}
+	// only handles the VirtualService created by the operator
+	if virtualService != nil && framework.IsOwner(virtualService, r.instance) {
+		deployedResources[virtualServiceType] = []client.Object{virtualService}
+	}
+	_, err = r.deltaProcessor.ProcessDelta(r.virtualServiceHandler.GetComparator(), requestedResources, deployedResources)
+	return err
+}
+
+func (r *rolloutReconciler) setPhase(status api.RolloutStatusInterface, phase api.RolloutPhase, message string) {
+	if status.GetPhase() != phase {
+		status.SetPhase(phase)
+		now := metav1.Now()
+		status.SetLastTransitionTime(&now)
+	}
+	status.SetMessage(message)
+}
+
+// resetRolloutStatus clears the rollout status when the service is updated in place
+func resetRolloutStatus(status api.RolloutStatusInterface) {
+	status.SetPhase("")
+	status.SetStableRevision("")
+	status.SetCandidateRevision("")
+	status.SetAbortedRevision("")
+	status.SetCanaryWeight(0)
+	status.SetMessage("")
+	status.SetLastTransitionTime(nil)
+}
+
+// removeRolloutAction removes the action annotation once it's been taken, leaving the in-memory status untouched
+func (r *rolloutReconciler) removeRolloutAction() error {
+	if _, ok := r.instance.GetAnnotations()[api.RolloutActionAnnotation]; !ok {
+		return nil
+	}
+	instance := r.instance.DeepCopyObject().(client.Object)
+	annotations := instance.GetAnnotations()
+	delete(annotations, api.RolloutActionAnnotation)
+	instance.SetAnnotations(annotations)
+	if err := kubernetes.ResourceC(r.Client).Update(instance); err != nil {
+		return err
+	}
+	r.instance.SetAnnotations(instance.GetAnnotations())
+	r.instance.SetResourceVersion(instance.GetResourceVersion())
+	return nil
+}
+
+// getRolloutCandidateName gets the name of the Deployment and Service running the candidate revision of the given service
+func getRolloutCandidateName(instance api.KogitoService) string {
+	return instance.GetName() + rolloutCandidateSuffix
+}
+
+// getRolloutStatus gets the rollout status of the given service, nil if it's not a Kogito Runtime
+func getRolloutStatus(instance api.KogitoService) api.RolloutStatusInterface {
+	if runtime, ok := instance.(api.KogitoRuntimeInterface); ok {
+		return runtime.GetRuntimeStatus().GetRollout()
+	}
+	return nil
+}
+
+// hasRolloutStrategy checks if the given service is rolled out with the BlueGreen or Canary strategy
+func hasRolloutStrategy(instance api.KogitoService) bool {
+	if runtime, ok := instance.(api.KogitoRuntimeInterface); ok {
+		return runtime.GetRuntimeSpec().GetRollout().GetStrategy() != api.RollingUpdateRolloutStrategy
+	}
+	return false
+}
+
+// isDeploymentReady checks if all the replicas of the given Deployment are updated and available
+func isDeploymentReady(deployment *appsv1.Deployment) bool {
+	if deployment == nil {
+		return false
+	}
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	return deployment.Status.ObservedGeneration >= deployment.Generation &&
+		deployment.Status.Replicas == replicas &&
+		deployment.Status.UpdatedReplicas == replicas &&
+		deployment.Status.AvailableReplicas == replicas
+}
+
+// getPodTemplateRevision gets the hash of the given pod template, ignoring the order of the variables and volumes
+func getPodTemplateRevision(template corev1.PodTemplateSpec) (string, error) {
+	podTemplate := template.DeepCopy()
+	sort.SliceStable(podTemplate.Spec.Volumes, func(i, j int) bool {
+		return podTemplate.Spec.Volumes[i].Name < podTemplate.Spec.Volumes[j].Name
+	})
+	for _, container := range podTemplate.Spec.Containers {
+		sort.SliceStable(container.Env, func(i, j int) bool {
+			return container.Env[i].Name < container.Env[j].Name
+		})
+		sort.SliceStable(container.VolumeMounts, func(i, j int) bool {
+			return container.VolumeMounts[i].MountPath < container.VolumeMounts[j].MountPath
+		})
+	}
+	content, err := json.Marshal(podTemplate)
+	if err != nil {
+		return "", err
+	}
+	return util.GenerateMD5Hash(map[string]string{"podTemplate": string(content)})[:10], nil
+}
@@ -0,0 +1,226 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoservice
+
+import (
+	"testing"
+
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	kogitocli "github.com/kiegroup/kogito-operator/core/client"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func reconcileRolloutDeployment(t *testing.T, context operator.Context, instance *v1beta1.KogitoRuntime, tag string) {
+	image := &api.Image{Name: "test-image", Tag: tag}
+	imageHandler := infrastructure.NewImageHandler(context, image, "default-image", "image-stream", instance.Namespace, false, false)
+	assert.NoError(t, newDeploymentReconciler(context, instance, ServiceDefinition{}, imageHandler).Reconcile())
+	assert.NoError(t, newServiceReconciler(context, instance).Reconcile())
+}
+
+func fetchRolloutDeployment(t *testing.T, cli *kogitocli.Client, instance *v1beta1.KogitoRuntime, name string) (*appsv1.Deployment, bool) {
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: instance.Namespace}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(deployment)
+	assert.NoError(t, err)
+	return deployment, exists
+}
+
+func setRolloutDeploymentReady(t *testing.T, cli *kogitocli.Client, deployment *appsv1.Deployment) {
+	deployment.Status.Replicas = *deployment.Spec.Replicas
+	deployment.Status.UpdatedReplicas = *deployment.Spec.Replicas
+	deployment.Status.AvailableReplicas = *deployment.Spec.Replicas
+	assert.NoError(t, kubernetes.ResourceC(cli).Update(deployment))
+}
+
+func TestRolloutReconciler_BlueGreen(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.Rollout = v1beta1.Rollout{Strategy: api.BlueGreenRolloutStrategy}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{Client: cli, Log: test.TestLogger, Scheme: meta.GetRegisteredSchema()}
+	candidateName := getRolloutCandidateName(instance)
+
+	reconcileRolloutDeployment(t, context, instance, "1.0")
+	stable, exists := fetchRolloutDeployment(t, cli, instance, instance.Name)
+	assert.True(t, exists)
+	assert.Equal(t, api.RolloutCompletedPhase, instance.Status.Rollout.Phase)
+	stableRevision := stable.Annotations[rolloutRevisionAnnotation]
+	assert.Equal(t, stableRevision, instance.Status.Rollout.StableRevision)
+	setRolloutDeploymentReady(t, cli, stable)
+
+	// new revision deployed side by side, the stable Deployment is untouched
+	reconcileRolloutDeployment(t, context, instance, "2.0")
+	stable, _ = fetchRolloutDeployment(t, cli, instance, instance.Name)
+	assert.Equal(t, stableRevision, stable.Annotations[rolloutRevisionAnnotation])
+	assert.Contains(t, stable.Spec.Template.Spec.Containers[0].Image, "1.0")
+	candidate, exists := fetchRolloutDeployment(t, cli, instance, candidateName)
+	assert.True(t, exists)
+	assert.Contains(t, candidate.Spec.Template.Spec.Containers[0].Image, "2.0")
+	assert.Equal(t, candidateName, candidate.Spec.Selector.MatchLabels[framework.LabelAppKey])
+	assert.Equal(t, api.RolloutProgressingPhase, instance.Status.Rollout.Phase)
+	candidateService := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: candidateName, Namespace: ns}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(candidateService)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	setRolloutDeploymentReady(t, cli, candidate)
+	reconcileRolloutDeployment(t, context, instance, "2.0")
+	assert.Equal(t, api.RolloutAwaitingPromotionPhase, instance.Status.Rollout.Phase)
+	stable, _ = fetchRolloutDeployment(t, cli, instance, instance.Name)
+	assert.Contains(t, stable.Spec.Template.Spec.Containers[0].Image, "1.0")
+
+	// promotion switches the stable Service to the candidate, the stable Deployment is untouched until then
+	instance.Annotations = map[string]string{api.RolloutActionAnnotation: api.RolloutActionPromote}
+	assert.NoError(t, kubernetes.ResourceC(cli).Update(instance))
+	reconcileRolloutDeployment(t, context, instance, "2.0")
+	assert.Equal(t, api.RolloutPromotingPhase, instance.Status.Rollout.Phase)
+	assert.NotContains(t, instance.Annotations, api.RolloutActionAnnotation)
+	stable, _ = fetchRolloutDeployment(t, cli, instance, instance.Name)
+	assert.Equal(t, stableRevision, stable.Annotations[rolloutRevisionAnnotation])
+	assert.Contains(t, stable.Spec.Template.Spec.Containers[0].Image, "1.0")
+	service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: ns}}
+	_, err = kubernetes.ResourceC(cli).Fetch(service)
+	assert.NoError(t, err)
+	assert.Equal(t, candidateName, service.Spec.Selector[framework.LabelAppKey])
+
+	// once the traffic is on the candidate, the stable Deployment is updated
+	reconcileRolloutDeployment(t, context, instance, "2.0")
+	assert.Equal(t, api.RolloutPromotingPhase, instance.Status.Rollout.Phase)
+	stable, _ = fetchRolloutDeployment(t, cli, instance, instance.Name)
+	assert.Contains(t, stable.Spec.Template.Spec.Containers[0].Image, "2.0")
+	_, err = kubernetes.ResourceC(cli).Fetch(service)
+	assert.NoError(t, err)
+	assert.Equal(t, candidateName, service.Spec.Selector[framework.LabelAppKey])
+
+	// once the stable Deployment is ready, the traffic is switched back to it and then the candidate is removed
+	stable.Status.UpdatedReplicas = 0
+	assert.NoError(t, kubernetes.ResourceC(cli).Update(stable))
+	reconcileRolloutDeployment(t, context, instance, "2.0")
+	assert.Equal(t, api.RolloutPromotingPhase, instance.Status.Rollout.Phase)
+	stable, _ = fetchRolloutDeployment(t, cli, instance, instance.Name)
+	setRolloutDeploymentReady(t, cli, stable)
+	reconcileRolloutDeployment(t, context, instance, "2.0")
+	assert.Equal(t, api.RolloutCompletedPhase, instance.Status.Rollout.Phase)
+	assert.Equal(t, candidate.Annotations[rolloutRevisionAnnotation], instance.Status.Rollout.StableRevision)
+	_, err = kubernetes.ResourceC(cli).Fetch(service)
+	assert.NoError(t, err)
+	assert.Equal(t, instance.Name, service.Spec.Selector[framework.LabelAppKey])
+	_, exists = fetchRolloutDeployment(t, cli, instance, candidateName)
+	assert.True(t, exists)
+	reconcileRolloutDeployment(t, context, instance, "2.0")
+	_, exists = fetchRolloutDeployment(t, cli, instance, candidateName)
+	assert.False(t, exists)
+}
+
+func TestRolloutReconciler_CanaryAbort(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.Rollout = v1beta1.Rollout{Strategy: api.CanaryRolloutStrategy, CanaryWeight: 20}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{Client: cli, Log: test.TestLogger, Scheme: meta.GetRegisteredSchema()}
+	candidateName := getRolloutCandidateName(instance)
+
+	reconcileRolloutDeployment(t, context, instance, "1.0")
+	stable, _ := fetchRolloutDeployment(t, cli, instance, instance.Name)
+	setRolloutDeploymentReady(t, cli, stable)
+	reconcileRolloutDeployment(t, context, instance, "2.0")
+	candidate, exists := fetchRolloutDeployment(t, cli, instance, candidateName)
+	assert.True(t, exists)
+	setRolloutDeploymentReady(t, cli, candidate)
+
+	reconcileRolloutDeployment(t, context, instance, "2.0")
+	assert.Equal(t, api.RolloutAwaitingPromotionPhase, instance.Status.Rollout.Phase)
+	assert.Equal(t, int32(20), instance.Status.Rollout.CanaryWeight)
+	// no Istio in the cluster
+	assert.Contains(t, instance.Status.Rollout.Message, "VirtualService")
+
+	instance.Annotations = map[string]string{api.RolloutActionAnnotation: api.RolloutActionAbort}
+	assert.NoError(t, kubernetes.ResourceC(cli).Update(instance))
+	reconcileRolloutDeployment(t, context, instance, "2.0")
+	assert.Equal(t, api.RolloutAbortedPhase, instance.Status.Rollout.Phase)
+	assert.Equal(t, int32(0), instance.Status.Rollout.CanaryWeight)
+	assert.Equal(t, candidate.Annotations[rolloutRevisionAnnotation], instance.Status.Rollout.AbortedRevision)
+	_, exists = fetchRolloutDeployment(t, cli, instance, candidateName)
+	assert.False(t, exists)
+
+	// the aborted revision isn't rolled out again
+	reconcileRolloutDeployment(t, context, instance, "2.0")
+	assert.Equal(t, api.RolloutAbortedPhase, instance.Status.Rollout.Phase)
+	_, exists = fetchRolloutDeployment(t, cli, instance, candidateName)
+	assert.False(t, exists)
+	stable, _ = fetchRolloutDeployment(t, cli, instance, instance.Name)
+	assert.Contains(t, stable.Spec.Template.Spec.Containers[0].Image, "1.0")
+}
+
+func TestRouteReconciler_CanaryWeights(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Status.Rollout = v1beta1.RolloutStatus{Phase: api.RolloutAwaitingPromotionPhase, CanaryWeight: 30}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).OnOpenShift().Build()
+	context := operator.Context{Client: cli, Log: test.TestLogger, Scheme: meta.GetRegisteredSchema()}
+
+	assert.NoError(t, newRouteReconciler(context, instance).Reconcile())
+	route := &routev1.Route{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: ns}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(route)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, int32(70), *route.Spec.To.Weight)
+	assert.Len(t, route.Spec.AlternateBackends, 1)
+	assert.Equal(t, getRolloutCandidateName(instance), route.Spec.AlternateBackends[0].Name)
+	assert.Equal(t, int32(30), *route.Spec.AlternateBackends[0].Weight)
+
+	// back to the stable Service once the rollout is over
+	instance.Status.Rollout = v1beta1.RolloutStatus{Phase: api.RolloutCompletedPhase}
+	assert.NoError(t, newRouteReconciler(context, instance).Reconcile())
+	_, err = kubernetes.ResourceC(cli).Fetch(route)
+	assert.NoError(t, err)
+	assert.Empty(t, route.Spec.AlternateBackends)
+}
+
+func TestGetPodTemplateRevision(t *testing.T) {
+	template := corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{
+		Name: "test",
+		Env:  []corev1.EnvVar{{Name: "B", Value: "b"}, {Name: "A", Value: "a"}},
+	}}}}
+	revision, err := getPodTemplateRevision(template)
+	assert.NoError(t, err)
+	assert.Len(t, revision, 10)
+
+	reordered := template.DeepCopy()
+	reordered.Spec.Containers[0].Env = []corev1.EnvVar{{Name: "A", Value: "a"}, {Name: "B", Value: "b"}}
+	reorderedRevision, err := getPodTemplateRevision(*reordered)
+	assert.NoError(t, err)
+	assert.Equal(t, revision, reorderedRevision)
+	// the given template is left untouched
+	assert.Equal(t, "B", template.Spec.Containers[0].Env[0].Name)
+
+	reordered.Spec.Containers[0].Image = "other"
+	changedRevision, err := getPodTemplateRevision(*reordered)
+	assert.NoError(t, err)
+	assert.NotEqual(t, revision, changedRevision)
+}
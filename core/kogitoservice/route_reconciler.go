@@ -81,6 +81,19 @@ func (i *routeReconciler) Reconcile() error {
 func (i *routeReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
 	resources := make(map[reflect.Type][]client.Object)
 	route := i.routeHandler.CreateRoute(i.instance)
+	// splits the traffic with the candidate during canary rollouts
+	if rollout := getRolloutStatus(i.instance); rollout != nil && rollout.GetCanaryWeight() > 0 {
+		stableWeight := 100 - rollout.GetCanaryWeight()
+		candidateWeight := rollout.GetCanaryWeight()
+		route.Spec.To.Weight = &stableWeight
+		route.Spec.AlternateBackends = []v1.RouteTargetReference{
+			{
+				Kind:   infrastructure.KindService.Name,
+				Name:   getRolloutCandidateName(i.instance),
+				Weight: &candidateWeight,
+			},
+		}
+	}
 	if err := framework.SetOwner(i.instance, i.Scheme, route); err != nil {
 		return nil, err
 	}
@@ -70,6 +70,10 @@ func (i *serviceReconciler) Reconcile() error {
 func (i *serviceReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
 	resources := make(map[reflect.Type][]client.Object)
 	service := i.serviceHandler.CreateService(i.instance)
+	// the promoted candidate serves the traffic while the stable Deployment is updated
+	if rollout := getRolloutStatus(i.instance); rollout != nil && rollout.GetPhase() == api.RolloutPromotingPhase {
+		service.Spec.Selector = map[string]string{framework.LabelAppKey: getRolloutCandidateName(i.instance)}
+	}
 	if err := framework.SetOwner(i.instance, i.Scheme, service); err != nil {
 		return nil, err
 	}
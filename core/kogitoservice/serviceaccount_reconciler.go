@@ -0,0 +1,156 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoservice
+
+import (
+	"reflect"
+
+	"github.com/RHsyseng/operator-utils/pkg/resource/compare"
+	api "github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const serviceViewerRoleBindingSuffix = "-service-viewer"
+
+// ServiceAccountReconciler ...
+type ServiceAccountReconciler interface {
+	Reconcile() error
+}
+
+type serviceAccountReconciler struct {
+	operator.Context
+	instance       api.KogitoService
+	deltaProcessor infrastructure.DeltaProcessor
+}
+
+func newServiceAccountReconciler(context operator.Context, instance api.KogitoService) ServiceAccountReconciler {
+	return &serviceAccountReconciler{
+		Context:        context,
+		instance:       instance,
+		deltaProcessor: infrastructure.NewDeltaProcessor(context),
+	}
+}
+
+// Reconcile creates the ServiceAccount of the service when requested, bound to the service viewer Role for KogitoRuntimes
+func (s *serviceAccountReconciler) Reconcile() error {
+	// Create Required resource
+	requestedResources, err := s.createRequiredResources()
+	if err != nil {
+		return err
+	}
+
+	// Get Deployed resource
+	deployedResources, err := s.getDeployedResources()
+	if err != nil {
+		return err
+	}
+
+	// Process Delta
+	return s.processDelta(requestedResources, deployedResources)
+}
+
+func (s *serviceAccountReconciler) createRequiredResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	serviceAccountName := getServiceAccountName(s.instance)
+	labels := map[string]string{framework.LabelAppKey: s.instance.GetName()}
+	if s.instance.GetSpec().IsCreateServiceAccount() {
+		serviceAccount := &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: serviceAccountName, Namespace: s.instance.GetNamespace(), Labels: labels},
+		}
+		if err := framework.SetOwner(s.instance, s.Scheme, serviceAccount); err != nil {
+			return nil, err
+		}
+		resources[reflect.TypeOf(corev1.ServiceAccount{})] = []client.Object{serviceAccount}
+	}
+	// the service discovery of the runtimes relies on the service viewer Role
+	if _, isRuntime := s.instance.(api.KogitoRuntimeInterface); isRuntime && len(serviceAccountName) > 0 {
+		roleBinding := infrastructure.NewServiceViewerRoleBinding(s.instance.GetName()+serviceViewerRoleBindingSuffix, s.instance.GetNamespace(), serviceAccountName)
+		roleBinding.Labels = labels
+		if err := framework.SetOwner(s.instance, s.Scheme, roleBinding); err != nil {
+			return nil, err
+		}
+		resources[reflect.TypeOf(rbacv1.RoleBinding{})] = []client.Object{roleBinding}
+	}
+	return resources, nil
+}
+
+func (s *serviceAccountReconciler) getDeployedResources() (map[reflect.Type][]client.Object, error) {
+	resources := make(map[reflect.Type][]client.Object)
+	labels := map[string]string{framework.LabelAppKey: s.instance.GetName()}
+	serviceAccounts := &corev1.ServiceAccountList{}
+	if err := kubernetes.ResourceC(s.Client).ListWithNamespaceAndLabel(s.instance.GetNamespace(), serviceAccounts, labels); err != nil {
+		return nil, err
+	}
+	for i := range serviceAccounts.Items {
+		// only handles the ServiceAccounts created by the operator
+		if framework.IsOwner(&serviceAccounts.Items[i], s.instance) {
+			resources[reflect.TypeOf(corev1.ServiceAccount{})] = append(resources[reflect.TypeOf(corev1.ServiceAccount{})], &serviceAccounts.Items[i])
+		}
+	}
+	if _, isRuntime := s.instance.(api.KogitoRuntimeInterface); !isRuntime {
+		return resources, nil
+	}
+	roleBindings := &rbacv1.RoleBindingList{}
+	if err := kubernetes.ResourceC(s.Client).ListWithNamespaceAndLabel(s.instance.GetNamespace(), roleBindings, labels); err != nil {
+		return nil, err
+	}
+	for i := range roleBindings.Items {
+		if framework.IsOwner(&roleBindings.Items[i], s.instance) {
+			resources[reflect.TypeOf(rbacv1.RoleBinding{})] = append(resources[reflect.TypeOf(rbacv1.RoleBinding{})], &roleBindings.Items[i])
+		}
+	}
+	return resources, nil
+}
+
+func (s *serviceAccountReconciler) processDelta(requestedResources map[reflect.Type][]client.Object, deployedResources map[reflect.Type][]client.Object) (err error) {
+	_, err = s.deltaProcessor.ProcessDelta(s.getComparator(), requestedResources, deployedResources)
+	return
+}
+
+func (s *serviceAccountReconciler) getComparator() compare.MapComparator {
+	resourceComparator := compare.DefaultComparator()
+	resourceComparator.SetComparator(
+		framework.NewComparatorBuilder().
+			WithType(reflect.TypeOf(corev1.ServiceAccount{})).
+			WithCustomComparator(framework.CreateServiceAccountComparator()).
+			Build())
+	resourceComparator.SetComparator(
+		framework.NewComparatorBuilder().
+			WithType(reflect.TypeOf(rbacv1.RoleBinding{})).
+			WithCustomComparator(framework.CreateRoleBindingComparator()).
+			Build())
+	return compare.MapComparator{Comparator: resourceComparator}
+}
+
+// getServiceAccountName gets the ServiceAccount the pods of the service run with, empty for the default one
+func getServiceAccountName(service api.KogitoService) string {
+	if name := service.GetSpec().GetServiceAccountName(); len(name) > 0 {
+		return name
+	}
+	if service.GetSpec().IsCreateServiceAccount() {
+		return service.GetName()
+	}
+	return ""
+}
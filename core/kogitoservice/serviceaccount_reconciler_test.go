@@ -0,0 +1,82 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoservice
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	v13 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestServiceAccountReconciler(t *testing.T) {
+	ns := t.Name()
+	instance := test.CreateFakeKogitoRuntime(ns)
+	instance.Spec.CreateServiceAccount = true
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	err := newServiceAccountReconciler(context, instance).Reconcile()
+	assert.NoError(t, err)
+
+	serviceAccount := &corev1.ServiceAccount{ObjectMeta: v13.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	exists, err := kubernetes.ResourceC(cli).Fetch(serviceAccount)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	roleBinding := &rbacv1.RoleBinding{ObjectMeta: v13.ObjectMeta{Name: instance.Name + serviceViewerRoleBindingSuffix, Namespace: instance.Namespace}}
+	exists, err = kubernetes.ResourceC(cli).Fetch(roleBinding)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, instance.Name, roleBinding.Subjects[0].Name)
+
+	// renaming the ServiceAccount replaces the created one and updates the RoleBinding
+	instance.Spec.ServiceAccountName = "my-identity"
+	err = newServiceAccountReconciler(context, instance).Reconcile()
+	assert.NoError(t, err)
+	exists, err = kubernetes.ResourceC(cli).Fetch(serviceAccount)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+	renamed := &corev1.ServiceAccount{ObjectMeta: v13.ObjectMeta{Name: "my-identity", Namespace: instance.Namespace}}
+	exists, err = kubernetes.ResourceC(cli).Fetch(renamed)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	_, err = kubernetes.ResourceC(cli).Fetch(roleBinding)
+	assert.NoError(t, err)
+	assert.Equal(t, "my-identity", roleBinding.Subjects[0].Name)
+
+	// an existing ServiceAccount isn't managed by the operator
+	instance.Spec.CreateServiceAccount = false
+	err = newServiceAccountReconciler(context, instance).Reconcile()
+	assert.NoError(t, err)
+	exists, err = kubernetes.ResourceC(cli).Fetch(renamed)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+	_, err = kubernetes.ResourceC(cli).Fetch(roleBinding)
+	assert.NoError(t, err)
+	assert.Equal(t, "my-identity", roleBinding.Subjects[0].Name)
+}
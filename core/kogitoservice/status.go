@@ -111,7 +111,10 @@ func (s *statusHandler) handleConditionTransition(instance api.KogitoService) er
 	if err != nil {
 		return err
 	}
-	expectedReplicas := *instance.GetSpec().GetReplicas()
+	expectedReplicas, err := s.fetchExpectedReplicas(instance)
+	if err != nil {
+		return err
+	}
 	if expectedReplicas == availableReplicas {
 		s.setDeployed(instance.GetStatus().GetConditions(), metav1.ConditionTrue)
 		s.setProvisioning(instance.GetStatus().GetConditions(), metav1.ConditionFalse, infrastructure.FinishedProvisioningReason)
@@ -134,6 +137,16 @@ func (s *statusHandler) updateStatus(instance api.KogitoService) error {
 }
 
 func (s *statusHandler) updateImageStatus(instance api.KogitoService) error {
+	if isKnativeServing(instance) {
+		knativeService, err := infrastructure.NewKnativeServingHandler(s.Context).FetchKnativeService(types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+		if err != nil || knativeService == nil {
+			return err
+		}
+		if len(knativeService.Spec.Template.Spec.Containers) > 0 {
+			instance.GetStatus().SetImage(knativeService.Spec.Template.Spec.Containers[0].Image)
+		}
+		return nil
+	}
 	deploymentHandler := infrastructure.NewDeploymentHandler(s.Context)
 	deployment, err := deploymentHandler.FetchDeployment(types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
 	if err != nil {
@@ -149,6 +162,10 @@ func (s *statusHandler) updateImageStatus(instance api.KogitoService) error {
 }
 
 func (s *statusHandler) updateDeploymentStatus(instance api.KogitoService) error {
+	// the Deployments of the Knative revisions are managed by Knative
+	if isKnativeServing(instance) {
+		return nil
+	}
 	deploymentHandler := infrastructure.NewDeploymentHandler(s.Context)
 	deployment, err := deploymentHandler.FetchDeployment(types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
 	if err != nil {
@@ -161,6 +178,9 @@ func (s *statusHandler) updateDeploymentStatus(instance api.KogitoService) error
 }
 
 func (s *statusHandler) updateRouteStatus(instance api.KogitoService) error {
+	if isKnativeServing(instance) {
+		return s.updateKnativeServiceURL(instance)
+	}
 	if s.Client.IsOpenshift() {
 		if instance.GetStatus().GetRouteConditions() == nil {
 			instance.GetStatus().SetRouteConditions(&[]metav1.Condition{})
@@ -197,6 +217,46 @@ func (s *statusHandler) updateRouteStatus(instance api.KogitoService) error {
 			uri := fmt.Sprintf("http://%s", route)
 			instance.GetStatus().SetExternalURI(uri)
 		}
+	} else {
+		return s.updateIngressStatus(instance)
+	}
+	return nil
+}
+
+func (s *statusHandler) updateIngressStatus(instance api.KogitoService) error {
+	if instance.GetSpec().IsRouteDisabled() || len(instance.GetSpec().GetIngress().GetHost()) == 0 {
+		return nil
+	}
+	if instance.GetStatus().GetRouteConditions() == nil {
+		instance.GetStatus().SetRouteConditions(&[]metav1.Condition{})
+	}
+
+	ingressHandler := infrastructure.NewIngressHandler(s.Context)
+	ingressKey := types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()}
+	if isAdmitted, err := ingressHandler.ValidateIngressStatus(ingressKey); err != nil {
+		return err
+	} else if isAdmitted {
+		successCondition := s.newFailedCondition(metav1.ConditionFalse, infrastructure.RouteProcessed, "Ingress admitted.")
+		meta.SetStatusCondition(instance.GetStatus().GetRouteConditions(), successCondition)
+	}
+	uri, err := ingressHandler.GetExternalURIFromIngress(ingressKey)
+	if err != nil {
+		return err
+	}
+	if len(uri) > 0 {
+		instance.GetStatus().SetExternalURI(uri)
+	}
+	return nil
+}
+
+// updateKnativeServiceURL exposes the URL of the Knative Service as the external URI of the service
+func (s *statusHandler) updateKnativeServiceURL(instance api.KogitoService) error {
+	knativeService, err := infrastructure.NewKnativeServingHandler(s.Context).FetchKnativeService(types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+	if err != nil || knativeService == nil {
+		return err
+	}
+	if knativeService.Status.URL != nil {
+		instance.GetStatus().SetExternalURI(knativeService.Status.URL.String())
 	}
 	return nil
 }
@@ -258,7 +318,33 @@ func (s *statusHandler) InvalidateFailedCondition(conditions *[]metav1.Condition
 	}
 }
 
+// fetchExpectedReplicas gets the replicas set by the HorizontalPodAutoscaler when autoscaling is enabled, the ones in the spec otherwise
+func (s *statusHandler) fetchExpectedReplicas(instance api.KogitoService) (int32, error) {
+	// a Knative Service is deployed once it's ready, whatever its scale
+	if isKnativeServing(instance) {
+		return 1, nil
+	}
+	if !instance.GetSpec().GetAutoscaling().IsEnabled() {
+		return *instance.GetSpec().GetReplicas(), nil
+	}
+	deploymentHandler := infrastructure.NewDeploymentHandler(s.Context)
+	deployment, err := deploymentHandler.FetchDeployment(types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+	if err != nil {
+		return 0, err
+	} else if deployment == nil || deployment.Spec.Replicas == nil {
+		return *instance.GetSpec().GetReplicas(), nil
+	}
+	return *deployment.Spec.Replicas, nil
+}
+
 func (s *statusHandler) fetchReadyReplicas(instance api.KogitoService) (int32, error) {
+	if isKnativeServing(instance) {
+		ready, err := infrastructure.NewKnativeServingHandler(s.Context).IsKnativeServiceReady(types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+		if err != nil || !ready {
+			return 0, err
+		}
+		return 1, nil
+	}
 	deploymentHandler := infrastructure.NewDeploymentHandler(s.Context)
 	readyReplicas, err := deploymentHandler.FetchReadyReplicas(types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
 	if err != nil {
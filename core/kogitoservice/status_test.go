@@ -29,6 +29,8 @@ import (
 	"github.com/kiegroup/kogito-operator/meta"
 	"github.com/stretchr/testify/assert"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	meta2 "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -214,3 +216,36 @@ func TestReconciliation_PodAlreadyRunning(t *testing.T) {
 func getSpecificCondition(conditions []metav1.Condition, conditionType api.KogitoServiceConditionType) *metav1.Condition {
 	return meta2.FindStatusCondition(conditions, string(conditionType))
 }
+
+func TestReconciliation_IngressAdmitted(t *testing.T) {
+	instance := test.CreateFakeDataIndex(t.Name())
+	instance.Spec.Ingress.Host = "data-index.example.com"
+	instance.Spec.Ingress.TLSSecret = "data-index-tls"
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace},
+		Spec: networkingv1.IngressSpec{
+			TLS:   []networkingv1.IngressTLS{{Hosts: []string{"data-index.example.com"}, SecretName: "data-index-tls"}},
+			Rules: []networkingv1.IngressRule{{Host: "data-index.example.com"}},
+		},
+		Status: networkingv1.IngressStatus{
+			LoadBalancer: corev1.LoadBalancerStatus{Ingress: []corev1.LoadBalancerIngress{{IP: "10.0.0.1"}}},
+		},
+	}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(instance, ingress).Build()
+	context := operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	statusHandler := NewStatusHandler(context)
+	var err error
+	statusHandler.HandleStatusUpdate(instance, &err)
+
+	_, err = kubernetes.ResourceC(cli).Fetch(instance)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://data-index.example.com", instance.Status.ExternalURI)
+	routeCondition := meta2.FindStatusCondition(*instance.Status.RouteConditions, string(api.FailedConditionType))
+	assert.NotNil(t, routeCondition)
+	assert.Equal(t, metav1.ConditionFalse, routeCondition.Status)
+	assert.Equal(t, string(infrastructure.RouteProcessed), routeCondition.Reason)
+}
@@ -0,0 +1,218 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoservice
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/framework"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	defaultReplicas = int32(1)
+)
+
+// SetDefaults sets the default values shared by every Kogito Service
+func SetDefaults(service api.KogitoService) {
+	if service.GetSpec().GetReplicas() == nil {
+		service.GetSpec().SetReplicas(defaultReplicas)
+	}
+}
+
+// SetRuntimeDefaults sets the default values for the given KogitoRuntime
+func SetRuntimeDefaults(runtime api.KogitoRuntimeInterface) {
+	SetDefaults(runtime)
+	// GetRuntime falls back to Quarkus when no runtime is given, persist it in the spec
+	runtime.GetRuntimeSpec().SetRuntime(runtime.GetRuntimeSpec().GetRuntime())
+}
+
+// Validate verifies the spec attributes shared by every Kogito Service
+func Validate(service api.KogitoService) field.ErrorList {
+	var errs field.ErrorList
+	specPath := field.NewPath("spec")
+	spec := service.GetSpec()
+	if spec.GetReplicas() != nil && *spec.GetReplicas() < 0 {
+		errs = append(errs, field.Invalid(specPath.Child("replicas"), *spec.GetReplicas(), "replicas can't be negative"))
+	}
+	for i, env := range spec.GetEnvs() {
+		for _, msg := range validation.IsEnvVarName(env.Name) {
+			errs = append(errs, field.Invalid(specPath.Child("env").Index(i).Child("name"), env.Name, msg))
+		}
+	}
+	for i, infra := range spec.GetInfra() {
+		errs = append(errs, validateResourceName(specPath.Child("infra").Index(i), infra, false)...)
+	}
+	errs = append(errs, validateResourceName(specPath.Child("propertiesConfigMap"), spec.GetPropertiesConfigMap(), true)...)
+	errs = append(errs, validateResourceName(specPath.Child("trustStoreSecret"), spec.GetTrustStoreSecret(), true)...)
+	if scheme := spec.GetMonitoring().GetScheme(); len(scheme) > 0 && scheme != "http" && scheme != "https" {
+		errs = append(errs, field.NotSupported(specPath.Child("monitoring", "scheme"), scheme, []string{"http", "https"}))
+	}
+	if ingress := spec.GetIngress(); ingress != nil {
+		ingressPath := specPath.Child("ingress")
+		errs = append(errs, validateResourceName(ingressPath.Child("host"), ingress.GetHost(), true)...)
+		errs = append(errs, validateResourceName(ingressPath.Child("ingressClassName"), ingress.GetIngressClassName(), true)...)
+		errs = append(errs, validateResourceName(ingressPath.Child("tlsSecret"), ingress.GetTLSSecret(), true)...)
+		if path := ingress.GetPath(); len(path) > 0 && !strings.HasPrefix(path, "/") {
+			errs = append(errs, field.Invalid(ingressPath.Child("path"), path, "path must start with '/'"))
+		}
+	}
+	if autoscaling := spec.GetAutoscaling(); autoscaling.IsEnabled() {
+		autoscalingPath := specPath.Child("autoscaling")
+		if minReplicas := autoscaling.GetMinReplicas(); minReplicas != nil && *minReplicas > autoscaling.GetMaxReplicas() {
+			errs = append(errs, field.Invalid(autoscalingPath.Child("minReplicas"), *minReplicas, "minReplicas can't be greater than maxReplicas"))
+		}
+		for i, metric := range autoscaling.GetMetrics() {
+			if len(metric.GetName()) == 0 {
+				errs = append(errs, field.Required(autoscalingPath.Child("metrics").Index(i).Child("name"), ""))
+			}
+		}
+	}
+	for i, topic := range spec.GetKafkaTopics().GetTopics() {
+		namePath := specPath.Child("kafkaTopics", "topics").Index(i).Child("name")
+		if len(topic.GetName()) == 0 {
+			errs = append(errs, field.Required(namePath, ""))
+		} else if !isValidKafkaTopicPattern(topic.GetName()) {
+			errs = append(errs, field.Invalid(namePath, topic.GetName(), "name must be a topic name or a valid pattern"))
+		}
+	}
+	if sink := spec.GetKnativeTriggerDelivery().GetDeadLetterSink(); !sink.IsEmpty() {
+		sinkPath := specPath.Child("knativeTriggerDelivery", "deadLetterSink")
+		if len(sink.GetName()) > 0 {
+			if len(sink.GetKind()) == 0 {
+				errs = append(errs, field.Required(sinkPath.Child("kind"), "kind is required to reference a resource"))
+			}
+			if len(sink.GetAPIVersion()) == 0 {
+				errs = append(errs, field.Required(sinkPath.Child("apiVersion"), "apiVersion is required to reference a resource"))
+			}
+		} else if uri, err := url.Parse(sink.GetURI()); err != nil || !uri.IsAbs() {
+			errs = append(errs, field.Invalid(sinkPath.Child("uri"), sink.GetURI(), "uri must be absolute when no resource is referenced"))
+		}
+	}
+	errs = append(errs, validateResourceName(specPath.Child("priorityClassName"), spec.GetPriorityClassName(), true)...)
+	errs = append(errs, validateResourceName(specPath.Child("serviceAccountName"), spec.GetServiceAccountName(), true)...)
+	for i, pullSecret := range spec.GetImagePullSecrets() {
+		errs = append(errs, validateResourceName(specPath.Child("imagePullSecrets").Index(i).Child("name"), pullSecret.Name, false)...)
+	}
+	if pdb := spec.GetPodDisruptionBudget(); pdb.GetMinAvailable() != nil && pdb.GetMaxUnavailable() != nil {
+		errs = append(errs, field.Forbidden(specPath.Child("podDisruptionBudget", "maxUnavailable"), "maxUnavailable can't be set along with minAvailable"))
+	}
+	if podTemplate := spec.GetPodTemplate(); podTemplate != nil {
+		errs = append(errs, validatePodTemplate(specPath.Child("podTemplate"), podTemplate, service.GetName())...)
+	}
+	return errs
+}
+
+// validatePodTemplate verifies that the pod template overlay doesn't override the pod template parts managed by the operator
+func validatePodTemplate(path *field.Path, podTemplate *corev1.PodTemplateSpec, serviceName string) field.ErrorList {
+	var errs field.ErrorList
+	if _, exists := podTemplate.Labels[framework.LabelAppKey]; exists {
+		errs = append(errs, field.Forbidden(path.Child("metadata", "labels").Key(framework.LabelAppKey), "the label is used to select the pods of the service"))
+	}
+	specPath := path.Child("spec")
+	for i, container := range podTemplate.Spec.Containers {
+		containerPath := specPath.Child("containers").Index(i)
+		if len(container.Name) == 0 {
+			errs = append(errs, field.Required(containerPath.Child("name"), "name is required to merge the container"))
+		} else if container.Name == serviceName {
+			allowed := corev1.Container{
+				Name:            container.Name,
+				Env:             container.Env,
+				EnvFrom:         container.EnvFrom,
+				VolumeMounts:    container.VolumeMounts,
+				SecurityContext: container.SecurityContext,
+				Lifecycle:       container.Lifecycle,
+			}
+			if !reflect.DeepEqual(allowed, container) {
+				errs = append(errs, field.Forbidden(containerPath, "only env, envFrom, volumeMounts, securityContext and lifecycle can be set on the main container"))
+			}
+		} else if len(container.Image) == 0 {
+			errs = append(errs, field.Required(containerPath.Child("image"), ""))
+		}
+	}
+	for i, container := range podTemplate.Spec.InitContainers {
+		containerPath := specPath.Child("initContainers").Index(i)
+		if len(container.Name) == 0 {
+			errs = append(errs, field.Required(containerPath.Child("name"), "name is required to merge the container"))
+		}
+		if len(container.Image) == 0 {
+			errs = append(errs, field.Required(containerPath.Child("image"), ""))
+		}
+	}
+	for i, volume := range podTemplate.Spec.Volumes {
+		if len(volume.Name) == 0 {
+			errs = append(errs, field.Required(specPath.Child("volumes").Index(i).Child("name"), "name is required to merge the volume"))
+		}
+	}
+	return errs
+}
+
+// ValidateRuntime verifies the spec attributes for the given KogitoRuntime
+func ValidateRuntime(runtime api.KogitoRuntimeInterface) field.ErrorList {
+	errs := Validate(runtime)
+	switch runtime.GetRuntimeSpec().GetRuntime() {
+	case api.QuarkusRuntimeType, api.SpringBootRuntimeType:
+	default:
+		errs = append(errs, field.NotSupported(field.NewPath("spec", "runtime"), runtime.GetRuntimeSpec().GetRuntime(),
+			[]string{string(api.QuarkusRuntimeType), string(api.SpringBootRuntimeType)}))
+	}
+	if isKnativeServing(runtime) {
+		errs = append(errs, validateKnativeServing(runtime)...)
+	}
+	return errs
+}
+
+// validateKnativeServing verifies that the KnativeServing deployment mode isn't combined with features requiring a Deployment
+func validateKnativeServing(runtime api.KogitoRuntimeInterface) field.ErrorList {
+	var errs field.ErrorList
+	specPath := field.NewPath("spec")
+	spec := runtime.GetRuntimeSpec()
+	if spec.GetAutoscaling().IsEnabled() {
+		errs = append(errs, field.Forbidden(specPath.Child("autoscaling"), "Knative Services are autoscaled by Knative, use knativeServing.minScale and knativeServing.maxScale"))
+	}
+	if strategy := spec.GetRollout().GetStrategy(); strategy != api.RollingUpdateRolloutStrategy {
+		errs = append(errs, field.Forbidden(specPath.Child("rollout", "strategy"), fmt.Sprintf("%s rollouts are not supported with the KnativeServing deployment mode", strategy)))
+	}
+	knativeServing := spec.GetKnativeServing()
+	if minScale, maxScale := knativeServing.GetMinScale(), knativeServing.GetMaxScale(); minScale != nil && maxScale != nil && *minScale > *maxScale {
+		errs = append(errs, field.Invalid(specPath.Child("knativeServing", "minScale"), *minScale, "minScale can't be greater than maxScale"))
+	}
+	if spec.GetPodDisruptionBudget().IsEnabled() {
+		errs = append(errs, field.Forbidden(specPath.Child("podDisruptionBudget"), "the pods of Knative Services are managed by Knative and can scale to zero"))
+	}
+	return errs
+}
+
+func validateResourceName(path *field.Path, name string, optional bool) field.ErrorList {
+	if len(name) == 0 {
+		if optional {
+			return nil
+		}
+		return field.ErrorList{field.Required(path, "")}
+	}
+	if msgs := validation.IsDNS1123Subdomain(name); len(msgs) > 0 {
+		return field.ErrorList{field.Invalid(path, name, strings.Join(msgs, ", "))}
+	}
+	return nil
+}
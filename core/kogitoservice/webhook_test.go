@@ -0,0 +1,104 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitoservice
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestSetRuntimeDefaults(t *testing.T) {
+	runtime := test.CreateFakeKogitoRuntime(t.Name())
+	runtime.Spec.Replicas = nil
+	runtime.Spec.Runtime = ""
+	SetRuntimeDefaults(runtime)
+	assert.Equal(t, int32(1), *runtime.Spec.Replicas)
+	assert.Equal(t, api.QuarkusRuntimeType, runtime.Spec.Runtime)
+}
+
+func TestValidateRuntime(t *testing.T) {
+	runtime := test.CreateFakeKogitoRuntime(t.Name())
+	assert.Empty(t, ValidateRuntime(runtime))
+
+	runtime.Spec.Runtime = "nodejs"
+	runtime.Spec.SetReplicas(-1)
+	runtime.Spec.Env = []corev1.EnvVar{{Name: "1-INVALID"}}
+	runtime.Spec.Infra = []string{""}
+	runtime.Spec.PropertiesConfigMap = "Invalid_Name"
+	runtime.Spec.Ingress.Host = "Invalid_Host"
+	runtime.Spec.Ingress.Path = "no-slash"
+	minReplicas := int32(3)
+	runtime.Spec.Autoscaling = v1beta1.Autoscaling{MinReplicas: &minReplicas, MaxReplicas: 2, Metrics: []v1beta1.AutoscalingMetric{{}}}
+	runtime.Spec.KafkaTopics = v1beta1.KafkaTopics{Topics: []v1beta1.KafkaTopic{{Name: "orders-[a"}, {}}}
+	runtime.Spec.KnativeTriggerDelivery = v1beta1.KnativeTriggerDelivery{DeadLetterSink: v1beta1.KnativeDeadLetterSink{Name: "event-display"}}
+	runtime.Spec.PriorityClassName = "Invalid_Priority"
+	minAvailable := intstr.FromInt(1)
+	runtime.Spec.PodDisruptionBudget = v1beta1.PodDisruptionBudget{MinAvailable: &minAvailable, MaxUnavailable: &minAvailable}
+	assert.Len(t, ValidateRuntime(runtime), 15)
+
+	runtime = test.CreateFakeKogitoRuntime(t.Name())
+	runtime.Spec.KnativeTriggerDelivery = v1beta1.KnativeTriggerDelivery{DeadLetterSink: v1beta1.KnativeDeadLetterSink{URI: "/dead-letters"}}
+	assert.Len(t, ValidateRuntime(runtime), 1)
+}
+
+func TestValidateRuntime_PodTemplate(t *testing.T) {
+	runtime := test.CreateFakeKogitoRuntime(t.Name())
+	runtime.Spec.PodTemplate = &corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "wait-for-schema", Image: "busybox:1.35"}},
+			Containers: []corev1.Container{
+				{Name: runtime.Name, Env: []corev1.EnvVar{{Name: "LOG_DIR", Value: "/logs"}}},
+				{Name: "log-shipper", Image: "fluent-bit:1.9"},
+			},
+			Volumes: []corev1.Volume{{Name: "logs"}},
+		},
+	}
+	assert.Empty(t, ValidateRuntime(runtime))
+
+	runtime.Spec.PodTemplate = &corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "other"}},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{}},
+			Containers:     []corev1.Container{{Name: runtime.Name, Image: "my-image"}, {Name: "log-shipper"}, {}},
+			Volumes:        []corev1.Volume{{}},
+		},
+	}
+	assert.Len(t, ValidateRuntime(runtime), 7)
+}
+
+func TestValidateRuntime_KnativeServing(t *testing.T) {
+	runtime := test.CreateFakeKogitoRuntime(t.Name())
+	runtime.Spec.SetDeploymentMode(api.KnativeServingDeploymentMode)
+	assert.Empty(t, ValidateRuntime(runtime))
+
+	minScale := int32(3)
+	maxScale := int32(2)
+	runtime.Spec.KnativeServing = v1beta1.KnativeServing{MinScale: &minScale, MaxScale: &maxScale}
+	runtime.Spec.Autoscaling = v1beta1.Autoscaling{MaxReplicas: 2}
+	runtime.Spec.Rollout = v1beta1.Rollout{Strategy: api.CanaryRolloutStrategy}
+	minAvailable := intstr.FromInt(1)
+	runtime.Spec.PodDisruptionBudget = v1beta1.PodDisruptionBudget{MinAvailable: &minAvailable}
+	assert.Len(t, ValidateRuntime(runtime), 4)
+}
@@ -0,0 +1,56 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitosupportingservice
+
+import (
+	"sort"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/core/kogitoservice"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// SetDefaults sets the default values for the given KogitoSupportingService
+func SetDefaults(instance api.KogitoSupportingServiceInterface) {
+	kogitoservice.SetDefaults(instance)
+}
+
+// Validate verifies the spec attributes for the given KogitoSupportingService instance
+func Validate(context operator.Context, instance api.KogitoSupportingServiceInterface) field.ErrorList {
+	errs := kogitoservice.Validate(instance)
+	serviceTypePath := field.NewPath("spec", "serviceType")
+	serviceType := instance.GetSupportingServiceSpec().GetServiceType()
+	if len(serviceType) == 0 {
+		return append(errs, field.Required(serviceTypePath, "supporting service type is required"))
+	}
+	supportedTypes := getSupportedResources(supportingServiceContext{Context: context, instance: instance})
+	if _, ok := supportedTypes[serviceType]; !ok {
+		var supported []string
+		for supportedType := range supportedTypes {
+			supported = append(supported, string(supportedType))
+		}
+		sort.Strings(supported)
+		errs = append(errs, field.NotSupported(serviceTypePath, serviceType, supported))
+	}
+	// Jobs Service runs a single replica
+	if serviceType == api.JobsService && instance.GetSpec().GetAutoscaling().IsEnabled() {
+		errs = append(errs, field.Forbidden(field.NewPath("spec", "autoscaling"), "Jobs Service can't be autoscaled, only one replica is allowed"))
+	}
+	return errs
+}
@@ -0,0 +1,42 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kogitosupportingservice
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	context := operator.Context{Log: test.TestLogger}
+	instance := test.CreateFakeDataIndex(t.Name())
+	assert.Empty(t, Validate(context, instance))
+
+	instance.Spec.ServiceType = "Unknown"
+	assert.Len(t, Validate(context, instance), 1)
+
+	instance.Spec.ServiceType = ""
+	assert.Len(t, Validate(context, instance), 1)
+
+	jobsService := test.CreateFakeJobsService(t.Name())
+	jobsService.Spec.Autoscaling.MaxReplicas = 2
+	assert.Len(t, Validate(context, jobsService), 1)
+}
@@ -0,0 +1,164 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metrics
+
+import (
+	"context"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	buildv1 "github.com/openshift/api/build/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// KogitoResourceLists creates the empty lists of the Kogito custom resources served by the operator
+type KogitoResourceLists struct {
+	NewKogitoRuntimeList func() client.ObjectList
+	NewKogitoInfraList   func() client.ObjectList
+	NewKogitoBuildList   func() client.ObjectList
+}
+
+type kogitoResourcesCollector struct {
+	reader   client.Reader
+	lists    KogitoResourceLists
+	runtimes *prometheus.Desc
+	infras   *prometheus.Desc
+	builds   *prometheus.Desc
+}
+
+// NewKogitoResourcesCollector creates a collector exposing the health of the Kogito custom resources read at scrape time:
+// the number of runtimes by condition, the KogitoInfra readiness per resource kind and the builds per phase.
+// The reader is supposed to be the manager's cached client, so no calls to the API server are made while scraping.
+func NewKogitoResourcesCollector(reader client.Reader, lists KogitoResourceLists) prometheus.Collector {
+	return &kogitoResourcesCollector{
+		reader: reader,
+		lists:  lists,
+		runtimes: prometheus.NewDesc(prometheus.BuildFQName(metricsNamespace, "", "runtimes"),
+			"Number of KogitoRuntimes per status condition", []string{"condition", "status"}, nil),
+		infras: prometheus.NewDesc(prometheus.BuildFQName(metricsNamespace, "", "infras"),
+			"Number of KogitoInfras per infra resource kind and readiness", []string{"resource_kind", "ready"}, nil),
+		builds: prometheus.NewDesc(prometheus.BuildFQName(metricsNamespace, "", "builds"),
+			"Number of builds started by KogitoBuilds per phase", []string{"phase"}, nil),
+	}
+}
+
+func (c *kogitoResourcesCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.runtimes
+	ch <- c.infras
+	ch <- c.builds
+}
+
+func (c *kogitoResourcesCollector) Collect(ch chan<- prometheus.Metric) {
+	c.collectRuntimes(ch)
+	c.collectInfras(ch)
+	c.collectBuilds(ch)
+}
+
+func (c *kogitoResourcesCollector) collectRuntimes(ch chan<- prometheus.Metric) {
+	items, err := c.list(c.lists.NewKogitoRuntimeList())
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(c.runtimes, err)
+		return
+	}
+	counts := map[[2]string]int{}
+	for _, item := range items {
+		runtime, ok := item.(api.KogitoRuntimeInterface)
+		if !ok || runtime.GetStatus().GetConditions() == nil {
+			continue
+		}
+		for _, condition := range *runtime.GetStatus().GetConditions() {
+			counts[[2]string{condition.Type, string(condition.Status)}]++
+		}
+	}
+	for labels, count := range counts {
+		ch <- prometheus.MustNewConstMetric(c.runtimes, prometheus.GaugeValue, float64(count), labels[0], labels[1])
+	}
+}
+
+func (c *kogitoResourcesCollector) collectInfras(ch chan<- prometheus.Metric) {
+	items, err := c.list(c.lists.NewKogitoInfraList())
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(c.infras, err)
+		return
+	}
+	counts := map[[2]string]int{}
+	for _, item := range items {
+		infra, ok := item.(api.KogitoInfraInterface)
+		if !ok {
+			continue
+		}
+		var kind string
+		if !infra.GetSpec().IsResourceEmpty() {
+			kind = infra.GetSpec().GetResource().GetKind()
+		}
+		ready := string(metav1.ConditionUnknown)
+		if infra.GetStatus().GetConditions() != nil {
+			if condition := apimeta.FindStatusCondition(*infra.GetStatus().GetConditions(), string(api.KogitoInfraConfigured)); condition != nil {
+				ready = string(condition.Status)
+			}
+		}
+		counts[[2]string{kind, ready}]++
+	}
+	for labels, count := range counts {
+		ch <- prometheus.MustNewConstMetric(c.infras, prometheus.GaugeValue, float64(count), labels[0], labels[1])
+	}
+}
+
+func (c *kogitoResourcesCollector) collectBuilds(ch chan<- prometheus.Metric) {
+	items, err := c.list(c.lists.NewKogitoBuildList())
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(c.builds, err)
+		return
+	}
+	counts := map[buildv1.BuildPhase]int{
+		buildv1.BuildPhaseNew:       0,
+		buildv1.BuildPhasePending:   0,
+		buildv1.BuildPhaseRunning:   0,
+		buildv1.BuildPhaseComplete:  0,
+		buildv1.BuildPhaseFailed:    0,
+		buildv1.BuildPhaseError:     0,
+		buildv1.BuildPhaseCancelled: 0,
+	}
+	for _, item := range items {
+		build, ok := item.(api.KogitoBuildInterface)
+		if !ok || build.GetStatus().GetBuilds() == nil {
+			continue
+		}
+		builds := build.GetStatus().GetBuilds()
+		counts[buildv1.BuildPhaseNew] += len(builds.GetNew())
+		counts[buildv1.BuildPhasePending] += len(builds.GetPending())
+		counts[buildv1.BuildPhaseRunning] += len(builds.GetRunning())
+		counts[buildv1.BuildPhaseComplete] += len(builds.GetComplete())
+		counts[buildv1.BuildPhaseFailed] += len(builds.GetFailed())
+		counts[buildv1.BuildPhaseError] += len(builds.GetError())
+		counts[buildv1.BuildPhaseCancelled] += len(builds.GetCancelled())
+	}
+	for phase, count := range counts {
+		ch <- prometheus.MustNewConstMetric(c.builds, prometheus.GaugeValue, float64(count), string(phase))
+	}
+}
+
+func (c *kogitoResourcesCollector) list(list client.ObjectList) ([]runtime.Object, error) {
+	if err := c.reader.List(context.TODO(), list); err != nil {
+		return nil, err
+	}
+	return apimeta.ExtractList(list)
+}
@@ -0,0 +1,89 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/apis"
+	"github.com/kiegroup/kogito-operator/apis/app/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestKogitoResourcesCollector(t *testing.T) {
+	ns := t.Name()
+	deployed := test.CreateFakeKogitoRuntime(ns)
+	deployed.Name = "deployed"
+	deployed.Status.Conditions = &[]metav1.Condition{{Type: string(api.DeployedConditionType), Status: metav1.ConditionTrue}}
+	failed := test.CreateFakeKogitoRuntime(ns)
+	failed.Name = "failed"
+	failed.Status.Conditions = &[]metav1.Condition{
+		{Type: string(api.DeployedConditionType), Status: metav1.ConditionFalse},
+		{Type: string(api.FailedConditionType), Status: metav1.ConditionTrue},
+	}
+	kafka := &v1beta1.KogitoInfra{
+		ObjectMeta: metav1.ObjectMeta{Name: "kafka", Namespace: ns},
+		Spec:       v1beta1.KogitoInfraSpec{Resource: &v1beta1.InfraResource{Kind: "Kafka", Name: "kafka"}},
+		Status: v1beta1.KogitoInfraStatus{
+			Conditions: &[]metav1.Condition{{Type: string(api.KogitoInfraConfigured), Status: metav1.ConditionTrue}},
+		},
+	}
+	build := &v1beta1.KogitoBuild{
+		ObjectMeta: metav1.ObjectMeta{Name: "build", Namespace: ns},
+		Status: v1beta1.KogitoBuildStatus{
+			Builds: v1beta1.Builds{Complete: []string{"build-1", "build-2"}, Running: []string{"build-3"}},
+		},
+	}
+	cli := test.NewFakeClientBuilder().AddK8sObjects(deployed, failed, kafka, build).Build()
+
+	collector := NewKogitoResourcesCollector(cli.ControlCli, KogitoResourceLists{
+		NewKogitoRuntimeList: func() client.ObjectList { return &v1beta1.KogitoRuntimeList{} },
+		NewKogitoInfraList:   func() client.ObjectList { return &v1beta1.KogitoInfraList{} },
+		NewKogitoBuildList:   func() client.ObjectList { return &v1beta1.KogitoBuildList{} },
+	})
+
+	expected := `
+# HELP kogito_operator_runtimes Number of KogitoRuntimes per status condition
+# TYPE kogito_operator_runtimes gauge
+kogito_operator_runtimes{condition="Deployed",status="False"} 1
+kogito_operator_runtimes{condition="Deployed",status="True"} 1
+kogito_operator_runtimes{condition="Failed",status="True"} 1
+# HELP kogito_operator_infras Number of KogitoInfras per infra resource kind and readiness
+# TYPE kogito_operator_infras gauge
+kogito_operator_infras{ready="True",resource_kind="Kafka"} 1
+`
+	assert.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected), "kogito_operator_runtimes", "kogito_operator_infras"))
+
+	expected = `
+# HELP kogito_operator_builds Number of builds started by KogitoBuilds per phase
+# TYPE kogito_operator_builds gauge
+kogito_operator_builds{phase="Cancelled"} 0
+kogito_operator_builds{phase="Complete"} 2
+kogito_operator_builds{phase="Error"} 0
+kogito_operator_builds{phase="Failed"} 0
+kogito_operator_builds{phase="New"} 0
+kogito_operator_builds{phase="Pending"} 0
+kogito_operator_builds{phase="Running"} 1
+`
+	assert.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected), "kogito_operator_builds"))
+}
@@ -0,0 +1,149 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metrics
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// ReconcileResult is the outcome of a reconciliation
+type ReconcileResult string
+
+const (
+	// ReconcileSuccess the reconciliation finished without errors
+	ReconcileSuccess ReconcileResult = "success"
+	// ReconcileRequeue the reconciliation is waiting for something to happen in the cluster and has been scheduled again
+	ReconcileRequeue ReconcileResult = "requeue"
+	// ReconcileError the reconciliation failed with an unexpected error
+	ReconcileError ReconcileResult = "error"
+	// ReconcilePaused the reconciliation has been skipped because the resource is annotated to pause it
+	ReconcilePaused ReconcileResult = "paused"
+
+	metricsNamespace = "kogito_operator"
+)
+
+var (
+	reconcileTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "reconcile_total",
+			Help:      "Total number of reconciliations per custom resource kind, result and condition reason",
+		},
+		[]string{"kind", "result", "reason"},
+	)
+	infraWaitDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "infra_wait_duration_seconds",
+			Help:      "Time spent by Kogito services waiting for their KogitoInfra dependencies to be ready, per infra resource kind",
+			Buckets:   []float64{10, 30, 60, 120, 300, 600, 1200, 1800, 3600},
+		},
+		[]string{"resource_kind"},
+	)
+	maxConcurrentReconciles = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "max_concurrent_reconciles",
+			Help:      "Maximum number of reconciliations run in parallel per controller",
+		},
+		[]string{"controller"},
+	)
+	retryDelay = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "retry_delay_seconds",
+			Help:      "Bounds of the exponential backoff applied to the failed reconciliations",
+		},
+		[]string{"bound"},
+	)
+	requeueJitterFactor = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "requeue_jitter_factor",
+			Help:      "Maximum fraction of the requeue interval randomly added to it",
+		},
+	)
+
+	// infraWaitStart holds when every service started to wait for a given KogitoInfra
+	infraWaitStart = sync.Map{}
+	// now is replaced in tests
+	now = time.Now
+)
+
+func init() {
+	metrics.Registry.MustRegister(reconcileTotal, infraWaitDuration, maxConcurrentReconciles, retryDelay, requeueJitterFactor)
+}
+
+// ObserveReconcile records the outcome of a reconciliation of the given kind.
+// The reason is the condition reason of the error that stopped the reconciliation, empty if it succeeded.
+func ObserveReconcile(kind string, result ReconcileResult, reason string) {
+	reconcileTotal.WithLabelValues(kind, string(result), reason).Inc()
+}
+
+// SetMaxConcurrentReconciles exposes the number of reconciliations the given controller runs in parallel
+func SetMaxConcurrentReconciles(controller string, workers int) {
+	maxConcurrentReconciles.WithLabelValues(controller).Set(float64(workers))
+}
+
+// SetRetryDelay exposes the bounds of the exponential backoff applied to the failed reconciliations
+func SetRetryDelay(base, max time.Duration) {
+	retryDelay.WithLabelValues("base").Set(base.Seconds())
+	retryDelay.WithLabelValues("max").Set(max.Seconds())
+}
+
+// SetRequeueJitterFactor exposes the maximum fraction of the requeue interval randomly added to it
+func SetRequeueJitterFactor(factor float64) {
+	requeueJitterFactor.Set(factor)
+}
+
+// StartInfraWait marks the given service as waiting for the given KogitoInfra, subsequent calls keep the first start time
+func StartInfraWait(serviceKey, infraKey string) {
+	infraWaitStart.LoadOrStore(infraWaitKey(serviceKey, infraKey), now())
+}
+
+// EndInfraWait records the time the given service has waited for the given KogitoInfra, if it was waiting for it
+func EndInfraWait(serviceKey, infraKey, resourceKind string) {
+	if start, waiting := infraWaitStart.LoadAndDelete(infraWaitKey(serviceKey, infraKey)); waiting {
+		infraWaitDuration.WithLabelValues(resourceKind).Observe(now().Sub(start.(time.Time)).Seconds())
+	}
+}
+
+// ForgetInfraWaits stops tracking the KogitoInfra the given service is waiting for, except the given ones.
+// Called when the service is deleted or unbound from a KogitoInfra, otherwise their start time would be kept forever.
+func ForgetInfraWaits(serviceKey string, keepInfraKeys ...string) {
+	keep := make(map[string]bool, len(keepInfraKeys))
+	for _, infraKey := range keepInfraKeys {
+		keep[infraWaitKey(serviceKey, infraKey)] = true
+	}
+	prefix := infraWaitKey(serviceKey, "")
+	infraWaitStart.Range(func(key, _ interface{}) bool {
+		if waitKey := key.(string); strings.HasPrefix(waitKey, prefix) && !keep[waitKey] {
+			infraWaitStart.Delete(key)
+		}
+		return true
+	})
+}
+
+func infraWaitKey(serviceKey, infraKey string) string {
+	return serviceKey + "|" + infraKey
+}
@@ -0,0 +1,85 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObserveReconcile(t *testing.T) {
+	ObserveReconcile("KogitoRuntime", ReconcileRequeue, "KogitoInfraNotReadyReason")
+	ObserveReconcile("KogitoRuntime", ReconcileRequeue, "KogitoInfraNotReadyReason")
+	ObserveReconcile("KogitoRuntime", ReconcileSuccess, "")
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(reconcileTotal.WithLabelValues("KogitoRuntime", "requeue", "KogitoInfraNotReadyReason")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(reconcileTotal.WithLabelValues("KogitoRuntime", "success", "")))
+}
+
+func TestInfraWait(t *testing.T) {
+	defer func() { now = time.Now }()
+	start := time.Now()
+	now = func() time.Time { return start }
+	StartInfraWait("ns/runtime", "ns/kafka")
+	now = func() time.Time { return start.Add(time.Minute) }
+	// the first start time is kept
+	StartInfraWait("ns/runtime", "ns/kafka")
+	now = func() time.Time { return start.Add(2 * time.Minute) }
+	EndInfraWait("ns/runtime", "ns/kafka", "Kafka")
+	// not waiting anymore, nothing is observed
+	EndInfraWait("ns/runtime", "ns/kafka", "Kafka")
+
+	assert.Equal(t, 1, testutil.CollectAndCount(infraWaitDuration, "kogito_operator_infra_wait_duration_seconds"))
+	_, waiting := infraWaitStart.Load(infraWaitKey("ns/runtime", "ns/kafka"))
+	assert.False(t, waiting)
+}
+
+func TestForgetInfraWaits(t *testing.T) {
+	StartInfraWait("ns/runtime", "ns/kafka")
+	StartInfraWait("ns/runtime", "ns/infinispan")
+	StartInfraWait("ns/runtime-2", "ns/kafka")
+
+	// unbound from ns/infinispan
+	ForgetInfraWaits("ns/runtime", "ns/kafka")
+	_, waiting := infraWaitStart.Load(infraWaitKey("ns/runtime", "ns/infinispan"))
+	assert.False(t, waiting)
+	_, waiting = infraWaitStart.Load(infraWaitKey("ns/runtime", "ns/kafka"))
+	assert.True(t, waiting)
+
+	// deleted
+	ForgetInfraWaits("ns/runtime")
+	_, waiting = infraWaitStart.Load(infraWaitKey("ns/runtime", "ns/kafka"))
+	assert.False(t, waiting)
+	_, waiting = infraWaitStart.Load(infraWaitKey("ns/runtime-2", "ns/kafka"))
+	assert.True(t, waiting)
+	ForgetInfraWaits("ns/runtime-2")
+}
+
+func TestControllerSettings(t *testing.T) {
+	SetMaxConcurrentReconciles("kogitoruntime", 4)
+	SetRetryDelay(time.Second, 5*time.Minute)
+	SetRequeueJitterFactor(0.2)
+
+	assert.Equal(t, float64(4), testutil.ToFloat64(maxConcurrentReconciles.WithLabelValues("kogitoruntime")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(retryDelay.WithLabelValues("base")))
+	assert.Equal(t, float64(300), testutil.ToFloat64(retryDelay.WithLabelValues("max")))
+	assert.Equal(t, 0.2, testutil.ToFloat64(requeueJitterFactor))
+}
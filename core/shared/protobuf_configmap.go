@@ -28,7 +28,6 @@ import (
 	"io/ioutil"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/types"
 	"net/http"
 	"path"
 	"strings"
@@ -55,7 +54,6 @@ type ProtoBufConfigMapHandler interface {
 
 type protobufConfigMapHandler struct {
 	operator.Context
-	deploymentHandler    infrastructure.DeploymentHandler
 	kogitoServiceHandler kogitoservice.ServiceHandler
 	configMapHandler     infrastructure.ConfigMapHandler
 }
@@ -64,7 +62,6 @@ type protobufConfigMapHandler struct {
 func NewProtoBufConfigMapHandler(context operator.Context) ProtoBufConfigMapHandler {
 	return &protobufConfigMapHandler{
 		Context:              context,
-		deploymentHandler:    infrastructure.NewDeploymentHandler(context),
 		kogitoServiceHandler: kogitoservice.NewKogitoServiceHandler(context),
 		configMapHandler:     infrastructure.NewConfigMapHandler(context),
 	}
@@ -127,7 +124,7 @@ func (p *protobufConfigMapHandler) CreateProtoBufConfigMapVolumeReference(protoB
 }
 
 func (p *protobufConfigMapHandler) getProtobufData(runtimeInstance api.KogitoRuntimeInterface) (map[string]string, error) {
-	available, err := p.deploymentHandler.IsDeploymentAvailable(types.NamespacedName{Name: runtimeInstance.GetName(), Namespace: runtimeInstance.GetNamespace()})
+	available, err := p.kogitoServiceHandler.IsKogitoServiceAvailable(runtimeInstance)
 	if err != nil {
 		p.Log.Error(err, "failed to check deployment status")
 		return nil, err
@@ -24,6 +24,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/discovery"
 	discfake "k8s.io/client-go/discovery/fake"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
 	clienttesting "k8s.io/client-go/testing"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -39,6 +40,8 @@ type FakeClientBuilder interface {
 	OnOpenShift() FakeClientBuilder
 	SupportPrometheus() FakeClientBuilder
 	SupportOLM() FakeClientBuilder
+	SupportKnativeEventing() FakeClientBuilder
+	SupportKnativeServing() FakeClientBuilder
 	Build() *kogitocli.Client
 }
 
@@ -55,6 +58,8 @@ type fakeClientStruct struct {
 	openShift  bool
 	prometheus bool
 	olm        bool
+	knative    bool
+	serving    bool
 }
 
 // AddK8sObjects ...
@@ -85,6 +90,16 @@ func (f *fakeClientStruct) SupportOLM() FakeClientBuilder {
 	return f
 }
 
+func (f *fakeClientStruct) SupportKnativeEventing() FakeClientBuilder {
+	f.knative = true
+	return f
+}
+
+func (f *fakeClientStruct) SupportKnativeServing() FakeClientBuilder {
+	f.serving = true
+	return f
+}
+
 // OnOpenShift ...
 func (f *fakeClientStruct) OnOpenShift() FakeClientBuilder {
 	f.openShift = true
@@ -105,6 +120,8 @@ func (f *fakeClientStruct) Build() *kogitocli.Client {
 		BuildCli:   buildCli,
 		ImageCli:   imgCli,
 		Discovery:  f.createFakeDiscoveryClient(),
+		// Kubernetes Clientset Fake used to read pod logs, the fake implementation always returns "fake logs"
+		KubernetesExtensionCli: k8sfake.NewSimpleClientset(),
 	}
 }
 
@@ -129,7 +146,10 @@ func (f *fakeClientStruct) createFakeDiscoveryClient() discovery.DiscoveryInterf
 				{GroupVersion: "kafka.strimzi.io/v1beta2"},
 				{GroupVersion: "keycloak.org/v1alpha1"},
 				{GroupVersion: "mongodbcommunity.mongodb.com/v1"},
+				{GroupVersion: "postgres-operator.crunchydata.com/v1beta1"},
 				{GroupVersion: "app.kiegroup.org/v1beta1"},
+				{GroupVersion: "autoscaling/v2"},
+				{GroupVersion: "policy/v1"},
 			},
 		},
 	}
@@ -149,6 +169,16 @@ func (f *fakeClientStruct) createFakeDiscoveryClient() discovery.DiscoveryInterf
 		disco.Fake.Resources = append(disco.Fake.Resources,
 			&metav1.APIResourceList{GroupVersion: "operators.coreos.com/v1"})
 	}
+
+	if f.knative {
+		disco.Fake.Resources = append(disco.Fake.Resources,
+			&metav1.APIResourceList{GroupVersion: "eventing.knative.dev/v1"})
+	}
+
+	if f.serving {
+		disco.Fake.Resources = append(disco.Fake.Resources,
+			&metav1.APIResourceList{GroupVersion: "serving.knative.dev/v1"})
+	}
 	return disco
 }
 
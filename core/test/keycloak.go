@@ -0,0 +1,90 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package test
+
+import (
+	keycloakv1alpha1 "github.com/kiegroup/kogito-operator/core/infrastructure/keycloak/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CreateFakeKeycloak ...
+func CreateFakeKeycloak(namespace string) *keycloakv1alpha1.Keycloak {
+	return &keycloakv1alpha1.Keycloak{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kogito-keycloak",
+			Namespace: namespace,
+		},
+		Status: keycloakv1alpha1.KeycloakStatus{
+			Ready:       true,
+			InternalURL: "https://keycloak." + namespace + ".svc:8443",
+		},
+	}
+}
+
+// CreateFakeKeycloakRealm ...
+func CreateFakeKeycloakRealm(namespace string) *keycloakv1alpha1.KeycloakRealm {
+	return &keycloakv1alpha1.KeycloakRealm{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kogito-realm",
+			Namespace: namespace,
+			Labels:    map[string]string{"realm": "kogito"},
+		},
+		Spec: keycloakv1alpha1.KeycloakRealmSpec{
+			Realm: &keycloakv1alpha1.KeycloakAPIRealm{
+				Realm: "kogito",
+			},
+		},
+		Status: keycloakv1alpha1.KeycloakRealmStatus{
+			Ready: true,
+		},
+	}
+}
+
+// CreateFakeKeycloakClient ...
+func CreateFakeKeycloakClient(namespace string) *keycloakv1alpha1.KeycloakClient {
+	return &keycloakv1alpha1.KeycloakClient{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kogito-client",
+			Namespace: namespace,
+		},
+		Spec: keycloakv1alpha1.KeycloakClientSpec{
+			RealmSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"realm": "kogito"}},
+			Client: &keycloakv1alpha1.KeycloakAPIClient{
+				ClientID: "kogito-app",
+			},
+		},
+		Status: keycloakv1alpha1.KeycloakClientStatus{
+			Ready: true,
+		},
+	}
+}
+
+// CreateFakeKeycloakClientSecret ...
+func CreateFakeKeycloakClientSecret(namespace string) *v1.Secret {
+	return &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "keycloak-client-secret-kogito-app",
+			Namespace: namespace,
+		},
+		Data: map[string][]byte{
+			"CLIENT_ID":     []byte("kogito-app"),
+			"CLIENT_SECRET": []byte("secretToFind"),
+		},
+	}
+}
@@ -140,3 +140,84 @@ func CreateFakeKogitoMongoDB(namespace string) api.KogitoInfraInterface {
 		},
 	}
 }
+
+// CreateFakeKogitoPostgresCluster create fake kogito infra instance for a PostgresCluster
+func CreateFakeKogitoPostgresCluster(namespace string) api.KogitoInfraInterface {
+	return &v1beta1.KogitoInfra{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "kogito-postgresql-infra",
+			Namespace: namespace,
+		},
+		Spec: v1beta1.KogitoInfraSpec{
+			Resource: &v1beta1.InfraResource{
+				Kind:       "PostgresCluster",
+				APIVersion: "postgres-operator.crunchydata.com/v1beta1",
+				Name:       "kogito-postgresql",
+			},
+		},
+		Status: v1beta1.KogitoInfraStatus{
+			Conditions: &[]v1.Condition{
+				{
+					Type:   string(api.KogitoInfraConfigured),
+					Status: v1.ConditionTrue,
+				},
+			},
+		},
+	}
+}
+
+// CreateFakeKogitoPostgreSQLService create fake kogito infra instance for a PostgreSQL server exposed by a plain Service
+func CreateFakeKogitoPostgreSQLService(namespace string) api.KogitoInfraInterface {
+	return &v1beta1.KogitoInfra{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "kogito-postgresql-service-infra",
+			Namespace: namespace,
+		},
+		Spec: v1beta1.KogitoInfraSpec{
+			Resource: &v1beta1.InfraResource{
+				Kind:       "Service",
+				APIVersion: "v1",
+				Name:       "postgresql",
+			},
+			InfraProperties: map[string]string{
+				"credentials-secret": "postgresql-credentials",
+			},
+		},
+		Status: v1beta1.KogitoInfraStatus{
+			Conditions: &[]v1.Condition{
+				{
+					Type:   string(api.KogitoInfraConfigured),
+					Status: v1.ConditionTrue,
+				},
+			},
+		},
+	}
+}
+
+// CreateFakeKogitoKeycloak create fake kogito infra instance for a Keycloak realm
+func CreateFakeKogitoKeycloak(namespace string) api.KogitoInfraInterface {
+	return &v1beta1.KogitoInfra{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "kogito-keycloak-infra",
+			Namespace: namespace,
+		},
+		Spec: v1beta1.KogitoInfraSpec{
+			Resource: &v1beta1.InfraResource{
+				Kind:       "Keycloak",
+				APIVersion: "keycloak.org/v1alpha1",
+				Name:       "kogito-keycloak",
+			},
+			InfraProperties: map[string]string{
+				"keycloak-realm": "kogito-realm",
+			},
+		},
+		Status: v1beta1.KogitoInfraStatus{
+			Conditions: &[]v1.Condition{
+				{
+					Type:   string(api.KogitoInfraConfigured),
+					Status: v1.ConditionTrue,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,102 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package test
+
+import (
+	postgresql "github.com/kiegroup/kogito-operator/core/infrastructure/postgresql/v1beta1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CreateFakePostgresCluster ...
+func CreateFakePostgresCluster(namespace string) *postgresql.PostgresCluster {
+	return &postgresql.PostgresCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kogito-postgresql",
+			Namespace: namespace,
+		},
+		Spec: postgresql.PostgresClusterSpec{
+			PostgresVersion: 14,
+			Users: []postgresql.PostgresUserSpec{
+				{
+					Name:      "kogito",
+					Databases: []string{"kogito"},
+				},
+			},
+		},
+		Status: postgresql.PostgresClusterStatus{
+			InstanceSets: []postgresql.PostgresInstanceSetStatus{
+				{
+					Name:          "instance1",
+					Replicas:      1,
+					ReadyReplicas: 1,
+				},
+			},
+		},
+	}
+}
+
+// CreateFakePostgresClusterUserSecret ...
+func CreateFakePostgresClusterUserSecret(namespace string) *v1.Secret {
+	return &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kogito-postgresql-pguser-kogito",
+			Namespace: namespace,
+		},
+		Data: map[string][]byte{
+			"host":     []byte("kogito-postgresql-primary." + namespace + ".svc"),
+			"port":     []byte("5432"),
+			"dbname":   []byte("kogito"),
+			"user":     []byte("kogito"),
+			"password": []byte("passwordToFind"),
+		},
+	}
+}
+
+// CreateFakePostgreSQLService ...
+func CreateFakePostgreSQLService(namespace string) *v1.Service {
+	return &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "postgresql",
+			Namespace: namespace,
+		},
+		Spec: v1.ServiceSpec{
+			Ports: []v1.ServicePort{
+				{
+					Name: "postgresql",
+					Port: 5433,
+				},
+			},
+		},
+	}
+}
+
+// CreateFakePostgreSQLSecret ...
+func CreateFakePostgreSQLSecret(namespace string) *v1.Secret {
+	return &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "postgresql-credentials",
+			Namespace: namespace,
+		},
+		Data: map[string][]byte{
+			"username": []byte("kogito"),
+			"password": []byte("passwordToFind"),
+			"database": []byte("kogito"),
+		},
+	}
+}
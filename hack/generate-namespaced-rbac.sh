@@ -0,0 +1,80 @@
+#!/bin/bash
+# Licensed to the Apache Software Foundation (ASF) under one
+# or more contributor license agreements.  See the NOTICE file
+# distributed with this work for additional information
+# regarding copyright ownership.  The ASF licenses this file
+# to you under the Apache License, Version 2.0 (the
+# "License"); you may not use this file except in compliance
+# with the License.  You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing,
+# software distributed under the License is distributed on an
+# "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+# KIND, either express or implied.  See the License for the
+# specific language governing permissions and limitations
+# under the License.
+
+
+set -e
+
+# Generates the Role and RoleBinding granting the operator access to every namespace it watches,
+# to be used instead of the manager ClusterRole when WATCH_NAMESPACE is set in the operator deployment.
+# Cluster-scoped rules (e.g. CustomResourceDefinitions) are dropped, since a Role can't grant them.
+#
+# Usage: generate-namespaced-rbac.sh <role file> <comma separated watched namespaces> <name prefix> <operator namespace>
+
+ROLE_FILE=${1}
+WATCH_NAMESPACE=${2}
+NAME_PREFIX=${3:-kogito-operator-}
+OPERATOR_NAMESPACE=${4:-kogito-operator-system}
+
+if [[ -z ${ROLE_FILE} || ! -f ${ROLE_FILE} ]]; then
+    echo "No role file given. Please provide the generated manager ClusterRole, e.g. config/rbac/app/role.yaml" >&2
+    exit 1
+fi
+if [[ -z ${WATCH_NAMESPACE} ]]; then
+    echo "No namespaces given. Please provide the comma separated namespaces watched by the operator" >&2
+    exit 1
+fi
+
+## keeps the namespaced rules of the ClusterRole
+rules=$(awk '
+  function flush() { if (rule != "" && rule !~ /apiextensions\.k8s\.io/) printf "%s", rule; rule = "" }
+  /^rules:/ { inRules = 1; print; next }
+  inRules && /^- / { flush() }
+  inRules { rule = rule $0 "\n" }
+  END { flush() }
+' "${ROLE_FILE}")
+
+IFS=',' read -ra namespaces <<< "${WATCH_NAMESPACE}"
+for namespace in "${namespaces[@]}"; do
+  namespace=$(echo "${namespace}" | xargs)
+  if [[ -z ${namespace} ]]; then
+    continue
+  fi
+  cat <<YAML
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: Role
+metadata:
+  name: ${NAME_PREFIX}manager-role
+  namespace: ${namespace}
+${rules}
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: RoleBinding
+metadata:
+  name: ${NAME_PREFIX}manager-rolebinding
+  namespace: ${namespace}
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: Role
+  name: ${NAME_PREFIX}manager-role
+subjects:
+- kind: ServiceAccount
+  name: ${NAME_PREFIX}controller-manager
+  namespace: ${OPERATOR_NAMESPACE}
+YAML
+done
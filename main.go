@@ -21,6 +21,7 @@ package main
 
 import (
 	"flag"
+	"github.com/kiegroup/kogito-operator/controllers/common"
 	"github.com/kiegroup/kogito-operator/controllers/rhpam"
 	"github.com/kiegroup/kogito-operator/core/client"
 	"github.com/kiegroup/kogito-operator/core/framework/util"
@@ -35,8 +36,10 @@ import (
 
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	"github.com/kiegroup/kogito-operator/controllers/app"
 	//+kubebuilder:scaffold:imports
@@ -48,6 +51,7 @@ var (
 	metricsAddr          string
 	enableLeaderElection bool
 	probeAddr            string
+	controllerOptions    = common.DefaultControllerOptions()
 )
 
 func init() {
@@ -58,6 +62,17 @@ func init() {
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.IntVar(&controllerOptions.MaxConcurrentReconciles, "max-concurrent-reconciles", controllerOptions.MaxConcurrentReconciles,
+		"The number of reconciliations every controller runs in parallel.")
+	flag.Var(controllerOptions.MaxConcurrentReconcilesPerController, "max-concurrent-reconciles-per-controller",
+		"The number of reconciliations run in parallel by specific controllers, overriding --max-concurrent-reconciles. "+
+			"Comma separated list of controller=workers, e.g. KogitoRuntime=4,KogitoBuild=2.")
+	flag.DurationVar(&controllerOptions.RetryBaseDelay, "retry-base-delay", controllerOptions.RetryBaseDelay,
+		"The initial delay of the exponential backoff applied to the failed reconciliations.")
+	flag.DurationVar(&controllerOptions.RetryMaxDelay, "retry-max-delay", controllerOptions.RetryMaxDelay,
+		"The maximum delay of the exponential backoff applied to the failed reconciliations.")
+	flag.Float64Var(&controllerOptions.RequeueJitterFactor, "requeue-jitter-factor", controllerOptions.RequeueJitterFactor,
+		"The maximum fraction of the requeue interval randomly added to it, so resources waiting together aren't reconciled in bursts. Zero disables the jitter.")
 }
 
 func main() {
@@ -68,19 +83,26 @@ func main() {
 	flag.Parse()
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+	mgrOptions := ctrl.Options{
 		Scheme:                 scheme,
 		MetricsBindAddress:     metricsAddr,
 		Port:                   9443,
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "d1731e98.kiegroup.org",
-	})
+	}
+	setWatchNamespaces(&mgrOptions)
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), mgrOptions)
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
 		os.Exit(1)
 	}
 
+	if err = common.ConfigureControllers(controllerOptions); err != nil {
+		setupLog.Error(err, "invalid controller options")
+		os.Exit(1)
+	}
+
 	kubeCli := client.NewForController(mgr)
 
 	if !util.IsProductMode() {
@@ -104,6 +126,29 @@ func main() {
 			setupLog.Error(err, "unable to create controller", "controller", "KogitoRuntimeDeployment")
 			os.Exit(1)
 		}
+		if err = app.NewAPIDiscoveryReconciler(kubeCli).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "APIDiscovery")
+			os.Exit(1)
+		}
+		ctrlmetrics.Registry.MustRegister(app.NewKogitoResourcesCollector(mgr.GetClient()))
+		if isWebhookEnabled() {
+			if err = app.NewKogitoRuntimeWebhook(kubeCli, mgr.GetScheme()).SetupWebhookWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create webhook", "webhook", "KogitoRuntime")
+				os.Exit(1)
+			}
+			if err = app.NewKogitoSupportingServiceWebhook(kubeCli, mgr.GetScheme()).SetupWebhookWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create webhook", "webhook", "KogitoSupportingService")
+				os.Exit(1)
+			}
+			if err = app.NewKogitoBuildWebhook(kubeCli, mgr.GetScheme()).SetupWebhookWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create webhook", "webhook", "KogitoBuild")
+				os.Exit(1)
+			}
+			if err = app.NewKogitoInfraWebhook(kubeCli, mgr.GetScheme()).SetupWebhookWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create webhook", "webhook", "KogitoInfra")
+				os.Exit(1)
+			}
+		}
 	} else {
 		if err = rhpam.NewKogitoRuntimeReconciler(kubeCli, mgr.GetScheme()).SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "KogitoRuntime")
@@ -121,6 +166,29 @@ func main() {
 			setupLog.Error(err, "unable to create controller", "controller", "KogitoInfra")
 			os.Exit(1)
 		}
+		if err = rhpam.NewAPIDiscoveryReconciler(kubeCli).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "APIDiscovery")
+			os.Exit(1)
+		}
+		ctrlmetrics.Registry.MustRegister(rhpam.NewKogitoResourcesCollector(mgr.GetClient()))
+		if isWebhookEnabled() {
+			if err = rhpam.NewKogitoRuntimeWebhook(kubeCli, mgr.GetScheme()).SetupWebhookWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create webhook", "webhook", "KogitoRuntime")
+				os.Exit(1)
+			}
+			if err = rhpam.NewKogitoSupportingServiceWebhook(kubeCli, mgr.GetScheme()).SetupWebhookWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create webhook", "webhook", "KogitoSupportingService")
+				os.Exit(1)
+			}
+			if err = rhpam.NewKogitoBuildWebhook(kubeCli, mgr.GetScheme()).SetupWebhookWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create webhook", "webhook", "KogitoBuild")
+				os.Exit(1)
+			}
+			if err = rhpam.NewKogitoInfraWebhook(kubeCli, mgr.GetScheme()).SetupWebhookWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create webhook", "webhook", "KogitoInfra")
+				os.Exit(1)
+			}
+		}
 	}
 
 	//+kubebuilder:scaffold:builder
@@ -152,3 +220,24 @@ func isDebugMode() bool {
 	return false
 
 }
+
+// setWatchNamespaces restricts the manager cache to the namespaces listed in WATCH_NAMESPACE, if any
+func setWatchNamespaces(options *ctrl.Options) {
+	namespaces := util.GetWatchNamespaces()
+	switch len(namespaces) {
+	case 0:
+		setupLog.Info("Watching all namespaces")
+	case 1:
+		setupLog.Info("Watching a single namespace", "namespace", namespaces[0])
+		options.Namespace = namespaces[0]
+	default:
+		setupLog.Info("Watching multiple namespaces", "namespaces", namespaces)
+		options.NewCache = cache.MultiNamespacedCacheBuilder(namespaces)
+	}
+}
+
+// isWebhookEnabled the admission webhooks are opt-in, they are served only when ENABLE_WEBHOOKS is set to true (see config/default-webhook)
+func isWebhookEnabled() bool {
+	enabled, _ := os.LookupEnv("ENABLE_WEBHOOKS")
+	return strings.ToUpper(enabled) == "TRUE"
+}
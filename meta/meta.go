@@ -23,9 +23,12 @@ import (
 	"github.com/kiegroup/kogito-operator/core/framework/util"
 	grafana "github.com/kiegroup/kogito-operator/core/infrastructure/grafana/v1alpha1"
 	infinispan "github.com/kiegroup/kogito-operator/core/infrastructure/infinispan/v1"
+	istio "github.com/kiegroup/kogito-operator/core/infrastructure/istio/v1beta1"
 	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
 	keycloakv1alpha1 "github.com/kiegroup/kogito-operator/core/infrastructure/keycloak/v1alpha1"
 	mongodb "github.com/kiegroup/kogito-operator/core/infrastructure/mongodb/v1"
+	postgresql "github.com/kiegroup/kogito-operator/core/infrastructure/postgresql/v1beta1"
+	serving "github.com/kiegroup/kogito-operator/core/infrastructure/serving/v1"
 	appsv1 "github.com/openshift/api/apps/v1"
 	buildv1 "github.com/openshift/api/build/v1"
 	imgv1 "github.com/openshift/api/image/v1"
@@ -66,6 +69,9 @@ func GetRegisteredSchema() *runtime.Scheme {
 	metav1.AddToGroupVersion(s, routev1.GroupVersion)
 	metav1.AddToGroupVersion(s, infinispan.SchemeGroupVersion)
 	metav1.AddToGroupVersion(s, mongodb.SchemeBuilder.GroupVersion)
+	metav1.AddToGroupVersion(s, postgresql.SchemeBuilder.GroupVersion)
+	metav1.AddToGroupVersion(s, istio.SchemeBuilder.GroupVersion)
+	metav1.AddToGroupVersion(s, serving.SchemeBuilder.GroupVersion)
 	metav1.AddToGroupVersion(s, v1beta2.SchemeGroupVersion)
 	metav1.AddToGroupVersion(s, grafana.GroupVersion)
 	metav1.AddToGroupVersion(s, eventingv1.SchemeGroupVersion)
@@ -90,6 +96,9 @@ func getRegisteredSchemeBuilder() runtime.SchemeBuilder {
 		apiextensionsv1.AddToScheme,
 		v1beta2.SchemeBuilder.AddToScheme,
 		mongodb.SchemeBuilder.AddToScheme,
+		postgresql.SchemeBuilder.AddToScheme,
+		istio.SchemeBuilder.AddToScheme,
+		serving.SchemeBuilder.AddToScheme,
 		infinispan.AddToScheme,
 		keycloakv1alpha1.SchemeBuilder.AddToScheme,
 		monv1.SchemeBuilder.AddToScheme,